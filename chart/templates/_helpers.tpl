@@ -214,11 +214,19 @@ app.kubernetes.io/instance: {{ .Release.Name }}
 {{- include "gexec.shared.environment" . }}
 - name: GEXEC_RUNNER_SERVER
   value: "{{ .Values.config.runner.server }}"
+{{- if .Values.config.runner.joinToken }}
+- name: GEXEC_RUNNER_JOIN_TOKEN
+  valueFrom:
+    secretKeyRef:
+      name: "{{ include "gexec.runner.secretName" . }}"
+      key: "{{ .Values.config.runner.joinTokenKey }}"
+{{- else }}
 - name: GEXEC_RUNNER_TOKEN
   valueFrom:
     secretKeyRef:
       name: "{{ include "gexec.runner.secretName" . }}"
       key: "{{ .Values.config.runner.tokenKey }}"
+{{- end }}
 {{- end -}}
 
 {{- define "gexec.cleanup.environment" -}}
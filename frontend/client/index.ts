@@ -1,6 +1,7 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 export {
+  approveProjectExecution,
   attachGroupToProject,
   attachGroupToUser,
   attachProjectToGroup,
@@ -8,6 +9,8 @@ export {
   attachUserToGroup,
   attachUserToProject,
   callbackProvider,
+  claimRunnerExecution,
+  createGlobalJoin,
   createGlobalRunner,
   createGroup,
   createProject,
@@ -17,13 +20,16 @@ export {
   createProjectEnvironmentValue,
   createProjectExecution,
   createProjectInventory,
+  createProjectJoin,
   createProjectRepository,
   createProjectRunner,
   createProjectSchedule,
   createProjectTemplate,
   createProjectTemplateSurvey,
   createProjectTemplateVault,
+  createRunnerOutput,
   createUser,
+  deleteGlobalJoin,
   deleteGlobalRunner,
   deleteGroup,
   deleteGroupFromProject,
@@ -37,6 +43,7 @@ export {
   deleteProjectFromGroup,
   deleteProjectFromUser,
   deleteProjectInventory,
+  deleteProjectJoin,
   deleteProjectRepository,
   deleteProjectRunner,
   deleteProjectSchedule,
@@ -46,7 +53,10 @@ export {
   deleteUser,
   deleteUserFromGroup,
   deleteUserFromProject,
+  heartbeatRunner,
+  joinRunner,
   listGlobalEvents,
+  listGlobalJoins,
   listGlobalRunners,
   listGroupProjects,
   listGroups,
@@ -57,9 +67,11 @@ export {
   listProjectExecutions,
   listProjectGroups,
   listProjectInventories,
+  listProjectJoins,
   listProjectRepositories,
   listProjectRunners,
   listProjects,
+  listProjectScheduleRuns,
   listProjectSchedules,
   listProjectTemplates,
   listProjectUsers,
@@ -79,7 +91,14 @@ export {
   purgeProjectExecution,
   redirectAuth,
   refreshAuth,
+  registerRunner,
+  rejectProjectExecution,
   requestProvider,
+  rerunProjectExecution,
+  revokeGlobalRunner,
+  revokeProjectRunner,
+  rotateGlobalRunner,
+  rotateProjectRunner,
   showGlobalRunner,
   showGroup,
   showProfile,
@@ -92,7 +111,9 @@ export {
   showProjectRunner,
   showProjectSchedule,
   showProjectTemplate,
+  showRunnerExecution,
   showUser,
+  stopProjectExecution,
   tokenProfile,
   updateGlobalRunner,
   updateGroup,
@@ -109,10 +130,16 @@ export {
   updateProjectTemplate,
   updateProjectTemplateSurvey,
   updateProjectTemplateVault,
+  updateRunnerExecution,
   updateUser,
   verifyAuth,
 } from './sdk.gen'
 export type {
+  ApproveProjectExecutionData,
+  ApproveProjectExecutionError,
+  ApproveProjectExecutionErrors,
+  ApproveProjectExecutionResponse,
+  ApproveProjectExecutionResponses,
   AttachGroupToProjectData,
   AttachGroupToProjectError,
   AttachGroupToProjectErrors,
@@ -152,7 +179,18 @@ export type {
   CallbackProviderData,
   CallbackProviderError,
   CallbackProviderErrors,
+  ClaimRunnerExecutionData,
+  ClaimRunnerExecutionError,
+  ClaimRunnerExecutionErrors,
+  ClaimRunnerExecutionResponse,
+  ClaimRunnerExecutionResponses,
   ClientOptions,
+  CreateGlobalJoinBody,
+  CreateGlobalJoinData,
+  CreateGlobalJoinError,
+  CreateGlobalJoinErrors,
+  CreateGlobalJoinResponse,
+  CreateGlobalJoinResponses,
   CreateGlobalRunnerBody,
   CreateGlobalRunnerData,
   CreateGlobalRunnerError,
@@ -205,6 +243,12 @@ export type {
   CreateProjectInventoryErrors,
   CreateProjectInventoryResponse,
   CreateProjectInventoryResponses,
+  CreateProjectJoinBody,
+  CreateProjectJoinData,
+  CreateProjectJoinError,
+  CreateProjectJoinErrors,
+  CreateProjectJoinResponse,
+  CreateProjectJoinResponses,
   CreateProjectRepositoryBody,
   CreateProjectRepositoryData,
   CreateProjectRepositoryError,
@@ -243,6 +287,12 @@ export type {
   CreateProjectTemplateVaultErrors,
   CreateProjectTemplateVaultResponse,
   CreateProjectTemplateVaultResponses,
+  CreateRunnerOutputBody,
+  CreateRunnerOutputData,
+  CreateRunnerOutputError,
+  CreateRunnerOutputErrors,
+  CreateRunnerOutputResponse,
+  CreateRunnerOutputResponses,
   CreateUserBody,
   CreateUserData,
   CreateUserError,
@@ -254,6 +304,11 @@ export type {
   CredentialParam,
   CredentialShell,
   CredentialWritable,
+  DeleteGlobalJoinData,
+  DeleteGlobalJoinError,
+  DeleteGlobalJoinErrors,
+  DeleteGlobalJoinResponse,
+  DeleteGlobalJoinResponses,
   DeleteGlobalRunnerData,
   DeleteGlobalRunnerError,
   DeleteGlobalRunnerErrors,
@@ -317,6 +372,11 @@ export type {
   DeleteProjectInventoryErrors,
   DeleteProjectInventoryResponse,
   DeleteProjectInventoryResponses,
+  DeleteProjectJoinData,
+  DeleteProjectJoinError,
+  DeleteProjectJoinErrors,
+  DeleteProjectJoinResponse,
+  DeleteProjectJoinResponses,
   DeleteProjectRepositoryData,
   DeleteProjectRepositoryError,
   DeleteProjectRepositoryErrors,
@@ -383,14 +443,31 @@ export type {
   GroupUserDropBody,
   GroupUserPermBody,
   GroupWritable,
+  HeartbeatRunnerData,
+  HeartbeatRunnerError,
+  HeartbeatRunnerErrors,
+  HeartbeatRunnerResponse,
+  HeartbeatRunnerResponses,
   Inventory,
   InventoryParam,
   InventoryWritable,
+  Join,
+  JoinRunnerBody,
+  JoinRunnerData,
+  JoinRunnerError,
+  JoinRunnerErrors,
+  JoinRunnerResponse,
+  JoinRunnerResponses,
   ListGlobalEventsData,
   ListGlobalEventsError,
   ListGlobalEventsErrors,
   ListGlobalEventsResponse,
   ListGlobalEventsResponses,
+  ListGlobalJoinsData,
+  ListGlobalJoinsError,
+  ListGlobalJoinsErrors,
+  ListGlobalJoinsResponse,
+  ListGlobalJoinsResponses,
   ListGlobalRunnersData,
   ListGlobalRunnersError,
   ListGlobalRunnersErrors,
@@ -441,6 +518,11 @@ export type {
   ListProjectInventoriesErrors,
   ListProjectInventoriesResponse,
   ListProjectInventoriesResponses,
+  ListProjectJoinsData,
+  ListProjectJoinsError,
+  ListProjectJoinsErrors,
+  ListProjectJoinsResponse,
+  ListProjectJoinsResponses,
   ListProjectRepositoriesData,
   ListProjectRepositoriesError,
   ListProjectRepositoriesErrors,
@@ -451,6 +533,11 @@ export type {
   ListProjectRunnersErrors,
   ListProjectRunnersResponse,
   ListProjectRunnersResponses,
+  ListProjectScheduleRunsData,
+  ListProjectScheduleRunsError,
+  ListProjectScheduleRunsErrors,
+  ListProjectScheduleRunsResponse,
+  ListProjectScheduleRunsResponses,
   ListProjectSchedulesData,
   ListProjectSchedulesError,
   ListProjectSchedulesErrors,
@@ -561,17 +648,56 @@ export type {
   RefreshAuthErrors,
   RefreshAuthResponse,
   RefreshAuthResponses,
+  RegisterRunnerBody,
+  RegisterRunnerData,
+  RegisterRunnerError,
+  RegisterRunnerErrors,
+  RegisterRunnerResponse,
+  RegisterRunnerResponses,
+  RejectProjectExecutionData,
+  RejectProjectExecutionError,
+  RejectProjectExecutionErrors,
+  RejectProjectExecutionResponse,
+  RejectProjectExecutionResponses,
   Repository,
   RepositoryParam,
   RepositoryWritable,
   RequestProviderData,
   RequestProviderError,
   RequestProviderErrors,
+  RerunProjectExecutionData,
+  RerunProjectExecutionError,
+  RerunProjectExecutionErrors,
+  RerunProjectExecutionResponse,
+  RerunProjectExecutionResponses,
+  RevokeGlobalRunnerData,
+  RevokeGlobalRunnerError,
+  RevokeGlobalRunnerErrors,
+  RevokeGlobalRunnerResponse,
+  RevokeGlobalRunnerResponses,
+  RevokeProjectRunnerData,
+  RevokeProjectRunnerError,
+  RevokeProjectRunnerErrors,
+  RevokeProjectRunnerResponse,
+  RevokeProjectRunnerResponses,
+  RotateGlobalRunnerData,
+  RotateGlobalRunnerError,
+  RotateGlobalRunnerErrors,
+  RotateGlobalRunnerResponse,
+  RotateGlobalRunnerResponses,
+  RotateProjectRunnerData,
+  RotateProjectRunnerError,
+  RotateProjectRunnerErrors,
+  RotateProjectRunnerResponse,
+  RotateProjectRunnerResponses,
+  RotateRunnerBody,
   Runner,
   RunnerParam,
   RunnerWritable,
   Schedule,
   ScheduleParam,
+  ScheduleRun,
+  ScheduleRunWritable,
   ScheduleWritable,
   SearchQueryParam,
   SecretParam,
@@ -635,6 +761,11 @@ export type {
   ShowProjectTemplateErrors,
   ShowProjectTemplateResponse,
   ShowProjectTemplateResponses,
+  ShowRunnerExecutionData,
+  ShowRunnerExecutionError,
+  ShowRunnerExecutionErrors,
+  ShowRunnerExecutionResponse,
+  ShowRunnerExecutionResponses,
   ShowUserData,
   ShowUserError,
   ShowUserErrors,
@@ -642,6 +773,11 @@ export type {
   ShowUserResponses,
   SortColumnParam,
   SortOrderParam,
+  StopProjectExecutionData,
+  StopProjectExecutionError,
+  StopProjectExecutionErrors,
+  StopProjectExecutionResponse,
+  StopProjectExecutionResponses,
   SurveyParam,
   Template,
   TemplateParam,
@@ -745,6 +881,12 @@ export type {
   UpdateProjectTemplateVaultErrors,
   UpdateProjectTemplateVaultResponse,
   UpdateProjectTemplateVaultResponses,
+  UpdateRunnerExecutionBody,
+  UpdateRunnerExecutionData,
+  UpdateRunnerExecutionError,
+  UpdateRunnerExecutionErrors,
+  UpdateRunnerExecutionResponse,
+  UpdateRunnerExecutionResponses,
   UpdateUserBody,
   UpdateUserData,
   UpdateUserError,
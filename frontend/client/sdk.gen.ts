@@ -9,6 +9,9 @@ import type {
 } from './client'
 import { client } from './client.gen'
 import type {
+  ApproveProjectExecutionData,
+  ApproveProjectExecutionErrors,
+  ApproveProjectExecutionResponses,
   AttachGroupToProjectData,
   AttachGroupToProjectErrors,
   AttachGroupToProjectResponses,
@@ -29,6 +32,12 @@ import type {
   AttachUserToProjectResponses,
   CallbackProviderData,
   CallbackProviderErrors,
+  ClaimRunnerExecutionData,
+  ClaimRunnerExecutionErrors,
+  ClaimRunnerExecutionResponses,
+  CreateGlobalJoinData,
+  CreateGlobalJoinErrors,
+  CreateGlobalJoinResponses,
   CreateGlobalRunnerData,
   CreateGlobalRunnerErrors,
   CreateGlobalRunnerResponses,
@@ -55,6 +64,9 @@ import type {
   CreateProjectInventoryData,
   CreateProjectInventoryErrors,
   CreateProjectInventoryResponses,
+  CreateProjectJoinData,
+  CreateProjectJoinErrors,
+  CreateProjectJoinResponses,
   CreateProjectRepositoryData,
   CreateProjectRepositoryErrors,
   CreateProjectRepositoryResponses,
@@ -74,9 +86,15 @@ import type {
   CreateProjectTemplateVaultData,
   CreateProjectTemplateVaultErrors,
   CreateProjectTemplateVaultResponses,
+  CreateRunnerOutputData,
+  CreateRunnerOutputErrors,
+  CreateRunnerOutputResponses,
   CreateUserData,
   CreateUserErrors,
   CreateUserResponses,
+  DeleteGlobalJoinData,
+  DeleteGlobalJoinErrors,
+  DeleteGlobalJoinResponses,
   DeleteGlobalRunnerData,
   DeleteGlobalRunnerErrors,
   DeleteGlobalRunnerResponses,
@@ -115,6 +133,9 @@ import type {
   DeleteProjectInventoryData,
   DeleteProjectInventoryErrors,
   DeleteProjectInventoryResponses,
+  DeleteProjectJoinData,
+  DeleteProjectJoinErrors,
+  DeleteProjectJoinResponses,
   DeleteProjectRepositoryData,
   DeleteProjectRepositoryErrors,
   DeleteProjectRepositoryResponses,
@@ -143,9 +164,18 @@ import type {
   DeleteUserFromProjectErrors,
   DeleteUserFromProjectResponses,
   DeleteUserResponses,
+  HeartbeatRunnerData,
+  HeartbeatRunnerErrors,
+  HeartbeatRunnerResponses,
+  JoinRunnerData,
+  JoinRunnerErrors,
+  JoinRunnerResponses,
   ListGlobalEventsData,
   ListGlobalEventsErrors,
   ListGlobalEventsResponses,
+  ListGlobalJoinsData,
+  ListGlobalJoinsErrors,
+  ListGlobalJoinsResponses,
   ListGlobalRunnersData,
   ListGlobalRunnersErrors,
   ListGlobalRunnersResponses,
@@ -176,12 +206,18 @@ import type {
   ListProjectInventoriesData,
   ListProjectInventoriesErrors,
   ListProjectInventoriesResponses,
+  ListProjectJoinsData,
+  ListProjectJoinsErrors,
+  ListProjectJoinsResponses,
   ListProjectRepositoriesData,
   ListProjectRepositoriesErrors,
   ListProjectRepositoriesResponses,
   ListProjectRunnersData,
   ListProjectRunnersErrors,
   ListProjectRunnersResponses,
+  ListProjectScheduleRunsData,
+  ListProjectScheduleRunsErrors,
+  ListProjectScheduleRunsResponses,
   ListProjectSchedulesData,
   ListProjectSchedulesErrors,
   ListProjectSchedulesResponses,
@@ -238,8 +274,29 @@ import type {
   RefreshAuthData,
   RefreshAuthErrors,
   RefreshAuthResponses,
+  RegisterRunnerData,
+  RegisterRunnerErrors,
+  RegisterRunnerResponses,
+  RejectProjectExecutionData,
+  RejectProjectExecutionErrors,
+  RejectProjectExecutionResponses,
   RequestProviderData,
   RequestProviderErrors,
+  RerunProjectExecutionData,
+  RerunProjectExecutionErrors,
+  RerunProjectExecutionResponses,
+  RevokeGlobalRunnerData,
+  RevokeGlobalRunnerErrors,
+  RevokeGlobalRunnerResponses,
+  RevokeProjectRunnerData,
+  RevokeProjectRunnerErrors,
+  RevokeProjectRunnerResponses,
+  RotateGlobalRunnerData,
+  RotateGlobalRunnerErrors,
+  RotateGlobalRunnerResponses,
+  RotateProjectRunnerData,
+  RotateProjectRunnerErrors,
+  RotateProjectRunnerResponses,
   ShowGlobalRunnerData,
   ShowGlobalRunnerErrors,
   ShowGlobalRunnerResponses,
@@ -276,9 +333,15 @@ import type {
   ShowProjectTemplateData,
   ShowProjectTemplateErrors,
   ShowProjectTemplateResponses,
+  ShowRunnerExecutionData,
+  ShowRunnerExecutionErrors,
+  ShowRunnerExecutionResponses,
   ShowUserData,
   ShowUserErrors,
   ShowUserResponses,
+  StopProjectExecutionData,
+  StopProjectExecutionErrors,
+  StopProjectExecutionResponses,
   TokenProfileData,
   TokenProfileErrors,
   TokenProfileResponses,
@@ -327,6 +390,9 @@ import type {
   UpdateProjectTemplateVaultData,
   UpdateProjectTemplateVaultErrors,
   UpdateProjectTemplateVaultResponses,
+  UpdateRunnerExecutionData,
+  UpdateRunnerExecutionErrors,
+  UpdateRunnerExecutionResponses,
   UpdateUserData,
   UpdateUserErrors,
   UpdateUserResponses,
@@ -1004,6 +1070,134 @@ export const updateProjectRunner = <ThrowOnError extends boolean = false>(
     },
   })
 
+/**
+ * Rotate the token of a specific runner for a project
+ */
+export const rotateProjectRunner = <ThrowOnError extends boolean = false>(
+  options: Options<RotateProjectRunnerData, ThrowOnError>
+): RequestResult<
+  RotateProjectRunnerResponses,
+  RotateProjectRunnerErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RotateProjectRunnerResponses,
+    RotateProjectRunnerErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/runners/{runner_id}/rotate',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Revoke the token of a specific runner for a project
+ */
+export const revokeProjectRunner = <ThrowOnError extends boolean = false>(
+  options: Options<RevokeProjectRunnerData, ThrowOnError>
+): RequestResult<
+  RevokeProjectRunnerResponses,
+  RevokeProjectRunnerErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RevokeProjectRunnerResponses,
+    RevokeProjectRunnerErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/runners/{runner_id}/revoke',
+    ...options,
+  })
+
+/**
+ * Fetch all join tokens for a project
+ */
+export const listProjectJoins = <ThrowOnError extends boolean = false>(
+  options: Options<ListProjectJoinsData, ThrowOnError>
+): RequestResult<
+  ListProjectJoinsResponses,
+  ListProjectJoinsErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).get<
+    ListProjectJoinsResponses,
+    ListProjectJoinsErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/joins',
+    ...options,
+  })
+
+/**
+ * Create a new join token
+ */
+export const createProjectJoin = <ThrowOnError extends boolean = false>(
+  options: Options<CreateProjectJoinData, ThrowOnError>
+): RequestResult<
+  CreateProjectJoinResponses,
+  CreateProjectJoinErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    CreateProjectJoinResponses,
+    CreateProjectJoinErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/joins',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Delete a specific join token for a project
+ */
+export const deleteProjectJoin = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteProjectJoinData, ThrowOnError>
+): RequestResult<
+  DeleteProjectJoinResponses,
+  DeleteProjectJoinErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).delete<
+    DeleteProjectJoinResponses,
+    DeleteProjectJoinErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/joins/{join_id}',
+    ...options,
+  })
+
 /**
  * Fetch all credentials for a project
  */
@@ -2116,6 +2310,30 @@ export const updateProjectSchedule = <ThrowOnError extends boolean = false>(
     },
   })
 
+/**
+ * Fetch the run history of a schedule for a project
+ */
+export const listProjectScheduleRuns = <ThrowOnError extends boolean = false>(
+  options: Options<ListProjectScheduleRunsData, ThrowOnError>
+): RequestResult<
+  ListProjectScheduleRunsResponses,
+  ListProjectScheduleRunsErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).get<
+    ListProjectScheduleRunsResponses,
+    ListProjectScheduleRunsErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/schedules/{schedule_id}/runs',
+    ...options,
+  })
+
 /**
  * Fetch all executions for a project
  */
@@ -2264,6 +2482,102 @@ export const outputProjectExecution = <ThrowOnError extends boolean = false>(
     ...options,
   })
 
+/**
+ * Approve a specific execution awaiting confirmation
+ */
+export const approveProjectExecution = <ThrowOnError extends boolean = false>(
+  options: Options<ApproveProjectExecutionData, ThrowOnError>
+): RequestResult<
+  ApproveProjectExecutionResponses,
+  ApproveProjectExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    ApproveProjectExecutionResponses,
+    ApproveProjectExecutionErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/executions/{execution_id}/approve',
+    ...options,
+  })
+
+/**
+ * Reject a specific execution awaiting confirmation
+ */
+export const rejectProjectExecution = <ThrowOnError extends boolean = false>(
+  options: Options<RejectProjectExecutionData, ThrowOnError>
+): RequestResult<
+  RejectProjectExecutionResponses,
+  RejectProjectExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RejectProjectExecutionResponses,
+    RejectProjectExecutionErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/executions/{execution_id}/reject',
+    ...options,
+  })
+
+/**
+ * Rerun a specific execution with the same parameters
+ */
+export const rerunProjectExecution = <ThrowOnError extends boolean = false>(
+  options: Options<RerunProjectExecutionData, ThrowOnError>
+): RequestResult<
+  RerunProjectExecutionResponses,
+  RerunProjectExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RerunProjectExecutionResponses,
+    RerunProjectExecutionErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/executions/{execution_id}/rerun',
+    ...options,
+  })
+
+/**
+ * Stop a specific execution for a project
+ */
+export const stopProjectExecution = <ThrowOnError extends boolean = false>(
+  options: Options<StopProjectExecutionData, ThrowOnError>
+): RequestResult<
+  StopProjectExecutionResponses,
+  StopProjectExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    StopProjectExecutionResponses,
+    StopProjectExecutionErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/projects/{project_id}/executions/{execution_id}/stop',
+    ...options,
+  })
+
 /**
  * Fetch all events
  */
@@ -2416,6 +2730,281 @@ export const updateGlobalRunner = <ThrowOnError extends boolean = false>(
     },
   })
 
+/**
+ * Claim the next waiting execution
+ */
+export const claimRunnerExecution = <ThrowOnError extends boolean = false>(
+  options?: Options<ClaimRunnerExecutionData, ThrowOnError>
+): RequestResult<
+  ClaimRunnerExecutionResponses,
+  ClaimRunnerExecutionErrors,
+  ThrowOnError
+> =>
+  (options?.client ?? client).post<
+    ClaimRunnerExecutionResponses,
+    ClaimRunnerExecutionErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/executions',
+    ...options,
+  })
+
+/**
+ * Fetch a specific claimed execution
+ */
+export const showRunnerExecution = <ThrowOnError extends boolean = false>(
+  options: Options<ShowRunnerExecutionData, ThrowOnError>
+): RequestResult<
+  ShowRunnerExecutionResponses,
+  ShowRunnerExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).get<
+    ShowRunnerExecutionResponses,
+    ShowRunnerExecutionErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/executions/{execution_id}',
+    ...options,
+  })
+
+/**
+ * Update the status of a claimed execution
+ */
+export const updateRunnerExecution = <ThrowOnError extends boolean = false>(
+  options: Options<UpdateRunnerExecutionData, ThrowOnError>
+): RequestResult<
+  UpdateRunnerExecutionResponses,
+  UpdateRunnerExecutionErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).put<
+    UpdateRunnerExecutionResponses,
+    UpdateRunnerExecutionErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/executions/{execution_id}',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Append output to a claimed execution
+ */
+export const createRunnerOutput = <ThrowOnError extends boolean = false>(
+  options: Options<CreateRunnerOutputData, ThrowOnError>
+): RequestResult<
+  CreateRunnerOutputResponses,
+  CreateRunnerOutputErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    CreateRunnerOutputResponses,
+    CreateRunnerOutputErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/executions/{execution_id}/output',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Send a heartbeat for the authenticated runner
+ */
+export const heartbeatRunner = <ThrowOnError extends boolean = false>(
+  options?: Options<HeartbeatRunnerData, ThrowOnError>
+): RequestResult<
+  HeartbeatRunnerResponses,
+  HeartbeatRunnerErrors,
+  ThrowOnError
+> =>
+  (options?.client ?? client).post<
+    HeartbeatRunnerResponses,
+    HeartbeatRunnerErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/heartbeat',
+    ...options,
+  })
+
+/**
+ * Rotate the token of a specific runner
+ */
+export const rotateGlobalRunner = <ThrowOnError extends boolean = false>(
+  options: Options<RotateGlobalRunnerData, ThrowOnError>
+): RequestResult<
+  RotateGlobalRunnerResponses,
+  RotateGlobalRunnerErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RotateGlobalRunnerResponses,
+    RotateGlobalRunnerErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/runners/{runner_id}/rotate',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Revoke the token of a specific runner
+ */
+export const revokeGlobalRunner = <ThrowOnError extends boolean = false>(
+  options: Options<RevokeGlobalRunnerData, ThrowOnError>
+): RequestResult<
+  RevokeGlobalRunnerResponses,
+  RevokeGlobalRunnerErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    RevokeGlobalRunnerResponses,
+    RevokeGlobalRunnerErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/runners/{runner_id}/revoke',
+    ...options,
+  })
+
+/**
+ * Fetch all join tokens
+ */
+export const listGlobalJoins = <ThrowOnError extends boolean = false>(
+  options?: Options<ListGlobalJoinsData, ThrowOnError>
+): RequestResult<
+  ListGlobalJoinsResponses,
+  ListGlobalJoinsErrors,
+  ThrowOnError
+> =>
+  (options?.client ?? client).get<
+    ListGlobalJoinsResponses,
+    ListGlobalJoinsErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/joins',
+    ...options,
+  })
+
+/**
+ * Create a new global join token
+ */
+export const createGlobalJoin = <ThrowOnError extends boolean = false>(
+  options: Options<CreateGlobalJoinData, ThrowOnError>
+): RequestResult<
+  CreateGlobalJoinResponses,
+  CreateGlobalJoinErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).post<
+    CreateGlobalJoinResponses,
+    CreateGlobalJoinErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/joins',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Delete a specific join token
+ */
+export const deleteGlobalJoin = <ThrowOnError extends boolean = false>(
+  options: Options<DeleteGlobalJoinData, ThrowOnError>
+): RequestResult<
+  DeleteGlobalJoinResponses,
+  DeleteGlobalJoinErrors,
+  ThrowOnError
+> =>
+  (options.client ?? client).delete<
+    DeleteGlobalJoinResponses,
+    DeleteGlobalJoinErrors,
+    ThrowOnError
+  >({
+    security: [
+      { name: 'X-API-Key', type: 'apiKey' },
+      { scheme: 'bearer', type: 'http' },
+      { scheme: 'basic', type: 'http' },
+    ],
+    url: '/joins/{join_id}',
+    ...options,
+  })
+
+/**
+ * Exchange a join token for a new runner
+ */
+export const joinRunner = <ThrowOnError extends boolean = false>(
+  options: Options<JoinRunnerData, ThrowOnError>
+): RequestResult<JoinRunnerResponses, JoinRunnerErrors, ThrowOnError> =>
+  (options.client ?? client).post<
+    JoinRunnerResponses,
+    JoinRunnerErrors,
+    ThrowOnError
+  >({
+    url: '/runner/join',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
+/**
+ * Register the authenticated runner
+ */
+export const registerRunner = <ThrowOnError extends boolean = false>(
+  options: Options<RegisterRunnerData, ThrowOnError>
+): RequestResult<RegisterRunnerResponses, RegisterRunnerErrors, ThrowOnError> =>
+  (options.client ?? client).post<
+    RegisterRunnerResponses,
+    RegisterRunnerErrors,
+    ThrowOnError
+  >({
+    security: [{ name: 'X-Runner-Token', type: 'apiKey' }],
+    url: '/runner/register',
+    ...options,
+    headers: {
+      'Content-Type': 'application/json',
+      ...options.headers,
+    },
+  })
+
 /**
  * Fetch all available groups
  */
@@ -56,6 +56,7 @@ export type Event = {
     | 'group_user'
     | 'group'
     | 'inventory'
+    | 'join_token'
     | 'project_group'
     | 'project_user'
     | 'project'
@@ -66,7 +67,17 @@ export type Event = {
     | 'user_group'
     | 'user_project'
     | 'user'
-  action?: 'create' | 'update' | 'delete'
+  action?:
+    | 'create'
+    | 'update'
+    | 'delete'
+    | 'approve'
+    | 'reject'
+    | 'rotate'
+    | 'revoke'
+    | 'rerun'
+    | 'retry'
+    | 'trigger'
   readonly created_at?: string
 }
 
@@ -168,7 +179,7 @@ export type Inventory = {
   become?: Credential
   slug?: string
   name?: string
-  kind?: 'static' | 'file'
+  kind?: 'static' | 'file' | 'workspace'
   content?: string
   readonly created_at?: string
   readonly updated_at?: string
@@ -236,7 +247,15 @@ export type Template = {
   limit?: string
   executor?: string
   branch?: string
+  image?: string
+  labels?: {
+    [key: string]: string
+  }
   allow_override?: boolean
+  sequential?: boolean
+  retry_attempts?: number
+  retry_backoff?: number
+  retry_codes?: Array<number>
   surveys?: Array<TemplateSurvey>
   vaults?: Array<TemplateVault>
   readonly created_at?: string
@@ -296,11 +315,35 @@ export type Schedule = {
   slug?: string
   name?: string
   cron?: string
+  timezone?: string
+  missed?: 'skip' | 'once' | 'all'
+  run_at?: string
+  start_at?: string
+  end_at?: string
   active?: boolean
+  readonly next_runs?: Array<string>
+  readonly last_run_at?: string
+  readonly last_status?: string
+  readonly last_execution_id?: string
   readonly created_at?: string
   readonly updated_at?: string
 }
 
+/**
+ * ScheduleRun
+ *
+ * Model to represent schedule run
+ */
+export type ScheduleRun = {
+  readonly id?: string
+  execution_id?: string
+  readonly status?: string
+  readonly message?: string
+  readonly triggered_at?: string
+  readonly finished_at?: string
+  readonly created_at?: string
+}
+
 /**
  * Runner
  *
@@ -313,6 +356,38 @@ export type Runner = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
+  readonly capacity?: number
+  readonly version?: string
+  readonly hostname?: string
+  readonly os?: string
+  readonly arch?: string
+  readonly last_seen_at?: string
+  readonly rotated_at?: string
+  readonly grace_expires_at?: string
+  readonly created_at?: string
+  readonly updated_at?: string
+}
+
+/**
+ * Join
+ *
+ * Model to represent join token
+ */
+export type Join = {
+  id?: string
+  project_id?: string
+  project?: Project
+  name?: string
+  readonly token?: string
+  uses?: number
+  readonly used?: number
+  labels?: {
+    [key: string]: string
+  }
+  expires_at?: string
   readonly created_at?: string
   readonly updated_at?: string
 }
@@ -327,14 +402,30 @@ export type Execution = {
   project_id?: string
   template_id?: string
   template?: Template
+  runner_id?: string
+  runner?: Runner
+  readonly parent_id?: string
+  readonly schedule_id?: string
+  readonly attempt?: number
+  priority?: number
+  readonly queue_position?: number
+  readonly not_before?: string
   name?: string
   status?: string
   debug?: boolean
   path?: string
+  arguments?: string
   environment?: string
   secret?: string
+  answers?: {
+    [key: string]: string
+  }
   limit?: string
   branch?: string
+  readonly commit_sha?: string
+  readonly exit_code?: number
+  readonly started_at?: string
+  readonly finished_at?: string
   readonly created_at?: string
   readonly updated_at?: string
 }
@@ -529,6 +620,7 @@ export type EventWritable = {
     | 'group_user'
     | 'group'
     | 'inventory'
+    | 'join_token'
     | 'project_group'
     | 'project_user'
     | 'project'
@@ -539,7 +631,17 @@ export type EventWritable = {
     | 'user_group'
     | 'user_project'
     | 'user'
-  action?: 'create' | 'update' | 'delete'
+  action?:
+    | 'create'
+    | 'update'
+    | 'delete'
+    | 'approve'
+    | 'reject'
+    | 'rotate'
+    | 'revoke'
+    | 'rerun'
+    | 'retry'
+    | 'trigger'
 }
 
 /**
@@ -606,7 +708,7 @@ export type InventoryWritable = {
   become_id?: string
   slug?: string
   name?: string
-  kind?: 'static' | 'file'
+  kind?: 'static' | 'file' | 'workspace'
   content?: string
 }
 
@@ -643,7 +745,15 @@ export type TemplateWritable = {
   limit?: string
   executor?: string
   branch?: string
+  image?: string
+  labels?: {
+    [key: string]: string
+  }
   allow_override?: boolean
+  sequential?: boolean
+  retry_attempts?: number
+  retry_backoff?: number
+  retry_codes?: Array<number>
   surveys?: Array<TemplateSurvey>
   vaults?: Array<TemplateVaultWritable>
 }
@@ -673,9 +783,23 @@ export type ScheduleWritable = {
   slug?: string
   name?: string
   cron?: string
+  timezone?: string
+  missed?: 'skip' | 'once' | 'all'
+  run_at?: string
+  start_at?: string
+  end_at?: string
   active?: boolean
 }
 
+/**
+ * ScheduleRun
+ *
+ * Model to represent schedule run
+ */
+export type ScheduleRunWritable = {
+  execution_id?: string
+}
+
 /**
  * Runner
  *
@@ -687,6 +811,9 @@ export type RunnerWritable = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
 }
 
 /**
@@ -698,12 +825,17 @@ export type ExecutionWritable = {
   id?: string
   project_id?: string
   template_id?: string
+  priority?: number
   name?: string
   status?: string
   debug?: boolean
   path?: string
+  arguments?: string
   environment?: string
   secret?: string
+  answers?: {
+    [key: string]: string
+  }
   limit?: string
   branch?: string
 }
@@ -972,6 +1104,21 @@ export type CreateProjectRunnerBody = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
+}
+
+/**
+ * The join token data to create
+ */
+export type CreateProjectJoinBody = {
+  name?: string
+  uses?: number
+  expires_at?: string
+  labels?: {
+    [key: string]: string
+  }
 }
 
 /**
@@ -981,6 +1128,9 @@ export type UpdateProjectRunnerBody = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
 }
 
 /**
@@ -1126,7 +1276,15 @@ export type CreateProjectTemplateBody = {
   limit?: string
   executor?: string
   branch?: string
+  image?: string
+  labels?: {
+    [key: string]: string
+  }
   allow_override?: boolean
+  sequential?: boolean
+  retry_attempts?: number
+  retry_backoff?: number
+  retry_codes?: Array<number>
   surveys?: Array<TemplateSurvey>
   vaults?: Array<TemplateVaultWritable>
 }
@@ -1145,7 +1303,15 @@ export type UpdateProjectTemplateBody = {
   arguments?: string
   limit?: string
   branch?: string
+  image?: string
+  labels?: {
+    [key: string]: string
+  }
   allow_override?: boolean
+  sequential?: boolean
+  retry_attempts?: number
+  retry_backoff?: number
+  retry_codes?: Array<number>
   surveys?: Array<TemplateSurvey>
   vaults?: Array<TemplateVaultWritable>
 }
@@ -1202,6 +1368,11 @@ export type CreateProjectScheduleBody = {
   slug?: string
   name?: string
   cron?: string
+  timezone?: string
+  missed?: string
+  run_at?: string
+  start_at?: string
+  end_at?: string
   active?: boolean
 }
 
@@ -1213,6 +1384,11 @@ export type UpdateProjectScheduleBody = {
   slug?: string
   name?: string
   cron?: string
+  timezone?: string
+  missed?: string
+  run_at?: string
+  start_at?: string
+  end_at?: string
   active?: boolean
 }
 
@@ -1222,6 +1398,40 @@ export type UpdateProjectScheduleBody = {
 export type CreateProjectExecutionBody = {
   template_id?: string
   debug?: boolean
+  /**
+   * Branch to checkout instead of the template branch
+   */
+  branch?: string
+  /**
+   * Limit to apply instead of the template limit
+   */
+  limit?: string
+  /**
+   * Path to run instead of the template path
+   */
+  path?: string
+  /**
+   * Arguments to pass instead of the template arguments
+   */
+  arguments?: string
+  /**
+   * JSON object of extra environment variables
+   */
+  environment?: string
+  /**
+   * JSON object of extra secret environment variables
+   */
+  secret?: string
+  /**
+   * Priority within the queue, defaults to 50 for manual executions
+   */
+  priority?: number
+  /**
+   * Answers for the survey of the template
+   */
+  answers?: {
+    [key: string]: string
+  }
 }
 
 /**
@@ -1232,6 +1442,22 @@ export type CreateGlobalRunnerBody = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
+}
+
+/**
+ * The join token data to create
+ */
+export type CreateGlobalJoinBody = {
+  project_id?: string
+  name?: string
+  uses?: number
+  expires_at?: string
+  labels?: {
+    [key: string]: string
+  }
 }
 
 /**
@@ -1242,6 +1468,54 @@ export type UpdateGlobalRunnerBody = {
   slug?: string
   name?: string
   token?: string
+  labels?: {
+    [key: string]: string
+  }
+}
+
+/**
+ * The rotation details for the runner token
+ */
+export type RotateRunnerBody = {
+  /**
+   * Seconds the previous token stays valid
+   */
+  grace?: number
+}
+
+/**
+ * The join token to exchange
+ */
+export type JoinRunnerBody = {
+  token: string
+  name?: string
+}
+
+/**
+ * The runner details to register
+ */
+export type RegisterRunnerBody = {
+  capacity?: number
+  version?: string
+  hostname?: string
+  os?: string
+  arch?: string
+}
+
+/**
+ * The execution status to update
+ */
+export type UpdateRunnerExecutionBody = {
+  status: string
+  exit_code?: number
+  commit_sha?: string
+}
+
+/**
+ * The execution output to append
+ */
+export type CreateRunnerOutputBody = {
+  content: string
 }
 
 /**
@@ -2671,89 +2945,26 @@ export type UpdateProjectRunnerResponses = {
 export type UpdateProjectRunnerResponse =
   UpdateProjectRunnerResponses[keyof UpdateProjectRunnerResponses]
 
-export type ListProjectCredentialsData = {
-  body?: never
-  path: {
-    /**
-     * A project identifier or slug
-     */
-    project_id: string
-  }
-  query?: {
-    /**
-     * Search query
-     */
-    search?: string
-    /**
-     * Sorting column
-     */
-    sort?: string
-    /**
-     * Sorting order
-     */
-    order?: 'asc' | 'desc'
-    /**
-     * Paging limit
-     */
-    limit?: number
-    /**
-     * Paging offset
-     */
-    offset?: number
-  }
-  url: '/projects/{project_id}/credentials'
-}
-
-export type ListProjectCredentialsErrors = {
-  /**
-   * User is not authorized
-   */
-  403: Notification
-  /**
-   * Resource not found
-   */
-  404: Notification
-  /**
-   * Some internal server error
-   */
-  500: Notification
-}
-
-export type ListProjectCredentialsError =
-  ListProjectCredentialsErrors[keyof ListProjectCredentialsErrors]
-
-export type ListProjectCredentialsResponses = {
-  /**
-   * A collection of credentials for a project
-   */
-  200: {
-    total: number
-    limit: number
-    offset: number
-    project?: Project
-    credentials: Array<Credential>
-  }
-}
-
-export type ListProjectCredentialsResponse =
-  ListProjectCredentialsResponses[keyof ListProjectCredentialsResponses]
-
-export type CreateProjectCredentialData = {
+export type RotateProjectRunnerData = {
   /**
-   * The credential data to create
+   * The rotation details for the runner token
    */
-  body: CreateProjectCredentialBody
+  body?: RotateRunnerBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+    /**
+     * A runner identifier or slug
+     */
+    runner_id: string
   }
   query?: never
-  url: '/projects/{project_id}/credentials'
+  url: '/projects/{project_id}/runners/{runner_id}/rotate'
 }
 
-export type CreateProjectCredentialErrors = {
+export type RotateProjectRunnerErrors = {
   /**
    * Failed to parse request
    */
@@ -2776,20 +2987,20 @@ export type CreateProjectCredentialErrors = {
   500: Notification
 }
 
-export type CreateProjectCredentialError =
-  CreateProjectCredentialErrors[keyof CreateProjectCredentialErrors]
+export type RotateProjectRunnerError =
+  RotateProjectRunnerErrors[keyof RotateProjectRunnerErrors]
 
-export type CreateProjectCredentialResponses = {
+export type RotateProjectRunnerResponses = {
   /**
-   * The details for a credential of a project
+   * The details for a runner of a project
    */
-  200: Credential
+  200: Runner
 }
 
-export type CreateProjectCredentialResponse =
-  CreateProjectCredentialResponses[keyof CreateProjectCredentialResponses]
+export type RotateProjectRunnerResponse =
+  RotateProjectRunnerResponses[keyof RotateProjectRunnerResponses]
 
-export type DeleteProjectCredentialData = {
+export type RevokeProjectRunnerData = {
   body?: never
   path: {
     /**
@@ -2797,19 +3008,15 @@ export type DeleteProjectCredentialData = {
      */
     project_id: string
     /**
-     * A credential identifier or slug
+     * A runner identifier or slug
      */
-    credential_id: string
+    runner_id: string
   }
   query?: never
-  url: '/projects/{project_id}/credentials/{credential_id}'
+  url: '/projects/{project_id}/runners/{runner_id}/revoke'
 }
 
-export type DeleteProjectCredentialErrors = {
-  /**
-   * Failed to execute action for resource
-   */
-  400: Notification
+export type RevokeProjectRunnerErrors = {
   /**
    * User is not authorized
    */
@@ -2818,42 +3025,55 @@ export type DeleteProjectCredentialErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectCredentialError =
-  DeleteProjectCredentialErrors[keyof DeleteProjectCredentialErrors]
+export type RevokeProjectRunnerError =
+  RevokeProjectRunnerErrors[keyof RevokeProjectRunnerErrors]
 
-export type DeleteProjectCredentialResponses = {
+export type RevokeProjectRunnerResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteProjectCredentialResponse =
-  DeleteProjectCredentialResponses[keyof DeleteProjectCredentialResponses]
+export type RevokeProjectRunnerResponse =
+  RevokeProjectRunnerResponses[keyof RevokeProjectRunnerResponses]
 
-export type ShowProjectCredentialData = {
+export type ListProjectJoinsData = {
   body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+  }
+  query?: {
     /**
-     * A credential identifier or slug
+     * Search query
      */
-    credential_id: string
+    search?: string
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
   }
-  query?: never
-  url: '/projects/{project_id}/credentials/{credential_id}'
+  url: '/projects/{project_id}/joins'
 }
 
-export type ShowProjectCredentialErrors = {
+export type ListProjectJoinsErrors = {
   /**
    * User is not authorized
    */
@@ -2868,39 +3088,41 @@ export type ShowProjectCredentialErrors = {
   500: Notification
 }
 
-export type ShowProjectCredentialError =
-  ShowProjectCredentialErrors[keyof ShowProjectCredentialErrors]
+export type ListProjectJoinsError =
+  ListProjectJoinsErrors[keyof ListProjectJoinsErrors]
 
-export type ShowProjectCredentialResponses = {
+export type ListProjectJoinsResponses = {
   /**
-   * The details for a credential of a project
+   * A collection of join tokens for a project
    */
-  200: Credential
+  200: {
+    total: number
+    limit: number
+    offset: number
+    project?: Project
+    joins: Array<Join>
+  }
 }
 
-export type ShowProjectCredentialResponse =
-  ShowProjectCredentialResponses[keyof ShowProjectCredentialResponses]
+export type ListProjectJoinsResponse =
+  ListProjectJoinsResponses[keyof ListProjectJoinsResponses]
 
-export type UpdateProjectCredentialData = {
+export type CreateProjectJoinData = {
   /**
-   * The credential data to update
+   * The join token data to create
    */
-  body: UpdateProjectCredentialBody
+  body: CreateProjectJoinBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-    /**
-     * A credential identifier or slug
-     */
-    credential_id: string
   }
   query?: never
-  url: '/projects/{project_id}/credentials/{credential_id}'
+  url: '/projects/{project_id}/joins'
 }
 
-export type UpdateProjectCredentialErrors = {
+export type CreateProjectJoinErrors = {
   /**
    * Failed to parse request
    */
@@ -2923,33 +3145,81 @@ export type UpdateProjectCredentialErrors = {
   500: Notification
 }
 
-export type UpdateProjectCredentialError =
-  UpdateProjectCredentialErrors[keyof UpdateProjectCredentialErrors]
+export type CreateProjectJoinError =
+  CreateProjectJoinErrors[keyof CreateProjectJoinErrors]
 
-export type UpdateProjectCredentialResponses = {
+export type CreateProjectJoinResponses = {
   /**
-   * The details for a credential of a project
+   * The details for a join token of a project
    */
-  200: Credential
+  200: Join
 }
 
-export type UpdateProjectCredentialResponse =
-  UpdateProjectCredentialResponses[keyof UpdateProjectCredentialResponses]
+export type CreateProjectJoinResponse =
+  CreateProjectJoinResponses[keyof CreateProjectJoinResponses]
 
-export type ListProjectRepositoriesData = {
+export type DeleteProjectJoinData = {
   body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-  }
-  query?: {
     /**
-     * Search query
+     * A join token identifier
      */
-    search?: string
-    /**
+    join_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/joins/{join_id}'
+}
+
+export type DeleteProjectJoinErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type DeleteProjectJoinError =
+  DeleteProjectJoinErrors[keyof DeleteProjectJoinErrors]
+
+export type DeleteProjectJoinResponses = {
+  /**
+   * Plain success message
+   */
+  200: Notification
+}
+
+export type DeleteProjectJoinResponse =
+  DeleteProjectJoinResponses[keyof DeleteProjectJoinResponses]
+
+export type ListProjectCredentialsData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+  }
+  query?: {
+    /**
+     * Search query
+     */
+    search?: string
+    /**
      * Sorting column
      */
     sort?: string
@@ -2966,10 +3236,10 @@ export type ListProjectRepositoriesData = {
      */
     offset?: number
   }
-  url: '/projects/{project_id}/repositories'
+  url: '/projects/{project_id}/credentials'
 }
 
-export type ListProjectRepositoriesErrors = {
+export type ListProjectCredentialsErrors = {
   /**
    * User is not authorized
    */
@@ -2984,30 +3254,30 @@ export type ListProjectRepositoriesErrors = {
   500: Notification
 }
 
-export type ListProjectRepositoriesError =
-  ListProjectRepositoriesErrors[keyof ListProjectRepositoriesErrors]
+export type ListProjectCredentialsError =
+  ListProjectCredentialsErrors[keyof ListProjectCredentialsErrors]
 
-export type ListProjectRepositoriesResponses = {
+export type ListProjectCredentialsResponses = {
   /**
-   * A collection of repositories for a project
+   * A collection of credentials for a project
    */
   200: {
     total: number
     limit: number
     offset: number
     project?: Project
-    repositories: Array<Repository>
+    credentials: Array<Credential>
   }
 }
 
-export type ListProjectRepositoriesResponse =
-  ListProjectRepositoriesResponses[keyof ListProjectRepositoriesResponses]
+export type ListProjectCredentialsResponse =
+  ListProjectCredentialsResponses[keyof ListProjectCredentialsResponses]
 
-export type CreateProjectRepositoryData = {
+export type CreateProjectCredentialData = {
   /**
-   * The repository data to create
+   * The credential data to create
    */
-  body: CreateProjectRepositoryBody
+  body: CreateProjectCredentialBody
   path: {
     /**
      * A project identifier or slug
@@ -3015,10 +3285,10 @@ export type CreateProjectRepositoryData = {
     project_id: string
   }
   query?: never
-  url: '/projects/{project_id}/repositories'
+  url: '/projects/{project_id}/credentials'
 }
 
-export type CreateProjectRepositoryErrors = {
+export type CreateProjectCredentialErrors = {
   /**
    * Failed to parse request
    */
@@ -3041,20 +3311,20 @@ export type CreateProjectRepositoryErrors = {
   500: Notification
 }
 
-export type CreateProjectRepositoryError =
-  CreateProjectRepositoryErrors[keyof CreateProjectRepositoryErrors]
+export type CreateProjectCredentialError =
+  CreateProjectCredentialErrors[keyof CreateProjectCredentialErrors]
 
-export type CreateProjectRepositoryResponses = {
+export type CreateProjectCredentialResponses = {
   /**
-   * The details for a repository of a project
+   * The details for a credential of a project
    */
-  200: Repository
+  200: Credential
 }
 
-export type CreateProjectRepositoryResponse =
-  CreateProjectRepositoryResponses[keyof CreateProjectRepositoryResponses]
+export type CreateProjectCredentialResponse =
+  CreateProjectCredentialResponses[keyof CreateProjectCredentialResponses]
 
-export type DeleteProjectRepositoryData = {
+export type DeleteProjectCredentialData = {
   body?: never
   path: {
     /**
@@ -3062,15 +3332,15 @@ export type DeleteProjectRepositoryData = {
      */
     project_id: string
     /**
-     * A repository identifier or slug
+     * A credential identifier or slug
      */
-    repository_id: string
+    credential_id: string
   }
   query?: never
-  url: '/projects/{project_id}/repositories/{repository_id}'
+  url: '/projects/{project_id}/credentials/{credential_id}'
 }
 
-export type DeleteProjectRepositoryErrors = {
+export type DeleteProjectCredentialErrors = {
   /**
    * Failed to execute action for resource
    */
@@ -3089,20 +3359,20 @@ export type DeleteProjectRepositoryErrors = {
   500: Notification
 }
 
-export type DeleteProjectRepositoryError =
-  DeleteProjectRepositoryErrors[keyof DeleteProjectRepositoryErrors]
+export type DeleteProjectCredentialError =
+  DeleteProjectCredentialErrors[keyof DeleteProjectCredentialErrors]
 
-export type DeleteProjectRepositoryResponses = {
+export type DeleteProjectCredentialResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteProjectRepositoryResponse =
-  DeleteProjectRepositoryResponses[keyof DeleteProjectRepositoryResponses]
+export type DeleteProjectCredentialResponse =
+  DeleteProjectCredentialResponses[keyof DeleteProjectCredentialResponses]
 
-export type ShowProjectRepositoryData = {
+export type ShowProjectCredentialData = {
   body?: never
   path: {
     /**
@@ -3110,15 +3380,15 @@ export type ShowProjectRepositoryData = {
      */
     project_id: string
     /**
-     * A repository identifier or slug
+     * A credential identifier or slug
      */
-    repository_id: string
+    credential_id: string
   }
   query?: never
-  url: '/projects/{project_id}/repositories/{repository_id}'
+  url: '/projects/{project_id}/credentials/{credential_id}'
 }
 
-export type ShowProjectRepositoryErrors = {
+export type ShowProjectCredentialErrors = {
   /**
    * User is not authorized
    */
@@ -3133,39 +3403,39 @@ export type ShowProjectRepositoryErrors = {
   500: Notification
 }
 
-export type ShowProjectRepositoryError =
-  ShowProjectRepositoryErrors[keyof ShowProjectRepositoryErrors]
+export type ShowProjectCredentialError =
+  ShowProjectCredentialErrors[keyof ShowProjectCredentialErrors]
 
-export type ShowProjectRepositoryResponses = {
+export type ShowProjectCredentialResponses = {
   /**
-   * The details for a repository of a project
+   * The details for a credential of a project
    */
-  200: Repository
+  200: Credential
 }
 
-export type ShowProjectRepositoryResponse =
-  ShowProjectRepositoryResponses[keyof ShowProjectRepositoryResponses]
+export type ShowProjectCredentialResponse =
+  ShowProjectCredentialResponses[keyof ShowProjectCredentialResponses]
 
-export type UpdateProjectRepositoryData = {
+export type UpdateProjectCredentialData = {
   /**
-   * The repository data to update
+   * The credential data to update
    */
-  body: UpdateProjectRepositoryBody
+  body: UpdateProjectCredentialBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A repository identifier or slug
+     * A credential identifier or slug
      */
-    repository_id: string
+    credential_id: string
   }
   query?: never
-  url: '/projects/{project_id}/repositories/{repository_id}'
+  url: '/projects/{project_id}/credentials/{credential_id}'
 }
 
-export type UpdateProjectRepositoryErrors = {
+export type UpdateProjectCredentialErrors = {
   /**
    * Failed to parse request
    */
@@ -3188,20 +3458,20 @@ export type UpdateProjectRepositoryErrors = {
   500: Notification
 }
 
-export type UpdateProjectRepositoryError =
-  UpdateProjectRepositoryErrors[keyof UpdateProjectRepositoryErrors]
+export type UpdateProjectCredentialError =
+  UpdateProjectCredentialErrors[keyof UpdateProjectCredentialErrors]
 
-export type UpdateProjectRepositoryResponses = {
+export type UpdateProjectCredentialResponses = {
   /**
-   * The details for a repository of a project
+   * The details for a credential of a project
    */
-  200: Repository
+  200: Credential
 }
 
-export type UpdateProjectRepositoryResponse =
-  UpdateProjectRepositoryResponses[keyof UpdateProjectRepositoryResponses]
+export type UpdateProjectCredentialResponse =
+  UpdateProjectCredentialResponses[keyof UpdateProjectCredentialResponses]
 
-export type ListProjectInventoriesData = {
+export type ListProjectRepositoriesData = {
   body?: never
   path: {
     /**
@@ -3231,10 +3501,10 @@ export type ListProjectInventoriesData = {
      */
     offset?: number
   }
-  url: '/projects/{project_id}/inventories'
+  url: '/projects/{project_id}/repositories'
 }
 
-export type ListProjectInventoriesErrors = {
+export type ListProjectRepositoriesErrors = {
   /**
    * User is not authorized
    */
@@ -3249,30 +3519,30 @@ export type ListProjectInventoriesErrors = {
   500: Notification
 }
 
-export type ListProjectInventoriesError =
-  ListProjectInventoriesErrors[keyof ListProjectInventoriesErrors]
+export type ListProjectRepositoriesError =
+  ListProjectRepositoriesErrors[keyof ListProjectRepositoriesErrors]
 
-export type ListProjectInventoriesResponses = {
+export type ListProjectRepositoriesResponses = {
   /**
-   * A collection of inventories for a project
+   * A collection of repositories for a project
    */
   200: {
     total: number
     limit: number
     offset: number
     project?: Project
-    inventories: Array<Inventory>
+    repositories: Array<Repository>
   }
 }
 
-export type ListProjectInventoriesResponse =
-  ListProjectInventoriesResponses[keyof ListProjectInventoriesResponses]
+export type ListProjectRepositoriesResponse =
+  ListProjectRepositoriesResponses[keyof ListProjectRepositoriesResponses]
 
-export type CreateProjectInventoryData = {
+export type CreateProjectRepositoryData = {
   /**
-   * The inventory data to create
+   * The repository data to create
    */
-  body: CreateProjectInventoryBody
+  body: CreateProjectRepositoryBody
   path: {
     /**
      * A project identifier or slug
@@ -3280,10 +3550,10 @@ export type CreateProjectInventoryData = {
     project_id: string
   }
   query?: never
-  url: '/projects/{project_id}/inventories'
+  url: '/projects/{project_id}/repositories'
 }
 
-export type CreateProjectInventoryErrors = {
+export type CreateProjectRepositoryErrors = {
   /**
    * Failed to parse request
    */
@@ -3306,20 +3576,20 @@ export type CreateProjectInventoryErrors = {
   500: Notification
 }
 
-export type CreateProjectInventoryError =
-  CreateProjectInventoryErrors[keyof CreateProjectInventoryErrors]
+export type CreateProjectRepositoryError =
+  CreateProjectRepositoryErrors[keyof CreateProjectRepositoryErrors]
 
-export type CreateProjectInventoryResponses = {
+export type CreateProjectRepositoryResponses = {
   /**
-   * The details for a inventory of a project
+   * The details for a repository of a project
    */
-  200: Inventory
+  200: Repository
 }
 
-export type CreateProjectInventoryResponse =
-  CreateProjectInventoryResponses[keyof CreateProjectInventoryResponses]
+export type CreateProjectRepositoryResponse =
+  CreateProjectRepositoryResponses[keyof CreateProjectRepositoryResponses]
 
-export type DeleteProjectInventoryData = {
+export type DeleteProjectRepositoryData = {
   body?: never
   path: {
     /**
@@ -3327,15 +3597,15 @@ export type DeleteProjectInventoryData = {
      */
     project_id: string
     /**
-     * A inventory identifier or slug
+     * A repository identifier or slug
      */
-    inventory_id: string
+    repository_id: string
   }
   query?: never
-  url: '/projects/{project_id}/inventories/{inventory_id}'
+  url: '/projects/{project_id}/repositories/{repository_id}'
 }
 
-export type DeleteProjectInventoryErrors = {
+export type DeleteProjectRepositoryErrors = {
   /**
    * Failed to execute action for resource
    */
@@ -3354,20 +3624,20 @@ export type DeleteProjectInventoryErrors = {
   500: Notification
 }
 
-export type DeleteProjectInventoryError =
-  DeleteProjectInventoryErrors[keyof DeleteProjectInventoryErrors]
+export type DeleteProjectRepositoryError =
+  DeleteProjectRepositoryErrors[keyof DeleteProjectRepositoryErrors]
 
-export type DeleteProjectInventoryResponses = {
+export type DeleteProjectRepositoryResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteProjectInventoryResponse =
-  DeleteProjectInventoryResponses[keyof DeleteProjectInventoryResponses]
+export type DeleteProjectRepositoryResponse =
+  DeleteProjectRepositoryResponses[keyof DeleteProjectRepositoryResponses]
 
-export type ShowProjectInventoryData = {
+export type ShowProjectRepositoryData = {
   body?: never
   path: {
     /**
@@ -3375,15 +3645,15 @@ export type ShowProjectInventoryData = {
      */
     project_id: string
     /**
-     * A inventory identifier or slug
+     * A repository identifier or slug
      */
-    inventory_id: string
+    repository_id: string
   }
   query?: never
-  url: '/projects/{project_id}/inventories/{inventory_id}'
+  url: '/projects/{project_id}/repositories/{repository_id}'
 }
 
-export type ShowProjectInventoryErrors = {
+export type ShowProjectRepositoryErrors = {
   /**
    * User is not authorized
    */
@@ -3398,39 +3668,39 @@ export type ShowProjectInventoryErrors = {
   500: Notification
 }
 
-export type ShowProjectInventoryError =
-  ShowProjectInventoryErrors[keyof ShowProjectInventoryErrors]
+export type ShowProjectRepositoryError =
+  ShowProjectRepositoryErrors[keyof ShowProjectRepositoryErrors]
 
-export type ShowProjectInventoryResponses = {
+export type ShowProjectRepositoryResponses = {
   /**
-   * The details for a inventory of a project
+   * The details for a repository of a project
    */
-  200: Inventory
+  200: Repository
 }
 
-export type ShowProjectInventoryResponse =
-  ShowProjectInventoryResponses[keyof ShowProjectInventoryResponses]
+export type ShowProjectRepositoryResponse =
+  ShowProjectRepositoryResponses[keyof ShowProjectRepositoryResponses]
 
-export type UpdateProjectInventoryData = {
+export type UpdateProjectRepositoryData = {
   /**
-   * The inventory data to update
+   * The repository data to update
    */
-  body: UpdateProjectInventoryBody
+  body: UpdateProjectRepositoryBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A inventory identifier or slug
+     * A repository identifier or slug
      */
-    inventory_id: string
+    repository_id: string
   }
   query?: never
-  url: '/projects/{project_id}/inventories/{inventory_id}'
+  url: '/projects/{project_id}/repositories/{repository_id}'
 }
 
-export type UpdateProjectInventoryErrors = {
+export type UpdateProjectRepositoryErrors = {
   /**
    * Failed to parse request
    */
@@ -3453,20 +3723,20 @@ export type UpdateProjectInventoryErrors = {
   500: Notification
 }
 
-export type UpdateProjectInventoryError =
-  UpdateProjectInventoryErrors[keyof UpdateProjectInventoryErrors]
+export type UpdateProjectRepositoryError =
+  UpdateProjectRepositoryErrors[keyof UpdateProjectRepositoryErrors]
 
-export type UpdateProjectInventoryResponses = {
+export type UpdateProjectRepositoryResponses = {
   /**
-   * The details for a inventory of a project
+   * The details for a repository of a project
    */
-  200: Inventory
+  200: Repository
 }
 
-export type UpdateProjectInventoryResponse =
-  UpdateProjectInventoryResponses[keyof UpdateProjectInventoryResponses]
+export type UpdateProjectRepositoryResponse =
+  UpdateProjectRepositoryResponses[keyof UpdateProjectRepositoryResponses]
 
-export type ListProjectEnvironmentsData = {
+export type ListProjectInventoriesData = {
   body?: never
   path: {
     /**
@@ -3496,10 +3766,10 @@ export type ListProjectEnvironmentsData = {
      */
     offset?: number
   }
-  url: '/projects/{project_id}/environments'
+  url: '/projects/{project_id}/inventories'
 }
 
-export type ListProjectEnvironmentsErrors = {
+export type ListProjectInventoriesErrors = {
   /**
    * User is not authorized
    */
@@ -3514,30 +3784,30 @@ export type ListProjectEnvironmentsErrors = {
   500: Notification
 }
 
-export type ListProjectEnvironmentsError =
-  ListProjectEnvironmentsErrors[keyof ListProjectEnvironmentsErrors]
+export type ListProjectInventoriesError =
+  ListProjectInventoriesErrors[keyof ListProjectInventoriesErrors]
 
-export type ListProjectEnvironmentsResponses = {
+export type ListProjectInventoriesResponses = {
   /**
-   * A collection of environments for a project
+   * A collection of inventories for a project
    */
   200: {
     total: number
     limit: number
     offset: number
     project?: Project
-    environments: Array<Environment>
+    inventories: Array<Inventory>
   }
 }
 
-export type ListProjectEnvironmentsResponse =
-  ListProjectEnvironmentsResponses[keyof ListProjectEnvironmentsResponses]
+export type ListProjectInventoriesResponse =
+  ListProjectInventoriesResponses[keyof ListProjectInventoriesResponses]
 
-export type CreateProjectEnvironmentData = {
+export type CreateProjectInventoryData = {
   /**
-   * The environment data to create
+   * The inventory data to create
    */
-  body: CreateProjectEnvironmentBody
+  body: CreateProjectInventoryBody
   path: {
     /**
      * A project identifier or slug
@@ -3545,10 +3815,10 @@ export type CreateProjectEnvironmentData = {
     project_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments'
+  url: '/projects/{project_id}/inventories'
 }
 
-export type CreateProjectEnvironmentErrors = {
+export type CreateProjectInventoryErrors = {
   /**
    * Failed to parse request
    */
@@ -3571,20 +3841,20 @@ export type CreateProjectEnvironmentErrors = {
   500: Notification
 }
 
-export type CreateProjectEnvironmentError =
-  CreateProjectEnvironmentErrors[keyof CreateProjectEnvironmentErrors]
+export type CreateProjectInventoryError =
+  CreateProjectInventoryErrors[keyof CreateProjectInventoryErrors]
 
-export type CreateProjectEnvironmentResponses = {
+export type CreateProjectInventoryResponses = {
   /**
-   * The details for a environment of a project
+   * The details for a inventory of a project
    */
-  200: Environment
+  200: Inventory
 }
 
-export type CreateProjectEnvironmentResponse =
-  CreateProjectEnvironmentResponses[keyof CreateProjectEnvironmentResponses]
+export type CreateProjectInventoryResponse =
+  CreateProjectInventoryResponses[keyof CreateProjectInventoryResponses]
 
-export type DeleteProjectEnvironmentData = {
+export type DeleteProjectInventoryData = {
   body?: never
   path: {
     /**
@@ -3592,15 +3862,15 @@ export type DeleteProjectEnvironmentData = {
      */
     project_id: string
     /**
-     * A environment identifier or slug
+     * A inventory identifier or slug
      */
-    environment_id: string
+    inventory_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}'
+  url: '/projects/{project_id}/inventories/{inventory_id}'
 }
 
-export type DeleteProjectEnvironmentErrors = {
+export type DeleteProjectInventoryErrors = {
   /**
    * Failed to execute action for resource
    */
@@ -3619,20 +3889,20 @@ export type DeleteProjectEnvironmentErrors = {
   500: Notification
 }
 
-export type DeleteProjectEnvironmentError =
-  DeleteProjectEnvironmentErrors[keyof DeleteProjectEnvironmentErrors]
+export type DeleteProjectInventoryError =
+  DeleteProjectInventoryErrors[keyof DeleteProjectInventoryErrors]
 
-export type DeleteProjectEnvironmentResponses = {
+export type DeleteProjectInventoryResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteProjectEnvironmentResponse =
-  DeleteProjectEnvironmentResponses[keyof DeleteProjectEnvironmentResponses]
+export type DeleteProjectInventoryResponse =
+  DeleteProjectInventoryResponses[keyof DeleteProjectInventoryResponses]
 
-export type ShowProjectEnvironmentData = {
+export type ShowProjectInventoryData = {
   body?: never
   path: {
     /**
@@ -3640,15 +3910,15 @@ export type ShowProjectEnvironmentData = {
      */
     project_id: string
     /**
-     * A environment identifier or slug
+     * A inventory identifier or slug
      */
-    environment_id: string
+    inventory_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}'
+  url: '/projects/{project_id}/inventories/{inventory_id}'
 }
 
-export type ShowProjectEnvironmentErrors = {
+export type ShowProjectInventoryErrors = {
   /**
    * User is not authorized
    */
@@ -3663,39 +3933,39 @@ export type ShowProjectEnvironmentErrors = {
   500: Notification
 }
 
-export type ShowProjectEnvironmentError =
-  ShowProjectEnvironmentErrors[keyof ShowProjectEnvironmentErrors]
+export type ShowProjectInventoryError =
+  ShowProjectInventoryErrors[keyof ShowProjectInventoryErrors]
 
-export type ShowProjectEnvironmentResponses = {
+export type ShowProjectInventoryResponses = {
   /**
-   * The details for a environment of a project
+   * The details for a inventory of a project
    */
-  200: Environment
+  200: Inventory
 }
 
-export type ShowProjectEnvironmentResponse =
-  ShowProjectEnvironmentResponses[keyof ShowProjectEnvironmentResponses]
+export type ShowProjectInventoryResponse =
+  ShowProjectInventoryResponses[keyof ShowProjectInventoryResponses]
 
-export type UpdateProjectEnvironmentData = {
+export type UpdateProjectInventoryData = {
   /**
-   * The environment data to update
+   * The inventory data to update
    */
-  body: UpdateProjectEnvironmentBody
+  body: UpdateProjectInventoryBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A environment identifier or slug
+     * A inventory identifier or slug
      */
-    environment_id: string
+    inventory_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}'
+  url: '/projects/{project_id}/inventories/{inventory_id}'
 }
 
-export type UpdateProjectEnvironmentErrors = {
+export type UpdateProjectInventoryErrors = {
   /**
    * Failed to parse request
    */
@@ -3718,43 +3988,53 @@ export type UpdateProjectEnvironmentErrors = {
   500: Notification
 }
 
-export type UpdateProjectEnvironmentError =
-  UpdateProjectEnvironmentErrors[keyof UpdateProjectEnvironmentErrors]
+export type UpdateProjectInventoryError =
+  UpdateProjectInventoryErrors[keyof UpdateProjectInventoryErrors]
 
-export type UpdateProjectEnvironmentResponses = {
+export type UpdateProjectInventoryResponses = {
   /**
-   * The details for a environment of a project
+   * The details for a inventory of a project
    */
-  200: Environment
+  200: Inventory
 }
 
-export type UpdateProjectEnvironmentResponse =
-  UpdateProjectEnvironmentResponses[keyof UpdateProjectEnvironmentResponses]
+export type UpdateProjectInventoryResponse =
+  UpdateProjectInventoryResponses[keyof UpdateProjectInventoryResponses]
 
-export type CreateProjectEnvironmentSecretData = {
-  /**
-   * The environment secret data to create
-   */
-  body: CreateProjectEnvironmentSecretBody
+export type ListProjectEnvironmentsData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+  }
+  query?: {
     /**
-     * A environment identifier or slug
+     * Search query
      */
-    environment_id: string
+    search?: string
+    /**
+     * Sorting column
+     */
+    sort?: string
+    /**
+     * Sorting order
+     */
+    order?: 'asc' | 'desc'
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
   }
-  query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/secrets'
+  url: '/projects/{project_id}/environments'
 }
 
-export type CreateProjectEnvironmentSecretErrors = {
-  /**
-   * Failed to parse request
-   */
-  400: Notification
+export type ListProjectEnvironmentsErrors = {
   /**
    * User is not authorized
    */
@@ -3763,52 +4043,49 @@ export type CreateProjectEnvironmentSecretErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type CreateProjectEnvironmentSecretError =
-  CreateProjectEnvironmentSecretErrors[keyof CreateProjectEnvironmentSecretErrors]
+export type ListProjectEnvironmentsError =
+  ListProjectEnvironmentsErrors[keyof ListProjectEnvironmentsErrors]
 
-export type CreateProjectEnvironmentSecretResponses = {
+export type ListProjectEnvironmentsResponses = {
   /**
-   * The details for an environment secret of a project
+   * A collection of environments for a project
    */
-  200: EnvironmentSecret
+  200: {
+    total: number
+    limit: number
+    offset: number
+    project?: Project
+    environments: Array<Environment>
+  }
 }
 
-export type CreateProjectEnvironmentSecretResponse =
-  CreateProjectEnvironmentSecretResponses[keyof CreateProjectEnvironmentSecretResponses]
+export type ListProjectEnvironmentsResponse =
+  ListProjectEnvironmentsResponses[keyof ListProjectEnvironmentsResponses]
 
-export type DeleteProjectEnvironmentSecretData = {
-  body?: never
+export type CreateProjectEnvironmentData = {
+  /**
+   * The environment data to create
+   */
+  body: CreateProjectEnvironmentBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-    /**
-     * A environment identifier or slug
-     */
-    environment_id: string
-    /**
-     * A secret identifier or slug
-     */
-    secret_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/secrets/{secret_id}'
+  url: '/projects/{project_id}/environments'
 }
 
-export type DeleteProjectEnvironmentSecretErrors = {
+export type CreateProjectEnvironmentErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -3819,30 +4096,31 @@ export type DeleteProjectEnvironmentSecretErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectEnvironmentSecretError =
-  DeleteProjectEnvironmentSecretErrors[keyof DeleteProjectEnvironmentSecretErrors]
+export type CreateProjectEnvironmentError =
+  CreateProjectEnvironmentErrors[keyof CreateProjectEnvironmentErrors]
 
-export type DeleteProjectEnvironmentSecretResponses = {
+export type CreateProjectEnvironmentResponses = {
   /**
-   * Plain success message
+   * The details for a environment of a project
    */
-  200: Notification
+  200: Environment
 }
 
-export type DeleteProjectEnvironmentSecretResponse =
-  DeleteProjectEnvironmentSecretResponses[keyof DeleteProjectEnvironmentSecretResponses]
+export type CreateProjectEnvironmentResponse =
+  CreateProjectEnvironmentResponses[keyof CreateProjectEnvironmentResponses]
 
-export type UpdateProjectEnvironmentSecretData = {
-  /**
-   * The environment secret data to create
-   */
-  body: UpdateProjectEnvironmentSecretBody
+export type DeleteProjectEnvironmentData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
@@ -3852,18 +4130,14 @@ export type UpdateProjectEnvironmentSecretData = {
      * A environment identifier or slug
      */
     environment_id: string
-    /**
-     * A secret identifier or slug
-     */
-    secret_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/secrets/{secret_id}'
+  url: '/projects/{project_id}/environments/{environment_id}'
 }
 
-export type UpdateProjectEnvironmentSecretErrors = {
+export type DeleteProjectEnvironmentErrors = {
   /**
-   * Failed to parse request
+   * Failed to execute action for resource
    */
   400: Notification
   /**
@@ -3874,34 +4148,27 @@ export type UpdateProjectEnvironmentSecretErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type UpdateProjectEnvironmentSecretError =
-  UpdateProjectEnvironmentSecretErrors[keyof UpdateProjectEnvironmentSecretErrors]
+export type DeleteProjectEnvironmentError =
+  DeleteProjectEnvironmentErrors[keyof DeleteProjectEnvironmentErrors]
 
-export type UpdateProjectEnvironmentSecretResponses = {
+export type DeleteProjectEnvironmentResponses = {
   /**
-   * The details for an environment secret of a project
+   * Plain success message
    */
-  200: EnvironmentSecret
+  200: Notification
 }
 
-export type UpdateProjectEnvironmentSecretResponse =
-  UpdateProjectEnvironmentSecretResponses[keyof UpdateProjectEnvironmentSecretResponses]
+export type DeleteProjectEnvironmentResponse =
+  DeleteProjectEnvironmentResponses[keyof DeleteProjectEnvironmentResponses]
 
-export type CreateProjectEnvironmentValueData = {
-  /**
-   * The environment value data to create
-   */
-  body: CreateProjectEnvironmentValueBody
+export type ShowProjectEnvironmentData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
@@ -3913,14 +4180,10 @@ export type CreateProjectEnvironmentValueData = {
     environment_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/values'
+  url: '/projects/{project_id}/environments/{environment_id}'
 }
 
-export type CreateProjectEnvironmentValueErrors = {
-  /**
-   * Failed to parse request
-   */
-  400: Notification
+export type ShowProjectEnvironmentErrors = {
   /**
    * User is not authorized
    */
@@ -3929,31 +4192,30 @@ export type CreateProjectEnvironmentValueErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type CreateProjectEnvironmentValueError =
-  CreateProjectEnvironmentValueErrors[keyof CreateProjectEnvironmentValueErrors]
+export type ShowProjectEnvironmentError =
+  ShowProjectEnvironmentErrors[keyof ShowProjectEnvironmentErrors]
 
-export type CreateProjectEnvironmentValueResponses = {
+export type ShowProjectEnvironmentResponses = {
   /**
-   * The details for an environment value of a project
+   * The details for a environment of a project
    */
-  200: EnvironmentValue
+  200: Environment
 }
 
-export type CreateProjectEnvironmentValueResponse =
-  CreateProjectEnvironmentValueResponses[keyof CreateProjectEnvironmentValueResponses]
+export type ShowProjectEnvironmentResponse =
+  ShowProjectEnvironmentResponses[keyof ShowProjectEnvironmentResponses]
 
-export type DeleteProjectEnvironmentValueData = {
-  body?: never
+export type UpdateProjectEnvironmentData = {
+  /**
+   * The environment data to update
+   */
+  body: UpdateProjectEnvironmentBody
   path: {
     /**
      * A project identifier or slug
@@ -3963,18 +4225,14 @@ export type DeleteProjectEnvironmentValueData = {
      * A environment identifier or slug
      */
     environment_id: string
-    /**
-     * A value identifier or slug
-     */
-    value_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/values/{value_id}'
+  url: '/projects/{project_id}/environments/{environment_id}'
 }
 
-export type DeleteProjectEnvironmentValueErrors = {
+export type UpdateProjectEnvironmentErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -3985,30 +4243,34 @@ export type DeleteProjectEnvironmentValueErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectEnvironmentValueError =
-  DeleteProjectEnvironmentValueErrors[keyof DeleteProjectEnvironmentValueErrors]
+export type UpdateProjectEnvironmentError =
+  UpdateProjectEnvironmentErrors[keyof UpdateProjectEnvironmentErrors]
 
-export type DeleteProjectEnvironmentValueResponses = {
+export type UpdateProjectEnvironmentResponses = {
   /**
-   * Plain success message
+   * The details for a environment of a project
    */
-  200: Notification
+  200: Environment
 }
 
-export type DeleteProjectEnvironmentValueResponse =
-  DeleteProjectEnvironmentValueResponses[keyof DeleteProjectEnvironmentValueResponses]
+export type UpdateProjectEnvironmentResponse =
+  UpdateProjectEnvironmentResponses[keyof UpdateProjectEnvironmentResponses]
 
-export type UpdateProjectEnvironmentValueData = {
+export type CreateProjectEnvironmentSecretData = {
   /**
-   * The environment value data to create
+   * The environment secret data to create
    */
-  body: UpdateProjectEnvironmentValueBody
+  body: CreateProjectEnvironmentSecretBody
   path: {
     /**
      * A project identifier or slug
@@ -4018,16 +4280,12 @@ export type UpdateProjectEnvironmentValueData = {
      * A environment identifier or slug
      */
     environment_id: string
-    /**
-     * A value identifier or slug
-     */
-    value_id: string
   }
   query?: never
-  url: '/projects/{project_id}/environments/{environment_id}/values/{value_id}'
+  url: '/projects/{project_id}/environments/{environment_id}/secrets'
 }
 
-export type UpdateProjectEnvironmentValueErrors = {
+export type CreateProjectEnvironmentSecretErrors = {
   /**
    * Failed to parse request
    */
@@ -4050,53 +4308,44 @@ export type UpdateProjectEnvironmentValueErrors = {
   500: Notification
 }
 
-export type UpdateProjectEnvironmentValueError =
-  UpdateProjectEnvironmentValueErrors[keyof UpdateProjectEnvironmentValueErrors]
+export type CreateProjectEnvironmentSecretError =
+  CreateProjectEnvironmentSecretErrors[keyof CreateProjectEnvironmentSecretErrors]
 
-export type UpdateProjectEnvironmentValueResponses = {
+export type CreateProjectEnvironmentSecretResponses = {
   /**
-   * The details for an environment value of a project
+   * The details for an environment secret of a project
    */
-  200: EnvironmentValue
+  200: EnvironmentSecret
 }
 
-export type UpdateProjectEnvironmentValueResponse =
-  UpdateProjectEnvironmentValueResponses[keyof UpdateProjectEnvironmentValueResponses]
+export type CreateProjectEnvironmentSecretResponse =
+  CreateProjectEnvironmentSecretResponses[keyof CreateProjectEnvironmentSecretResponses]
 
-export type ListProjectTemplatesData = {
+export type DeleteProjectEnvironmentSecretData = {
   body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-  }
-  query?: {
-    /**
-     * Search query
-     */
-    search?: string
-    /**
-     * Sorting column
-     */
-    sort?: string
-    /**
-     * Sorting order
-     */
-    order?: 'asc' | 'desc'
     /**
-     * Paging limit
+     * A environment identifier or slug
      */
-    limit?: number
+    environment_id: string
     /**
-     * Paging offset
+     * A secret identifier or slug
      */
-    offset?: number
+    secret_id: string
   }
-  url: '/projects/{project_id}/templates'
+  query?: never
+  url: '/projects/{project_id}/environments/{environment_id}/secrets/{secret_id}'
 }
 
-export type ListProjectTemplatesErrors = {
+export type DeleteProjectEnvironmentSecretErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * User is not authorized
    */
@@ -4111,41 +4360,43 @@ export type ListProjectTemplatesErrors = {
   500: Notification
 }
 
-export type ListProjectTemplatesError =
-  ListProjectTemplatesErrors[keyof ListProjectTemplatesErrors]
+export type DeleteProjectEnvironmentSecretError =
+  DeleteProjectEnvironmentSecretErrors[keyof DeleteProjectEnvironmentSecretErrors]
 
-export type ListProjectTemplatesResponses = {
+export type DeleteProjectEnvironmentSecretResponses = {
   /**
-   * A collection of templates for a project
+   * Plain success message
    */
-  200: {
-    total: number
-    limit: number
-    offset: number
-    project?: Project
-    templates: Array<Template>
-  }
+  200: Notification
 }
 
-export type ListProjectTemplatesResponse =
-  ListProjectTemplatesResponses[keyof ListProjectTemplatesResponses]
+export type DeleteProjectEnvironmentSecretResponse =
+  DeleteProjectEnvironmentSecretResponses[keyof DeleteProjectEnvironmentSecretResponses]
 
-export type CreateProjectTemplateData = {
+export type UpdateProjectEnvironmentSecretData = {
   /**
-   * The template data to create
+   * The environment secret data to create
    */
-  body: CreateProjectTemplateBody
+  body: UpdateProjectEnvironmentSecretBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+    /**
+     * A environment identifier or slug
+     */
+    environment_id: string
+    /**
+     * A secret identifier or slug
+     */
+    secret_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates'
+  url: '/projects/{project_id}/environments/{environment_id}/secrets/{secret_id}'
 }
 
-export type CreateProjectTemplateErrors = {
+export type UpdateProjectEnvironmentSecretErrors = {
   /**
    * Failed to parse request
    */
@@ -4168,38 +4419,41 @@ export type CreateProjectTemplateErrors = {
   500: Notification
 }
 
-export type CreateProjectTemplateError =
-  CreateProjectTemplateErrors[keyof CreateProjectTemplateErrors]
+export type UpdateProjectEnvironmentSecretError =
+  UpdateProjectEnvironmentSecretErrors[keyof UpdateProjectEnvironmentSecretErrors]
 
-export type CreateProjectTemplateResponses = {
+export type UpdateProjectEnvironmentSecretResponses = {
   /**
-   * The details for a template of a project
+   * The details for an environment secret of a project
    */
-  200: Template
+  200: EnvironmentSecret
 }
 
-export type CreateProjectTemplateResponse =
-  CreateProjectTemplateResponses[keyof CreateProjectTemplateResponses]
+export type UpdateProjectEnvironmentSecretResponse =
+  UpdateProjectEnvironmentSecretResponses[keyof UpdateProjectEnvironmentSecretResponses]
 
-export type DeleteProjectTemplateData = {
-  body?: never
+export type CreateProjectEnvironmentValueData = {
+  /**
+   * The environment value data to create
+   */
+  body: CreateProjectEnvironmentValueBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A template identifier or slug
+     * A environment identifier or slug
      */
-    template_id: string
+    environment_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}'
+  url: '/projects/{project_id}/environments/{environment_id}/values'
 }
 
-export type DeleteProjectTemplateErrors = {
+export type CreateProjectEnvironmentValueErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -4210,26 +4464,30 @@ export type DeleteProjectTemplateErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectTemplateError =
-  DeleteProjectTemplateErrors[keyof DeleteProjectTemplateErrors]
+export type CreateProjectEnvironmentValueError =
+  CreateProjectEnvironmentValueErrors[keyof CreateProjectEnvironmentValueErrors]
 
-export type DeleteProjectTemplateResponses = {
+export type CreateProjectEnvironmentValueResponses = {
   /**
-   * Plain success message
+   * The details for an environment value of a project
    */
-  200: Notification
+  200: EnvironmentValue
 }
 
-export type DeleteProjectTemplateResponse =
-  DeleteProjectTemplateResponses[keyof DeleteProjectTemplateResponses]
+export type CreateProjectEnvironmentValueResponse =
+  CreateProjectEnvironmentValueResponses[keyof CreateProjectEnvironmentValueResponses]
 
-export type ShowProjectTemplateData = {
+export type DeleteProjectEnvironmentValueData = {
   body?: never
   path: {
     /**
@@ -4237,15 +4495,23 @@ export type ShowProjectTemplateData = {
      */
     project_id: string
     /**
-     * A template identifier or slug
+     * A environment identifier or slug
      */
-    template_id: string
+    environment_id: string
+    /**
+     * A value identifier or slug
+     */
+    value_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}'
+  url: '/projects/{project_id}/environments/{environment_id}/values/{value_id}'
 }
 
-export type ShowProjectTemplateErrors = {
+export type DeleteProjectEnvironmentValueErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * User is not authorized
    */
@@ -4260,39 +4526,43 @@ export type ShowProjectTemplateErrors = {
   500: Notification
 }
 
-export type ShowProjectTemplateError =
-  ShowProjectTemplateErrors[keyof ShowProjectTemplateErrors]
+export type DeleteProjectEnvironmentValueError =
+  DeleteProjectEnvironmentValueErrors[keyof DeleteProjectEnvironmentValueErrors]
 
-export type ShowProjectTemplateResponses = {
+export type DeleteProjectEnvironmentValueResponses = {
   /**
-   * The details for a template of a project
+   * Plain success message
    */
-  200: Template
+  200: Notification
 }
 
-export type ShowProjectTemplateResponse =
-  ShowProjectTemplateResponses[keyof ShowProjectTemplateResponses]
+export type DeleteProjectEnvironmentValueResponse =
+  DeleteProjectEnvironmentValueResponses[keyof DeleteProjectEnvironmentValueResponses]
 
-export type UpdateProjectTemplateData = {
+export type UpdateProjectEnvironmentValueData = {
   /**
-   * The template data to update
+   * The environment value data to create
    */
-  body: UpdateProjectTemplateBody
+  body: UpdateProjectEnvironmentValueBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A template identifier or slug
+     * A environment identifier or slug
      */
-    template_id: string
+    environment_id: string
+    /**
+     * A value identifier or slug
+     */
+    value_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}'
+  url: '/projects/{project_id}/environments/{environment_id}/values/{value_id}'
 }
 
-export type UpdateProjectTemplateErrors = {
+export type UpdateProjectEnvironmentValueErrors = {
   /**
    * Failed to parse request
    */
@@ -4315,43 +4585,53 @@ export type UpdateProjectTemplateErrors = {
   500: Notification
 }
 
-export type UpdateProjectTemplateError =
-  UpdateProjectTemplateErrors[keyof UpdateProjectTemplateErrors]
+export type UpdateProjectEnvironmentValueError =
+  UpdateProjectEnvironmentValueErrors[keyof UpdateProjectEnvironmentValueErrors]
 
-export type UpdateProjectTemplateResponses = {
+export type UpdateProjectEnvironmentValueResponses = {
   /**
-   * The details for a template of a project
+   * The details for an environment value of a project
    */
-  200: Template
+  200: EnvironmentValue
 }
 
-export type UpdateProjectTemplateResponse =
-  UpdateProjectTemplateResponses[keyof UpdateProjectTemplateResponses]
+export type UpdateProjectEnvironmentValueResponse =
+  UpdateProjectEnvironmentValueResponses[keyof UpdateProjectEnvironmentValueResponses]
 
-export type CreateProjectTemplateSurveyData = {
-  /**
-   * The template data to create
-   */
-  body: CreateProjectTemplateSurveyBody
+export type ListProjectTemplatesData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+  }
+  query?: {
     /**
-     * A template identifier or slug
+     * Search query
      */
-    template_id: string
+    search?: string
+    /**
+     * Sorting column
+     */
+    sort?: string
+    /**
+     * Sorting order
+     */
+    order?: 'asc' | 'desc'
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
   }
-  query?: never
-  url: '/projects/{project_id}/templates/{template_id}/surveys'
+  url: '/projects/{project_id}/templates'
 }
 
-export type CreateProjectTemplateSurveyErrors = {
-  /**
-   * Failed to parse request
-   */
-  400: Notification
+export type ListProjectTemplatesErrors = {
   /**
    * User is not authorized
    */
@@ -4360,52 +4640,49 @@ export type CreateProjectTemplateSurveyErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type CreateProjectTemplateSurveyError =
-  CreateProjectTemplateSurveyErrors[keyof CreateProjectTemplateSurveyErrors]
+export type ListProjectTemplatesError =
+  ListProjectTemplatesErrors[keyof ListProjectTemplatesErrors]
 
-export type CreateProjectTemplateSurveyResponses = {
+export type ListProjectTemplatesResponses = {
   /**
-   * The details for a template survey of a project
+   * A collection of templates for a project
    */
-  200: TemplateSurvey
+  200: {
+    total: number
+    limit: number
+    offset: number
+    project?: Project
+    templates: Array<Template>
+  }
 }
 
-export type CreateProjectTemplateSurveyResponse =
-  CreateProjectTemplateSurveyResponses[keyof CreateProjectTemplateSurveyResponses]
+export type ListProjectTemplatesResponse =
+  ListProjectTemplatesResponses[keyof ListProjectTemplatesResponses]
 
-export type DeleteProjectTemplateSurveyData = {
-  body?: never
+export type CreateProjectTemplateData = {
+  /**
+   * The template data to create
+   */
+  body: CreateProjectTemplateBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-    /**
-     * A template identifier or slug
-     */
-    template_id: string
-    /**
-     * A survey identifier or slug
-     */
-    survey_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}/surveys/{survey_id}'
+  url: '/projects/{project_id}/templates'
 }
 
-export type DeleteProjectTemplateSurveyErrors = {
+export type CreateProjectTemplateErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -4416,30 +4693,31 @@ export type DeleteProjectTemplateSurveyErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectTemplateSurveyError =
-  DeleteProjectTemplateSurveyErrors[keyof DeleteProjectTemplateSurveyErrors]
+export type CreateProjectTemplateError =
+  CreateProjectTemplateErrors[keyof CreateProjectTemplateErrors]
 
-export type DeleteProjectTemplateSurveyResponses = {
+export type CreateProjectTemplateResponses = {
   /**
-   * Plain success message
+   * The details for a template of a project
    */
-  200: Notification
+  200: Template
 }
 
-export type DeleteProjectTemplateSurveyResponse =
-  DeleteProjectTemplateSurveyResponses[keyof DeleteProjectTemplateSurveyResponses]
+export type CreateProjectTemplateResponse =
+  CreateProjectTemplateResponses[keyof CreateProjectTemplateResponses]
 
-export type UpdateProjectTemplateSurveyData = {
-  /**
-   * The template data to update
-   */
-  body: UpdateProjectTemplateSurveyBody
+export type DeleteProjectTemplateData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
@@ -4449,18 +4727,14 @@ export type UpdateProjectTemplateSurveyData = {
      * A template identifier or slug
      */
     template_id: string
-    /**
-     * A survey identifier or slug
-     */
-    survey_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}/surveys/{survey_id}'
+  url: '/projects/{project_id}/templates/{template_id}'
 }
 
-export type UpdateProjectTemplateSurveyErrors = {
+export type DeleteProjectTemplateErrors = {
   /**
-   * Failed to parse request
+   * Failed to execute action for resource
    */
   400: Notification
   /**
@@ -4471,34 +4745,27 @@ export type UpdateProjectTemplateSurveyErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type UpdateProjectTemplateSurveyError =
-  UpdateProjectTemplateSurveyErrors[keyof UpdateProjectTemplateSurveyErrors]
+export type DeleteProjectTemplateError =
+  DeleteProjectTemplateErrors[keyof DeleteProjectTemplateErrors]
 
-export type UpdateProjectTemplateSurveyResponses = {
+export type DeleteProjectTemplateResponses = {
   /**
-   * The details for a template survey of a project
+   * Plain success message
    */
-  200: TemplateSurvey
+  200: Notification
 }
 
-export type UpdateProjectTemplateSurveyResponse =
-  UpdateProjectTemplateSurveyResponses[keyof UpdateProjectTemplateSurveyResponses]
+export type DeleteProjectTemplateResponse =
+  DeleteProjectTemplateResponses[keyof DeleteProjectTemplateResponses]
 
-export type CreateProjectTemplateVaultData = {
-  /**
-   * The template data to create
-   */
-  body: CreateProjectTemplateVaultBody
+export type ShowProjectTemplateData = {
+  body?: never
   path: {
     /**
      * A project identifier or slug
@@ -4510,14 +4777,10 @@ export type CreateProjectTemplateVaultData = {
     template_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}/vaults'
+  url: '/projects/{project_id}/templates/{template_id}'
 }
 
-export type CreateProjectTemplateVaultErrors = {
-  /**
-   * Failed to parse request
-   */
-  400: Notification
+export type ShowProjectTemplateErrors = {
   /**
    * User is not authorized
    */
@@ -4526,31 +4789,30 @@ export type CreateProjectTemplateVaultErrors = {
    * Resource not found
    */
   404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type CreateProjectTemplateVaultError =
-  CreateProjectTemplateVaultErrors[keyof CreateProjectTemplateVaultErrors]
+export type ShowProjectTemplateError =
+  ShowProjectTemplateErrors[keyof ShowProjectTemplateErrors]
 
-export type CreateProjectTemplateVaultResponses = {
+export type ShowProjectTemplateResponses = {
   /**
-   * The details for a template vault of a project
+   * The details for a template of a project
    */
-  200: TemplateVault
+  200: Template
 }
 
-export type CreateProjectTemplateVaultResponse =
-  CreateProjectTemplateVaultResponses[keyof CreateProjectTemplateVaultResponses]
+export type ShowProjectTemplateResponse =
+  ShowProjectTemplateResponses[keyof ShowProjectTemplateResponses]
 
-export type DeleteProjectTemplateVaultData = {
-  body?: never
+export type UpdateProjectTemplateData = {
+  /**
+   * The template data to update
+   */
+  body: UpdateProjectTemplateBody
   path: {
     /**
      * A project identifier or slug
@@ -4560,18 +4822,14 @@ export type DeleteProjectTemplateVaultData = {
      * A template identifier or slug
      */
     template_id: string
-    /**
-     * A vault identifier or slug
-     */
-    vault_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}/vaults/{vault_id}'
+  url: '/projects/{project_id}/templates/{template_id}'
 }
 
-export type DeleteProjectTemplateVaultErrors = {
+export type UpdateProjectTemplateErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -4582,30 +4840,34 @@ export type DeleteProjectTemplateVaultErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectTemplateVaultError =
-  DeleteProjectTemplateVaultErrors[keyof DeleteProjectTemplateVaultErrors]
+export type UpdateProjectTemplateError =
+  UpdateProjectTemplateErrors[keyof UpdateProjectTemplateErrors]
 
-export type DeleteProjectTemplateVaultResponses = {
+export type UpdateProjectTemplateResponses = {
   /**
-   * Plain success message
+   * The details for a template of a project
    */
-  200: Notification
+  200: Template
 }
 
-export type DeleteProjectTemplateVaultResponse =
-  DeleteProjectTemplateVaultResponses[keyof DeleteProjectTemplateVaultResponses]
+export type UpdateProjectTemplateResponse =
+  UpdateProjectTemplateResponses[keyof UpdateProjectTemplateResponses]
 
-export type UpdateProjectTemplateVaultData = {
+export type CreateProjectTemplateSurveyData = {
   /**
-   * The template data to update
+   * The template data to create
    */
-  body: UpdateProjectTemplateVaultBody
+  body: CreateProjectTemplateSurveyBody
   path: {
     /**
      * A project identifier or slug
@@ -4615,16 +4877,12 @@ export type UpdateProjectTemplateVaultData = {
      * A template identifier or slug
      */
     template_id: string
-    /**
-     * A vault identifier or slug
-     */
-    vault_id: string
   }
   query?: never
-  url: '/projects/{project_id}/templates/{template_id}/vaults/{vault_id}'
+  url: '/projects/{project_id}/templates/{template_id}/surveys'
 }
 
-export type UpdateProjectTemplateVaultErrors = {
+export type CreateProjectTemplateSurveyErrors = {
   /**
    * Failed to parse request
    */
@@ -4647,53 +4905,44 @@ export type UpdateProjectTemplateVaultErrors = {
   500: Notification
 }
 
-export type UpdateProjectTemplateVaultError =
-  UpdateProjectTemplateVaultErrors[keyof UpdateProjectTemplateVaultErrors]
+export type CreateProjectTemplateSurveyError =
+  CreateProjectTemplateSurveyErrors[keyof CreateProjectTemplateSurveyErrors]
 
-export type UpdateProjectTemplateVaultResponses = {
+export type CreateProjectTemplateSurveyResponses = {
   /**
-   * The details for a template vault of a project
+   * The details for a template survey of a project
    */
-  200: TemplateVault
+  200: TemplateSurvey
 }
 
-export type UpdateProjectTemplateVaultResponse =
-  UpdateProjectTemplateVaultResponses[keyof UpdateProjectTemplateVaultResponses]
+export type CreateProjectTemplateSurveyResponse =
+  CreateProjectTemplateSurveyResponses[keyof CreateProjectTemplateSurveyResponses]
 
-export type ListProjectSchedulesData = {
+export type DeleteProjectTemplateSurveyData = {
   body?: never
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
-  }
-  query?: {
-    /**
-     * Search query
-     */
-    search?: string
-    /**
-     * Sorting column
-     */
-    sort?: string
-    /**
-     * Sorting order
-     */
-    order?: 'asc' | 'desc'
     /**
-     * Paging limit
+     * A template identifier or slug
      */
-    limit?: number
+    template_id: string
     /**
-     * Paging offset
+     * A survey identifier or slug
      */
-    offset?: number
+    survey_id: string
   }
-  url: '/projects/{project_id}/schedules'
+  query?: never
+  url: '/projects/{project_id}/templates/{template_id}/surveys/{survey_id}'
 }
 
-export type ListProjectSchedulesErrors = {
+export type DeleteProjectTemplateSurveyErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * User is not authorized
    */
@@ -4708,41 +4957,43 @@ export type ListProjectSchedulesErrors = {
   500: Notification
 }
 
-export type ListProjectSchedulesError =
-  ListProjectSchedulesErrors[keyof ListProjectSchedulesErrors]
+export type DeleteProjectTemplateSurveyError =
+  DeleteProjectTemplateSurveyErrors[keyof DeleteProjectTemplateSurveyErrors]
 
-export type ListProjectSchedulesResponses = {
+export type DeleteProjectTemplateSurveyResponses = {
   /**
-   * A collection of schedules for a project
+   * Plain success message
    */
-  200: {
-    total: number
-    limit: number
-    offset: number
-    project?: Project
-    schedules: Array<Schedule>
-  }
+  200: Notification
 }
 
-export type ListProjectSchedulesResponse =
-  ListProjectSchedulesResponses[keyof ListProjectSchedulesResponses]
+export type DeleteProjectTemplateSurveyResponse =
+  DeleteProjectTemplateSurveyResponses[keyof DeleteProjectTemplateSurveyResponses]
 
-export type CreateProjectScheduleData = {
+export type UpdateProjectTemplateSurveyData = {
   /**
-   * The schedule data to create
+   * The template data to update
    */
-  body: CreateProjectScheduleBody
+  body: UpdateProjectTemplateSurveyBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
+    /**
+     * A template identifier or slug
+     */
+    template_id: string
+    /**
+     * A survey identifier or slug
+     */
+    survey_id: string
   }
   query?: never
-  url: '/projects/{project_id}/schedules'
+  url: '/projects/{project_id}/templates/{template_id}/surveys/{survey_id}'
 }
 
-export type CreateProjectScheduleErrors = {
+export type UpdateProjectTemplateSurveyErrors = {
   /**
    * Failed to parse request
    */
@@ -4765,38 +5016,41 @@ export type CreateProjectScheduleErrors = {
   500: Notification
 }
 
-export type CreateProjectScheduleError =
-  CreateProjectScheduleErrors[keyof CreateProjectScheduleErrors]
+export type UpdateProjectTemplateSurveyError =
+  UpdateProjectTemplateSurveyErrors[keyof UpdateProjectTemplateSurveyErrors]
 
-export type CreateProjectScheduleResponses = {
+export type UpdateProjectTemplateSurveyResponses = {
   /**
-   * The details for a schedule of a project
+   * The details for a template survey of a project
    */
-  200: Schedule
+  200: TemplateSurvey
 }
 
-export type CreateProjectScheduleResponse =
-  CreateProjectScheduleResponses[keyof CreateProjectScheduleResponses]
+export type UpdateProjectTemplateSurveyResponse =
+  UpdateProjectTemplateSurveyResponses[keyof UpdateProjectTemplateSurveyResponses]
 
-export type DeleteProjectScheduleData = {
-  body?: never
+export type CreateProjectTemplateVaultData = {
+  /**
+   * The template data to create
+   */
+  body: CreateProjectTemplateVaultBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A schedule identifier or slug
+     * A template identifier or slug
      */
-    schedule_id: string
+    template_id: string
   }
   query?: never
-  url: '/projects/{project_id}/schedules/{schedule_id}'
+  url: '/projects/{project_id}/templates/{template_id}/vaults'
 }
 
-export type DeleteProjectScheduleErrors = {
+export type CreateProjectTemplateVaultErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -4807,26 +5061,30 @@ export type DeleteProjectScheduleErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteProjectScheduleError =
-  DeleteProjectScheduleErrors[keyof DeleteProjectScheduleErrors]
+export type CreateProjectTemplateVaultError =
+  CreateProjectTemplateVaultErrors[keyof CreateProjectTemplateVaultErrors]
 
-export type DeleteProjectScheduleResponses = {
+export type CreateProjectTemplateVaultResponses = {
   /**
-   * Plain success message
+   * The details for a template vault of a project
    */
-  200: Notification
+  200: TemplateVault
 }
 
-export type DeleteProjectScheduleResponse =
-  DeleteProjectScheduleResponses[keyof DeleteProjectScheduleResponses]
+export type CreateProjectTemplateVaultResponse =
+  CreateProjectTemplateVaultResponses[keyof CreateProjectTemplateVaultResponses]
 
-export type ShowProjectScheduleData = {
+export type DeleteProjectTemplateVaultData = {
   body?: never
   path: {
     /**
@@ -4834,15 +5092,23 @@ export type ShowProjectScheduleData = {
      */
     project_id: string
     /**
-     * A schedule identifier or slug
+     * A template identifier or slug
      */
-    schedule_id: string
+    template_id: string
+    /**
+     * A vault identifier or slug
+     */
+    vault_id: string
   }
   query?: never
-  url: '/projects/{project_id}/schedules/{schedule_id}'
+  url: '/projects/{project_id}/templates/{template_id}/vaults/{vault_id}'
 }
 
-export type ShowProjectScheduleErrors = {
+export type DeleteProjectTemplateVaultErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * User is not authorized
    */
@@ -4857,39 +5123,43 @@ export type ShowProjectScheduleErrors = {
   500: Notification
 }
 
-export type ShowProjectScheduleError =
-  ShowProjectScheduleErrors[keyof ShowProjectScheduleErrors]
+export type DeleteProjectTemplateVaultError =
+  DeleteProjectTemplateVaultErrors[keyof DeleteProjectTemplateVaultErrors]
 
-export type ShowProjectScheduleResponses = {
+export type DeleteProjectTemplateVaultResponses = {
   /**
-   * The details for a schedule of a project
+   * Plain success message
    */
-  200: Schedule
+  200: Notification
 }
 
-export type ShowProjectScheduleResponse =
-  ShowProjectScheduleResponses[keyof ShowProjectScheduleResponses]
+export type DeleteProjectTemplateVaultResponse =
+  DeleteProjectTemplateVaultResponses[keyof DeleteProjectTemplateVaultResponses]
 
-export type UpdateProjectScheduleData = {
+export type UpdateProjectTemplateVaultData = {
   /**
-   * The schedule data to update
+   * The template data to update
    */
-  body: UpdateProjectScheduleBody
+  body: UpdateProjectTemplateVaultBody
   path: {
     /**
      * A project identifier or slug
      */
     project_id: string
     /**
-     * A schedule identifier or slug
+     * A template identifier or slug
      */
-    schedule_id: string
+    template_id: string
+    /**
+     * A vault identifier or slug
+     */
+    vault_id: string
   }
   query?: never
-  url: '/projects/{project_id}/schedules/{schedule_id}'
+  url: '/projects/{project_id}/templates/{template_id}/vaults/{vault_id}'
 }
 
-export type UpdateProjectScheduleErrors = {
+export type UpdateProjectTemplateVaultErrors = {
   /**
    * Failed to parse request
    */
@@ -4912,20 +5182,20 @@ export type UpdateProjectScheduleErrors = {
   500: Notification
 }
 
-export type UpdateProjectScheduleError =
-  UpdateProjectScheduleErrors[keyof UpdateProjectScheduleErrors]
+export type UpdateProjectTemplateVaultError =
+  UpdateProjectTemplateVaultErrors[keyof UpdateProjectTemplateVaultErrors]
 
-export type UpdateProjectScheduleResponses = {
+export type UpdateProjectTemplateVaultResponses = {
   /**
-   * The details for a schedule of a project
+   * The details for a template vault of a project
    */
-  200: Schedule
+  200: TemplateVault
 }
 
-export type UpdateProjectScheduleResponse =
-  UpdateProjectScheduleResponses[keyof UpdateProjectScheduleResponses]
+export type UpdateProjectTemplateVaultResponse =
+  UpdateProjectTemplateVaultResponses[keyof UpdateProjectTemplateVaultResponses]
 
-export type ListProjectExecutionsData = {
+export type ListProjectSchedulesData = {
   body?: never
   path: {
     /**
@@ -4955,10 +5225,10 @@ export type ListProjectExecutionsData = {
      */
     offset?: number
   }
-  url: '/projects/{project_id}/executions'
+  url: '/projects/{project_id}/schedules'
 }
 
-export type ListProjectExecutionsErrors = {
+export type ListProjectSchedulesErrors = {
   /**
    * User is not authorized
    */
@@ -4973,10 +5243,10 @@ export type ListProjectExecutionsErrors = {
   500: Notification
 }
 
-export type ListProjectExecutionsError =
-  ListProjectExecutionsErrors[keyof ListProjectExecutionsErrors]
+export type ListProjectSchedulesError =
+  ListProjectSchedulesErrors[keyof ListProjectSchedulesErrors]
 
-export type ListProjectExecutionsResponses = {
+export type ListProjectSchedulesResponses = {
   /**
    * A collection of schedules for a project
    */
@@ -4985,29 +5255,1149 @@ export type ListProjectExecutionsResponses = {
     limit: number
     offset: number
     project?: Project
-    executions: Array<Execution>
+    schedules: Array<Schedule>
   }
 }
 
-export type ListProjectExecutionsResponse =
-  ListProjectExecutionsResponses[keyof ListProjectExecutionsResponses]
+export type ListProjectSchedulesResponse =
+  ListProjectSchedulesResponses[keyof ListProjectSchedulesResponses]
 
-export type CreateProjectExecutionData = {
+export type CreateProjectScheduleData = {
   /**
-   * The execution data to create
+   * The schedule data to create
    */
-  body: CreateProjectExecutionBody
+  body: CreateProjectScheduleBody
   path: {
     /**
      * A project identifier or slug
      */
-    project_id: string
+    project_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/schedules'
+}
+
+export type CreateProjectScheduleErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type CreateProjectScheduleError =
+  CreateProjectScheduleErrors[keyof CreateProjectScheduleErrors]
+
+export type CreateProjectScheduleResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Schedule
+}
+
+export type CreateProjectScheduleResponse =
+  CreateProjectScheduleResponses[keyof CreateProjectScheduleResponses]
+
+export type DeleteProjectScheduleData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A schedule identifier or slug
+     */
+    schedule_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/schedules/{schedule_id}'
+}
+
+export type DeleteProjectScheduleErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type DeleteProjectScheduleError =
+  DeleteProjectScheduleErrors[keyof DeleteProjectScheduleErrors]
+
+export type DeleteProjectScheduleResponses = {
+  /**
+   * Plain success message
+   */
+  200: Notification
+}
+
+export type DeleteProjectScheduleResponse =
+  DeleteProjectScheduleResponses[keyof DeleteProjectScheduleResponses]
+
+export type ShowProjectScheduleData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A schedule identifier or slug
+     */
+    schedule_id: string
+  }
+  query?: {
+    /**
+     * Number of upcoming runs
+     */
+    next?: number
+  }
+  url: '/projects/{project_id}/schedules/{schedule_id}'
+}
+
+export type ShowProjectScheduleErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ShowProjectScheduleError =
+  ShowProjectScheduleErrors[keyof ShowProjectScheduleErrors]
+
+export type ShowProjectScheduleResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Schedule
+}
+
+export type ShowProjectScheduleResponse =
+  ShowProjectScheduleResponses[keyof ShowProjectScheduleResponses]
+
+export type UpdateProjectScheduleData = {
+  /**
+   * The schedule data to update
+   */
+  body: UpdateProjectScheduleBody
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A schedule identifier or slug
+     */
+    schedule_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/schedules/{schedule_id}'
+}
+
+export type UpdateProjectScheduleErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type UpdateProjectScheduleError =
+  UpdateProjectScheduleErrors[keyof UpdateProjectScheduleErrors]
+
+export type UpdateProjectScheduleResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Schedule
+}
+
+export type UpdateProjectScheduleResponse =
+  UpdateProjectScheduleResponses[keyof UpdateProjectScheduleResponses]
+
+export type ListProjectScheduleRunsData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A schedule identifier or slug
+     */
+    schedule_id: string
+  }
+  query?: {
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
+  }
+  url: '/projects/{project_id}/schedules/{schedule_id}/runs'
+}
+
+export type ListProjectScheduleRunsErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ListProjectScheduleRunsError =
+  ListProjectScheduleRunsErrors[keyof ListProjectScheduleRunsErrors]
+
+export type ListProjectScheduleRunsResponses = {
+  /**
+   * A collection of runs for a schedule
+   */
+  200: {
+    total: number
+    limit: number
+    offset: number
+    project?: Project
+    schedule?: Schedule
+    runs: Array<ScheduleRun>
+  }
+}
+
+export type ListProjectScheduleRunsResponse =
+  ListProjectScheduleRunsResponses[keyof ListProjectScheduleRunsResponses]
+
+export type ListProjectExecutionsData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+  }
+  query?: {
+    /**
+     * Search query
+     */
+    search?: string
+    /**
+     * Sorting column
+     */
+    sort?: string
+    /**
+     * Sorting order
+     */
+    order?: 'asc' | 'desc'
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
+  }
+  url: '/projects/{project_id}/executions'
+}
+
+export type ListProjectExecutionsErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ListProjectExecutionsError =
+  ListProjectExecutionsErrors[keyof ListProjectExecutionsErrors]
+
+export type ListProjectExecutionsResponses = {
+  /**
+   * A collection of schedules for a project
+   */
+  200: {
+    total: number
+    limit: number
+    offset: number
+    project?: Project
+    executions: Array<Execution>
+  }
+}
+
+export type ListProjectExecutionsResponse =
+  ListProjectExecutionsResponses[keyof ListProjectExecutionsResponses]
+
+export type CreateProjectExecutionData = {
+  /**
+   * The execution data to create
+   */
+  body: CreateProjectExecutionBody
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions'
+}
+
+export type CreateProjectExecutionErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type CreateProjectExecutionError =
+  CreateProjectExecutionErrors[keyof CreateProjectExecutionErrors]
+
+export type CreateProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type CreateProjectExecutionResponse =
+  CreateProjectExecutionResponses[keyof CreateProjectExecutionResponses]
+
+export type DeleteProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}'
+}
+
+export type DeleteProjectExecutionErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type DeleteProjectExecutionError =
+  DeleteProjectExecutionErrors[keyof DeleteProjectExecutionErrors]
+
+export type DeleteProjectExecutionResponses = {
+  /**
+   * Plain success message
+   */
+  200: Notification
+}
+
+export type DeleteProjectExecutionResponse =
+  DeleteProjectExecutionResponses[keyof DeleteProjectExecutionResponses]
+
+export type ShowProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}'
+}
+
+export type ShowProjectExecutionErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ShowProjectExecutionError =
+  ShowProjectExecutionErrors[keyof ShowProjectExecutionErrors]
+
+export type ShowProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type ShowProjectExecutionResponse =
+  ShowProjectExecutionResponses[keyof ShowProjectExecutionResponses]
+
+export type PurgeProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/purge'
+}
+
+export type PurgeProjectExecutionErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type PurgeProjectExecutionError =
+  PurgeProjectExecutionErrors[keyof PurgeProjectExecutionErrors]
+
+export type PurgeProjectExecutionResponses = {
+  /**
+   * Plain success message
+   */
+  200: Notification
+}
+
+export type PurgeProjectExecutionResponse =
+  PurgeProjectExecutionResponses[keyof PurgeProjectExecutionResponses]
+
+export type OutputProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/output'
+}
+
+export type OutputProjectExecutionErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type OutputProjectExecutionError =
+  OutputProjectExecutionErrors[keyof OutputProjectExecutionErrors]
+
+export type OutputProjectExecutionResponses = {
+  /**
+   * The logging for a execution of a project
+   */
+  200: Array<Output>
+}
+
+export type OutputProjectExecutionResponse =
+  OutputProjectExecutionResponses[keyof OutputProjectExecutionResponses]
+
+export type ApproveProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/approve'
+}
+
+export type ApproveProjectExecutionErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ApproveProjectExecutionError =
+  ApproveProjectExecutionErrors[keyof ApproveProjectExecutionErrors]
+
+export type ApproveProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type ApproveProjectExecutionResponse =
+  ApproveProjectExecutionResponses[keyof ApproveProjectExecutionResponses]
+
+export type RejectProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/reject'
+}
+
+export type RejectProjectExecutionErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type RejectProjectExecutionError =
+  RejectProjectExecutionErrors[keyof RejectProjectExecutionErrors]
+
+export type RejectProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type RejectProjectExecutionResponse =
+  RejectProjectExecutionResponses[keyof RejectProjectExecutionResponses]
+
+export type RerunProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/rerun'
+}
+
+export type RerunProjectExecutionErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type RerunProjectExecutionError =
+  RerunProjectExecutionErrors[keyof RerunProjectExecutionErrors]
+
+export type RerunProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type RerunProjectExecutionResponse =
+  RerunProjectExecutionResponses[keyof RerunProjectExecutionResponses]
+
+export type StopProjectExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A project identifier or slug
+     */
+    project_id: string
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/projects/{project_id}/executions/{execution_id}/stop'
+}
+
+export type StopProjectExecutionErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type StopProjectExecutionError =
+  StopProjectExecutionErrors[keyof StopProjectExecutionErrors]
+
+export type StopProjectExecutionResponses = {
+  /**
+   * The details for a schedule of a project
+   */
+  200: Execution
+}
+
+export type StopProjectExecutionResponse =
+  StopProjectExecutionResponses[keyof StopProjectExecutionResponses]
+
+export type ListGlobalEventsData = {
+  body?: never
+  path?: never
+  query?: {
+    /**
+     * Search query
+     */
+    search?: string
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
+  }
+  url: '/events'
+}
+
+export type ListGlobalEventsErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ListGlobalEventsError =
+  ListGlobalEventsErrors[keyof ListGlobalEventsErrors]
+
+export type ListGlobalEventsResponses = {
+  /**
+   * A collection of events
+   */
+  200: {
+    total: number
+    limit: number
+    offset: number
+    events: Array<Event>
+  }
+}
+
+export type ListGlobalEventsResponse =
+  ListGlobalEventsResponses[keyof ListGlobalEventsResponses]
+
+export type ListGlobalRunnersData = {
+  body?: never
+  path?: never
+  query?: {
+    /**
+     * Search query
+     */
+    search?: string
+    /**
+     * Sorting column
+     */
+    sort?: string
+    /**
+     * Sorting order
+     */
+    order?: 'asc' | 'desc'
+    /**
+     * Paging limit
+     */
+    limit?: number
+    /**
+     * Paging offset
+     */
+    offset?: number
+  }
+  url: '/runners'
+}
+
+export type ListGlobalRunnersErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ListGlobalRunnersError =
+  ListGlobalRunnersErrors[keyof ListGlobalRunnersErrors]
+
+export type ListGlobalRunnersResponses = {
+  /**
+   * A collection of runners
+   */
+  200: {
+    total: number
+    limit: number
+    offset: number
+    runners: Array<Runner>
+  }
+}
+
+export type ListGlobalRunnersResponse =
+  ListGlobalRunnersResponses[keyof ListGlobalRunnersResponses]
+
+export type CreateGlobalRunnerData = {
+  /**
+   * The runner data to create
+   */
+  body: CreateGlobalRunnerBody
+  path?: never
+  query?: never
+  url: '/runners'
+}
+
+export type CreateGlobalRunnerErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type CreateGlobalRunnerError =
+  CreateGlobalRunnerErrors[keyof CreateGlobalRunnerErrors]
+
+export type CreateGlobalRunnerResponses = {
+  /**
+   * The details for a runner
+   */
+  200: Runner
+}
+
+export type CreateGlobalRunnerResponse =
+  CreateGlobalRunnerResponses[keyof CreateGlobalRunnerResponses]
+
+export type DeleteGlobalRunnerData = {
+  body?: never
+  path: {
+    /**
+     * A runner identifier or slug
+     */
+    runner_id: string
+  }
+  query?: never
+  url: '/runners/{runner_id}'
+}
+
+export type DeleteGlobalRunnerErrors = {
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type DeleteGlobalRunnerError =
+  DeleteGlobalRunnerErrors[keyof DeleteGlobalRunnerErrors]
+
+export type DeleteGlobalRunnerResponses = {
+  /**
+   * Plain success message
+   */
+  200: Notification
+}
+
+export type DeleteGlobalRunnerResponse =
+  DeleteGlobalRunnerResponses[keyof DeleteGlobalRunnerResponses]
+
+export type ShowGlobalRunnerData = {
+  body?: never
+  path: {
+    /**
+     * A runner identifier or slug
+     */
+    runner_id: string
+  }
+  query?: never
+  url: '/runners/{runner_id}'
+}
+
+export type ShowGlobalRunnerErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ShowGlobalRunnerError =
+  ShowGlobalRunnerErrors[keyof ShowGlobalRunnerErrors]
+
+export type ShowGlobalRunnerResponses = {
+  /**
+   * The details for a runner
+   */
+  200: Runner
+}
+
+export type ShowGlobalRunnerResponse =
+  ShowGlobalRunnerResponses[keyof ShowGlobalRunnerResponses]
+
+export type UpdateGlobalRunnerData = {
+  /**
+   * The runner data to update
+   */
+  body: UpdateGlobalRunnerBody
+  path: {
+    /**
+     * A runner identifier or slug
+     */
+    runner_id: string
+  }
+  query?: never
+  url: '/runners/{runner_id}'
+}
+
+export type UpdateGlobalRunnerErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type UpdateGlobalRunnerError =
+  UpdateGlobalRunnerErrors[keyof UpdateGlobalRunnerErrors]
+
+export type UpdateGlobalRunnerResponses = {
+  /**
+   * The details for a runner
+   */
+  200: Runner
+}
+
+export type UpdateGlobalRunnerResponse =
+  UpdateGlobalRunnerResponses[keyof UpdateGlobalRunnerResponses]
+
+export type ClaimRunnerExecutionData = {
+  body?: never
+  path?: never
+  query?: {
+    /**
+     * Seconds to wait for a waiting execution
+     */
+    wait?: number
+  }
+  url: '/runner/executions'
+}
+
+export type ClaimRunnerExecutionErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ClaimRunnerExecutionError =
+  ClaimRunnerExecutionErrors[keyof ClaimRunnerExecutionErrors]
+
+export type ClaimRunnerExecutionResponses = {
+  /**
+   * The details for an execution claimed by a runner
+   */
+  200: Execution
+}
+
+export type ClaimRunnerExecutionResponse =
+  ClaimRunnerExecutionResponses[keyof ClaimRunnerExecutionResponses]
+
+export type ShowRunnerExecutionData = {
+  body?: never
+  path: {
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
+  }
+  query?: never
+  url: '/runner/executions/{execution_id}'
+}
+
+export type ShowRunnerExecutionErrors = {
+  /**
+   * User is not authorized
+   */
+  403: Notification
+  /**
+   * Resource not found
+   */
+  404: Notification
+  /**
+   * Some internal server error
+   */
+  500: Notification
+}
+
+export type ShowRunnerExecutionError =
+  ShowRunnerExecutionErrors[keyof ShowRunnerExecutionErrors]
+
+export type ShowRunnerExecutionResponses = {
+  /**
+   * The details for an execution claimed by a runner
+   */
+  200: Execution
+}
+
+export type ShowRunnerExecutionResponse =
+  ShowRunnerExecutionResponses[keyof ShowRunnerExecutionResponses]
+
+export type UpdateRunnerExecutionData = {
+  /**
+   * The execution status to update
+   */
+  body: UpdateRunnerExecutionBody
+  path: {
+    /**
+     * A execution identifier or slug
+     */
+    execution_id: string
   }
   query?: never
-  url: '/projects/{project_id}/executions'
+  url: '/runner/executions/{execution_id}'
 }
 
-export type CreateProjectExecutionErrors = {
+export type UpdateRunnerExecutionErrors = {
   /**
    * Failed to parse request
    */
@@ -5020,6 +6410,10 @@ export type CreateProjectExecutionErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Resource has been changed meanwhile
+   */
+  409: Notification
   /**
    * Failed to validate request
    */
@@ -5030,38 +6424,37 @@ export type CreateProjectExecutionErrors = {
   500: Notification
 }
 
-export type CreateProjectExecutionError =
-  CreateProjectExecutionErrors[keyof CreateProjectExecutionErrors]
+export type UpdateRunnerExecutionError =
+  UpdateRunnerExecutionErrors[keyof UpdateRunnerExecutionErrors]
 
-export type CreateProjectExecutionResponses = {
+export type UpdateRunnerExecutionResponses = {
   /**
-   * The details for a schedule of a project
+   * The details for an execution claimed by a runner
    */
   200: Execution
 }
 
-export type CreateProjectExecutionResponse =
-  CreateProjectExecutionResponses[keyof CreateProjectExecutionResponses]
+export type UpdateRunnerExecutionResponse =
+  UpdateRunnerExecutionResponses[keyof UpdateRunnerExecutionResponses]
 
-export type DeleteProjectExecutionData = {
-  body?: never
+export type CreateRunnerOutputData = {
+  /**
+   * The execution output to append
+   */
+  body: CreateRunnerOutputBody
   path: {
-    /**
-     * A project identifier or slug
-     */
-    project_id: string
     /**
      * A execution identifier or slug
      */
     execution_id: string
   }
   query?: never
-  url: '/projects/{project_id}/executions/{execution_id}'
+  url: '/runner/executions/{execution_id}/output'
 }
 
-export type DeleteProjectExecutionErrors = {
+export type CreateRunnerOutputErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -5078,82 +6471,72 @@ export type DeleteProjectExecutionErrors = {
   500: Notification
 }
 
-export type DeleteProjectExecutionError =
-  DeleteProjectExecutionErrors[keyof DeleteProjectExecutionErrors]
+export type CreateRunnerOutputError =
+  CreateRunnerOutputErrors[keyof CreateRunnerOutputErrors]
 
-export type DeleteProjectExecutionResponses = {
+export type CreateRunnerOutputResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteProjectExecutionResponse =
-  DeleteProjectExecutionResponses[keyof DeleteProjectExecutionResponses]
+export type CreateRunnerOutputResponse =
+  CreateRunnerOutputResponses[keyof CreateRunnerOutputResponses]
 
-export type ShowProjectExecutionData = {
+export type HeartbeatRunnerData = {
   body?: never
-  path: {
-    /**
-     * A project identifier or slug
-     */
-    project_id: string
-    /**
-     * A execution identifier or slug
-     */
-    execution_id: string
-  }
+  path?: never
   query?: never
-  url: '/projects/{project_id}/executions/{execution_id}'
+  url: '/runner/heartbeat'
 }
 
-export type ShowProjectExecutionErrors = {
+export type HeartbeatRunnerErrors = {
   /**
    * User is not authorized
    */
   403: Notification
-  /**
-   * Resource not found
-   */
-  404: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type ShowProjectExecutionError =
-  ShowProjectExecutionErrors[keyof ShowProjectExecutionErrors]
+export type HeartbeatRunnerError =
+  HeartbeatRunnerErrors[keyof HeartbeatRunnerErrors]
 
-export type ShowProjectExecutionResponses = {
+export type HeartbeatRunnerResponses = {
   /**
-   * The details for a schedule of a project
+   * The heartbeat details for the authenticated runner
    */
-  200: Execution
+  200: {
+    runner: Runner
+    stop: Array<string>
+    token?: string
+  }
 }
 
-export type ShowProjectExecutionResponse =
-  ShowProjectExecutionResponses[keyof ShowProjectExecutionResponses]
+export type HeartbeatRunnerResponse =
+  HeartbeatRunnerResponses[keyof HeartbeatRunnerResponses]
 
-export type PurgeProjectExecutionData = {
-  body?: never
+export type RotateGlobalRunnerData = {
+  /**
+   * The rotation details for the runner token
+   */
+  body?: RotateRunnerBody
   path: {
     /**
-     * A project identifier or slug
-     */
-    project_id: string
-    /**
-     * A execution identifier or slug
+     * A runner identifier or slug
      */
-    execution_id: string
+    runner_id: string
   }
   query?: never
-  url: '/projects/{project_id}/executions/{execution_id}/purge'
+  url: '/runners/{runner_id}/rotate'
 }
 
-export type PurgeProjectExecutionErrors = {
+export type RotateGlobalRunnerErrors = {
   /**
-   * Failed to execute action for resource
+   * Failed to parse request
    */
   400: Notification
   /**
@@ -5164,42 +6547,42 @@ export type PurgeProjectExecutionErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to validate request
+   */
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type PurgeProjectExecutionError =
-  PurgeProjectExecutionErrors[keyof PurgeProjectExecutionErrors]
+export type RotateGlobalRunnerError =
+  RotateGlobalRunnerErrors[keyof RotateGlobalRunnerErrors]
 
-export type PurgeProjectExecutionResponses = {
+export type RotateGlobalRunnerResponses = {
   /**
-   * Plain success message
+   * The details for a runner
    */
-  200: Notification
+  200: Runner
 }
 
-export type PurgeProjectExecutionResponse =
-  PurgeProjectExecutionResponses[keyof PurgeProjectExecutionResponses]
+export type RotateGlobalRunnerResponse =
+  RotateGlobalRunnerResponses[keyof RotateGlobalRunnerResponses]
 
-export type OutputProjectExecutionData = {
+export type RevokeGlobalRunnerData = {
   body?: never
   path: {
     /**
-     * A project identifier or slug
-     */
-    project_id: string
-    /**
-     * A execution identifier or slug
+     * A runner identifier or slug
      */
-    execution_id: string
+    runner_id: string
   }
   query?: never
-  url: '/projects/{project_id}/executions/{execution_id}/output'
+  url: '/runners/{runner_id}/revoke'
 }
 
-export type OutputProjectExecutionErrors = {
+export type RevokeGlobalRunnerErrors = {
   /**
    * User is not authorized
    */
@@ -5209,74 +6592,29 @@ export type OutputProjectExecutionErrors = {
    */
   404: Notification
   /**
-   * Some internal server error
-   */
-  500: Notification
-}
-
-export type OutputProjectExecutionError =
-  OutputProjectExecutionErrors[keyof OutputProjectExecutionErrors]
-
-export type OutputProjectExecutionResponses = {
-  /**
-   * The logging for a execution of a project
-   */
-  200: Array<Output>
-}
-
-export type OutputProjectExecutionResponse =
-  OutputProjectExecutionResponses[keyof OutputProjectExecutionResponses]
-
-export type ListGlobalEventsData = {
-  body?: never
-  path?: never
-  query?: {
-    /**
-     * Search query
-     */
-    search?: string
-    /**
-     * Paging limit
-     */
-    limit?: number
-    /**
-     * Paging offset
-     */
-    offset?: number
-  }
-  url: '/events'
-}
-
-export type ListGlobalEventsErrors = {
-  /**
-   * User is not authorized
+   * Failed to execute action for resource
    */
-  403: Notification
+  400: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type ListGlobalEventsError =
-  ListGlobalEventsErrors[keyof ListGlobalEventsErrors]
+export type RevokeGlobalRunnerError =
+  RevokeGlobalRunnerErrors[keyof RevokeGlobalRunnerErrors]
 
-export type ListGlobalEventsResponses = {
+export type RevokeGlobalRunnerResponses = {
   /**
-   * A collection of events
+   * Plain success message
    */
-  200: {
-    total: number
-    limit: number
-    offset: number
-    events: Array<Event>
-  }
+  200: Notification
 }
 
-export type ListGlobalEventsResponse =
-  ListGlobalEventsResponses[keyof ListGlobalEventsResponses]
+export type RevokeGlobalRunnerResponse =
+  RevokeGlobalRunnerResponses[keyof RevokeGlobalRunnerResponses]
 
-export type ListGlobalRunnersData = {
+export type ListGlobalJoinsData = {
   body?: never
   path?: never
   query?: {
@@ -5284,14 +6622,6 @@ export type ListGlobalRunnersData = {
      * Search query
      */
     search?: string
-    /**
-     * Sorting column
-     */
-    sort?: string
-    /**
-     * Sorting order
-     */
-    order?: 'asc' | 'desc'
     /**
      * Paging limit
      */
@@ -5301,10 +6631,10 @@ export type ListGlobalRunnersData = {
      */
     offset?: number
   }
-  url: '/runners'
+  url: '/joins'
 }
 
-export type ListGlobalRunnersErrors = {
+export type ListGlobalJoinsErrors = {
   /**
    * User is not authorized
    */
@@ -5315,35 +6645,35 @@ export type ListGlobalRunnersErrors = {
   500: Notification
 }
 
-export type ListGlobalRunnersError =
-  ListGlobalRunnersErrors[keyof ListGlobalRunnersErrors]
+export type ListGlobalJoinsError =
+  ListGlobalJoinsErrors[keyof ListGlobalJoinsErrors]
 
-export type ListGlobalRunnersResponses = {
+export type ListGlobalJoinsResponses = {
   /**
-   * A collection of runners
+   * A collection of join tokens
    */
   200: {
     total: number
     limit: number
     offset: number
-    runners: Array<Runner>
+    joins: Array<Join>
   }
 }
 
-export type ListGlobalRunnersResponse =
-  ListGlobalRunnersResponses[keyof ListGlobalRunnersResponses]
+export type ListGlobalJoinsResponse =
+  ListGlobalJoinsResponses[keyof ListGlobalJoinsResponses]
 
-export type CreateGlobalRunnerData = {
+export type CreateGlobalJoinData = {
   /**
-   * The runner data to create
+   * The join token data to create
    */
-  body: CreateGlobalRunnerBody
+  body: CreateGlobalJoinBody
   path?: never
   query?: never
-  url: '/runners'
+  url: '/joins'
 }
 
-export type CreateGlobalRunnerErrors = {
+export type CreateGlobalJoinErrors = {
   /**
    * Failed to parse request
    */
@@ -5362,36 +6692,32 @@ export type CreateGlobalRunnerErrors = {
   500: Notification
 }
 
-export type CreateGlobalRunnerError =
-  CreateGlobalRunnerErrors[keyof CreateGlobalRunnerErrors]
+export type CreateGlobalJoinError =
+  CreateGlobalJoinErrors[keyof CreateGlobalJoinErrors]
 
-export type CreateGlobalRunnerResponses = {
+export type CreateGlobalJoinResponses = {
   /**
-   * The details for a runner
+   * The details for a join token
    */
-  200: Runner
+  200: Join
 }
 
-export type CreateGlobalRunnerResponse =
-  CreateGlobalRunnerResponses[keyof CreateGlobalRunnerResponses]
+export type CreateGlobalJoinResponse =
+  CreateGlobalJoinResponses[keyof CreateGlobalJoinResponses]
 
-export type DeleteGlobalRunnerData = {
+export type DeleteGlobalJoinData = {
   body?: never
   path: {
     /**
-     * A runner identifier or slug
+     * A join token identifier
      */
-    runner_id: string
+    join_id: string
   }
   query?: never
-  url: '/runners/{runner_id}'
+  url: '/joins/{join_id}'
 }
 
-export type DeleteGlobalRunnerErrors = {
-  /**
-   * Failed to execute action for resource
-   */
-  400: Notification
+export type DeleteGlobalJoinErrors = {
   /**
    * User is not authorized
    */
@@ -5400,81 +6726,80 @@ export type DeleteGlobalRunnerErrors = {
    * Resource not found
    */
   404: Notification
+  /**
+   * Failed to execute action for resource
+   */
+  400: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type DeleteGlobalRunnerError =
-  DeleteGlobalRunnerErrors[keyof DeleteGlobalRunnerErrors]
+export type DeleteGlobalJoinError =
+  DeleteGlobalJoinErrors[keyof DeleteGlobalJoinErrors]
 
-export type DeleteGlobalRunnerResponses = {
+export type DeleteGlobalJoinResponses = {
   /**
    * Plain success message
    */
   200: Notification
 }
 
-export type DeleteGlobalRunnerResponse =
-  DeleteGlobalRunnerResponses[keyof DeleteGlobalRunnerResponses]
+export type DeleteGlobalJoinResponse =
+  DeleteGlobalJoinResponses[keyof DeleteGlobalJoinResponses]
 
-export type ShowGlobalRunnerData = {
-  body?: never
-  path: {
-    /**
-     * A runner identifier or slug
-     */
-    runner_id: string
-  }
+export type JoinRunnerData = {
+  /**
+   * The join token to exchange
+   */
+  body: JoinRunnerBody
+  path?: never
   query?: never
-  url: '/runners/{runner_id}'
+  url: '/runner/join'
 }
 
-export type ShowGlobalRunnerErrors = {
+export type JoinRunnerErrors = {
+  /**
+   * Failed to parse request
+   */
+  400: Notification
   /**
    * User is not authorized
    */
   403: Notification
   /**
-   * Resource not found
+   * Failed to validate request
    */
-  404: Notification
+  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type ShowGlobalRunnerError =
-  ShowGlobalRunnerErrors[keyof ShowGlobalRunnerErrors]
+export type JoinRunnerError = JoinRunnerErrors[keyof JoinRunnerErrors]
 
-export type ShowGlobalRunnerResponses = {
+export type JoinRunnerResponses = {
   /**
-   * The details for a runner
+   * The details for the joined runner
    */
   200: Runner
 }
 
-export type ShowGlobalRunnerResponse =
-  ShowGlobalRunnerResponses[keyof ShowGlobalRunnerResponses]
+export type JoinRunnerResponse = JoinRunnerResponses[keyof JoinRunnerResponses]
 
-export type UpdateGlobalRunnerData = {
+export type RegisterRunnerData = {
   /**
-   * The runner data to update
+   * The runner details to register
    */
-  body: UpdateGlobalRunnerBody
-  path: {
-    /**
-     * A runner identifier or slug
-     */
-    runner_id: string
-  }
+  body: RegisterRunnerBody
+  path?: never
   query?: never
-  url: '/runners/{runner_id}'
+  url: '/runner/register'
 }
 
-export type UpdateGlobalRunnerErrors = {
+export type RegisterRunnerErrors = {
   /**
    * Failed to parse request
    */
@@ -5483,32 +6808,24 @@ export type UpdateGlobalRunnerErrors = {
    * User is not authorized
    */
   403: Notification
-  /**
-   * Resource not found
-   */
-  404: Notification
-  /**
-   * Failed to validate request
-   */
-  422: Notification
   /**
    * Some internal server error
    */
   500: Notification
 }
 
-export type UpdateGlobalRunnerError =
-  UpdateGlobalRunnerErrors[keyof UpdateGlobalRunnerErrors]
+export type RegisterRunnerError =
+  RegisterRunnerErrors[keyof RegisterRunnerErrors]
 
-export type UpdateGlobalRunnerResponses = {
+export type RegisterRunnerResponses = {
   /**
-   * The details for a runner
+   * The details for the authenticated runner
    */
   200: Runner
 }
 
-export type UpdateGlobalRunnerResponse =
-  UpdateGlobalRunnerResponses[keyof UpdateGlobalRunnerResponses]
+export type RegisterRunnerResponse =
+  RegisterRunnerResponses[keyof RegisterRunnerResponses]
 
 export type ListGroupsData = {
   body?: never
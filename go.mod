@@ -49,6 +49,7 @@ require (
 	github.com/whilp/git-urls v1.0.0
 	golang.org/x/crypto v0.55.0
 	golang.org/x/oauth2 v0.36.0
+	golang.org/x/term v0.45.0
 )
 
 require (
@@ -231,6 +231,43 @@ func (a *API) Authentication(ctx context.Context, input *openapi3filter.Authenti
 
 		authenticating = user
 
+	case "Runner":
+		header := input.RequestValidationInput.Request.Header.Get(
+			input.SecurityScheme.Name,
+		)
+
+		if header == "" {
+			return fmt.Errorf("missing runner token")
+		}
+
+		runner, err := a.storage.Runners.ByToken(
+			ctx,
+			strings.TrimSpace(
+				header,
+			),
+		)
+
+		if err != nil {
+			logger.Error(
+				"Failed to find runner",
+				slog.Any("error", err),
+			)
+
+			return fmt.Errorf("failed to find runner")
+		}
+
+		logger.Debug(
+			"Authenticated",
+			slog.String("runner", runner.Slug),
+		)
+
+		current.SetRunner(
+			input.RequestValidationInput.Request.Context(),
+			runner,
+		)
+
+		return nil
+
 	default:
 		return fmt.Errorf("unknown security scheme: %s", scheme)
 	}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/gexec/gexec/pkg/middleware/current"
 	"github.com/gexec/gexec/pkg/model"
 	"github.com/gexec/gexec/pkg/store"
 	"github.com/go-chi/chi/v5"
@@ -19,6 +20,7 @@ const (
 	executionContext         contextKey = "execution"
 	scheduleContext          contextKey = "schedule"
 	runnerContext            contextKey = "runner"
+	joinContext              contextKey = "join"
 	credentialContext        contextKey = "credential"
 	inventoryContext         contextKey = "inventory"
 	repositoryContext        contextKey = "repository"
@@ -28,6 +30,7 @@ const (
 	templateContext          contextKey = "template"
 	templateSurveyContext    contextKey = "template_survey"
 	templateVaultContext     contextKey = "template_vault"
+	runnerExecutionContext   contextKey = "runner_execution"
 	groupContext             contextKey = "group"
 	userContext              contextKey = "user"
 )
@@ -299,6 +302,76 @@ func (a *API) ProjectRunnerFromContext(ctx context.Context) *model.Runner {
 	return record
 }
 
+// ProjectJoinToContext is used to put the requested join token into the context.
+func (a *API) ProjectJoinToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		project := a.ProjectFromContext(ctx)
+
+		if project == nil {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to find project"),
+				Status:  ToPtr(http.StatusNotFound),
+			})
+
+			return
+		}
+
+		id := chi.URLParam(r, "join_id")
+
+		record, err := a.storage.JoinTokens.Show(
+			ctx,
+			project,
+			id,
+		)
+
+		if err != nil {
+			if errors.Is(err, store.ErrJoinTokenNotFound) {
+				a.RenderNotify(w, r, Notification{
+					Message: ToPtr("Failed to find join token"),
+					Status:  ToPtr(http.StatusNotFound),
+				})
+
+				return
+			}
+
+			slog.Error(
+				"Failed to load join token",
+				slog.Any("error", err),
+				slog.String("action", "ProjectJoinToContext"),
+				slog.String("project", project.ID),
+				slog.String("join", id),
+			)
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to load join token"),
+				Status:  ToPtr(http.StatusInternalServerError),
+			})
+
+			return
+		}
+
+		ctx = context.WithValue(
+			ctx,
+			joinContext,
+			record,
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ProjectJoinFromContext is used to get the requested join token from the context.
+func (a *API) ProjectJoinFromContext(ctx context.Context) *model.JoinToken {
+	record, ok := ctx.Value(joinContext).(*model.JoinToken)
+
+	if !ok {
+		return nil
+	}
+
+	return record
+}
+
 // ProjectCredentialToContext is used to put the requested credential into the context.
 func (a *API) ProjectCredentialToContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1031,6 +1104,134 @@ func (a *API) GlobalRunnerFromContext(ctx context.Context) *model.Runner {
 	return record
 }
 
+// GlobalJoinToContext is used to put the requested join token into the context.
+func (a *API) GlobalJoinToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		id := chi.URLParam(r, "join_id")
+
+		record, err := a.storage.JoinTokens.Show(
+			ctx,
+			&model.Project{},
+			id,
+		)
+
+		if err != nil {
+			if errors.Is(err, store.ErrJoinTokenNotFound) {
+				a.RenderNotify(w, r, Notification{
+					Message: ToPtr("Failed to find join token"),
+					Status:  ToPtr(http.StatusNotFound),
+				})
+
+				return
+			}
+
+			slog.Error(
+				"Failed to load join token",
+				slog.Any("error", err),
+				slog.String("action", "GlobalJoinToContext"),
+				slog.String("join", id),
+			)
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to load join token"),
+				Status:  ToPtr(http.StatusInternalServerError),
+			})
+
+			return
+		}
+
+		ctx = context.WithValue(
+			ctx,
+			joinContext,
+			record,
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// GlobalJoinFromContext is used to get the requested join token from the context.
+func (a *API) GlobalJoinFromContext(ctx context.Context) *model.JoinToken {
+	record, ok := ctx.Value(joinContext).(*model.JoinToken)
+
+	if !ok {
+		return nil
+	}
+
+	return record
+}
+
+// RunnerExecutionToContext is used to put the claimed execution into the context.
+func (a *API) RunnerExecutionToContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		runner := current.GetRunner(ctx)
+
+		if runner == nil {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Only runners can access this resource"),
+				Status:  ToPtr(http.StatusForbidden),
+			})
+
+			return
+		}
+
+		id := chi.URLParam(r, "execution_id")
+
+		record, err := a.storage.Executions.Claimed(
+			ctx,
+			runner,
+			id,
+		)
+
+		if err != nil {
+			if errors.Is(err, store.ErrExecutionNotFound) {
+				a.RenderNotify(w, r, Notification{
+					Message: ToPtr("Failed to find execution"),
+					Status:  ToPtr(http.StatusNotFound),
+				})
+
+				return
+			}
+
+			slog.Error(
+				"Failed to load execution",
+				slog.Any("error", err),
+				slog.String("action", "RunnerExecutionToContext"),
+				slog.String("runner", runner.ID),
+				slog.String("execution", id),
+			)
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to load execution"),
+				Status:  ToPtr(http.StatusInternalServerError),
+			})
+
+			return
+		}
+
+		ctx = context.WithValue(
+			ctx,
+			runnerExecutionContext,
+			record,
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RunnerExecutionFromContext is used to get the claimed execution from the context.
+func (a *API) RunnerExecutionFromContext(ctx context.Context) *model.Execution {
+	record, ok := ctx.Value(runnerExecutionContext).(*model.Execution)
+
+	if !ok {
+		return nil
+	}
+
+	return record
+}
+
 // GroupToContext is used to put the requested group into the context.
 func (a *API) GroupToContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
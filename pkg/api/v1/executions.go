@@ -2,15 +2,22 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 
 	"github.com/gexec/gexec/pkg/middleware/current"
 	"github.com/gexec/gexec/pkg/model"
+	"github.com/gexec/gexec/pkg/store"
 	"github.com/gexec/gexec/pkg/validate"
 	"github.com/go-chi/render"
 )
 
+const (
+	// secretMask defines the replacement for secrets within responses.
+	secretMask = "********"
+)
+
 // ListProjectExecutions implements the v1.ServerInterface.
 func (a *API) ListProjectExecutions(w http.ResponseWriter, r *http.Request, _ ProjectID, params ListProjectExecutionsParams) {
 	ctx := r.Context()
@@ -100,8 +107,38 @@ func (a *API) ShowProjectExecution(w http.ResponseWriter, r *http.Request, _ Pro
 		return
 	}
 
+	position, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Executions.Position(
+		ctx,
+		record,
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to load queue position",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "ShowProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to load queue position"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	result := a.convertExecution(record)
+
+	if position > 0 {
+		result.QueuePosition = ToPtr(position)
+	}
+
 	render.JSON(w, r, ProjectExecutionResponse(
-		a.convertExecution(record),
+		result,
 	))
 }
 
@@ -129,6 +166,7 @@ func (a *API) CreateProjectExecution(w http.ResponseWriter, r *http.Request, _ P
 
 	incoming := &model.Execution{
 		ProjectID: project.ID,
+		Priority:  model.ExecutionPriorityManual,
 	}
 
 	if body.TemplateID != nil {
@@ -139,6 +177,56 @@ func (a *API) CreateProjectExecution(w http.ResponseWriter, r *http.Request, _ P
 		incoming.Debug = FromPtr(body.Debug)
 	}
 
+	if body.Priority != nil {
+		incoming.Priority = FromPtr(body.Priority)
+	}
+
+	if body.Branch != nil {
+		incoming.Branch = FromPtr(body.Branch)
+	}
+
+	if body.Limit != nil {
+		incoming.Limit = FromPtr(body.Limit)
+	}
+
+	if body.Path != nil {
+		incoming.Path = FromPtr(body.Path)
+	}
+
+	if body.Arguments != nil {
+		incoming.Arguments = FromPtr(body.Arguments)
+	}
+
+	if body.Environment != nil {
+		incoming.Environment = FromPtr(body.Environment)
+	}
+
+	if body.Secret != nil {
+		incoming.Secret = FromPtr(body.Secret)
+	}
+
+	if body.Answers != nil && len(FromPtr(body.Answers)) > 0 {
+		answers, err := json.Marshal(FromPtr(body.Answers))
+
+		if err != nil {
+			slog.Error(
+				"Failed to encode answers",
+				slog.Any("error", err),
+				slog.String("project", project.ID),
+				slog.String("action", "CreateProjectExecution"),
+			)
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to encode answers"),
+				Status:  ToPtr(http.StatusInternalServerError),
+			})
+
+			return
+		}
+
+		incoming.Answers = string(answers)
+	}
+
 	if err := incoming.SerializeSecret(a.config.Encrypt.Passphrase); err != nil {
 		slog.Error(
 			"Failed to encrypt secrets",
@@ -276,6 +364,267 @@ func (a *API) PurgeProjectExecution(w http.ResponseWriter, r *http.Request, _ Pr
 	})
 }
 
+// ApproveProjectExecution implements the v1.ServerInterface.
+func (a *API) ApproveProjectExecution(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ExecutionID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectExecutionFromContext(ctx)
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Executions.Approve(
+		ctx,
+		project,
+		record.ID,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotConfirm) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Execution does not await confirmation"),
+				Status:  ToPtr(http.StatusBadRequest),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to approve execution",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "ApproveProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to approve execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "ApproveProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, ProjectExecutionResponse(
+		a.convertExecution(result),
+	))
+}
+
+// RejectProjectExecution implements the v1.ServerInterface.
+func (a *API) RejectProjectExecution(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ExecutionID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectExecutionFromContext(ctx)
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Executions.Reject(
+		ctx,
+		project,
+		record.ID,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotConfirm) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Execution does not await confirmation"),
+				Status:  ToPtr(http.StatusBadRequest),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to reject execution",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "RejectProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to reject execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "RejectProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, ProjectExecutionResponse(
+		a.convertExecution(result),
+	))
+}
+
+// RerunProjectExecution implements the v1.ServerInterface.
+func (a *API) RerunProjectExecution(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ExecutionID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectExecutionFromContext(ctx)
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Executions.Rerun(
+		ctx,
+		project,
+		record.ID,
+	)
+
+	if err != nil {
+		if v, ok := err.(validate.Errors); ok {
+			errors := make([]Validation, 0)
+
+			for _, verr := range v.Errors {
+				errors = append(
+					errors,
+					Validation{
+						Field:   ToPtr(verr.Field),
+						Message: ToPtr(verr.Error.Error()),
+					},
+				)
+			}
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to validate execution"),
+				Status:  ToPtr(http.StatusUnprocessableEntity),
+				Errors:  ToPtr(errors),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to rerun execution",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "RerunProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to rerun execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "RerunProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, ProjectExecutionResponse(
+		a.convertExecution(result),
+	))
+}
+
+// StopProjectExecution implements the v1.ServerInterface.
+func (a *API) StopProjectExecution(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ExecutionID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectExecutionFromContext(ctx)
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Executions.Stop(
+		ctx,
+		project,
+		record.ID,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotRunning) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Execution is not running"),
+				Status:  ToPtr(http.StatusBadRequest),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to stop execution",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "StopProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to stop execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "StopProjectExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, ProjectExecutionResponse(
+		a.convertExecution(result),
+	))
+}
+
 // OutputProjectExecution implements the v1.ServerInterface.
 func (a *API) OutputProjectExecution(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ExecutionID) {
 	ctx := r.Context()
@@ -318,20 +667,82 @@ func (a *API) OutputProjectExecution(w http.ResponseWriter, r *http.Request, _ P
 }
 
 func (a *API) convertExecution(record *model.Execution) Execution {
+	result := a.convertRunnerExecution(record)
+
+	// Secret overrides are only exposed to the runner.
+	result.Secret = nil
+
+	if result.Answers != nil {
+		secrets := make(map[string]bool)
+
+		if record.Template != nil {
+			for _, survey := range record.Template.Surveys {
+				secrets[survey.Name] = survey.Kind == "secret"
+			}
+		}
+
+		// Answers without a known survey are hidden as well, they could be
+		// answers to secret questions which have been removed meanwhile.
+		for key := range FromPtr(result.Answers) {
+			if secret, ok := secrets[key]; !ok || secret {
+				FromPtr(result.Answers)[key] = secretMask
+			}
+		}
+	}
+
+	return result
+}
+
+// convertRunnerExecution converts the execution including all secrets, it
+// must only be used for the runner which actually runs the execution.
+func (a *API) convertRunnerExecution(record *model.Execution) Execution {
 	result := Execution{
 		ID:          ToPtr(record.ID),
 		Name:        ToPtr(record.Name),
 		Status:      ToPtr(string(record.Status)),
 		Debug:       ToPtr(record.Debug),
+		Attempt:     ToPtr(record.Attempt),
+		Priority:    ToPtr(record.Priority),
 		Path:        ToPtr(record.Path),
+		Arguments:   ToPtr(record.Arguments),
 		Environment: ToPtr(record.Environment),
 		Secret:      ToPtr(record.Secret),
 		Limit:       ToPtr(record.Limit),
 		Branch:      ToPtr(record.Branch),
+		CommitSHA:   ToPtr(record.CommitSHA),
 		CreatedAt:   ToPtr(record.CreatedAt),
 		UpdatedAt:   ToPtr(record.UpdatedAt),
 	}
 
+	if record.Answers != "" {
+		answers := make(map[string]string)
+
+		if err := json.Unmarshal([]byte(record.Answers), &answers); err == nil {
+			result.Answers = ToPtr(answers)
+		}
+	}
+
+	if record.ParentID != "" {
+		result.ParentID = ToPtr(record.ParentID)
+	}
+
+	if record.ScheduleID != "" {
+		result.ScheduleID = ToPtr(record.ScheduleID)
+	}
+
+	if !record.NotBefore.IsZero() {
+		result.NotBefore = ToPtr(record.NotBefore)
+	}
+
+	if !record.StartedAt.IsZero() {
+		result.StartedAt = ToPtr(record.StartedAt)
+	}
+
+	if !record.FinishedAt.IsZero() {
+		result.FinishedAt = ToPtr(record.FinishedAt)
+		result.ExitCode = ToPtr(record.ExitCode)
+	}
+
 	if record.Template != nil {
 		result.TemplateID = ToPtr(record.TemplateID)
 
@@ -342,6 +753,17 @@ func (a *API) convertExecution(record *model.Execution) Execution {
 		)
 	}
 
+	if record.Runner != nil {
+		result.RunnerID = ToPtr(record.RunnerID)
+
+		// Only expose the identity, the runner token must never leak here.
+		result.Runner = ToPtr(Runner{
+			ID:   ToPtr(record.Runner.ID),
+			Slug: ToPtr(record.Runner.Slug),
+			Name: ToPtr(record.Runner.Name),
+		})
+	}
+
 	return result
 }
 
@@ -140,18 +140,39 @@ func ToEnvironmentValueKind(val string) (EnvironmentValueKind, error) {
 
 // Defines values for EventAction.
 const (
-	Create EventAction = "create"
-	Delete EventAction = "delete"
-	Update EventAction = "update"
+	Approve EventAction = "approve"
+	Create  EventAction = "create"
+	Delete  EventAction = "delete"
+	Reject  EventAction = "reject"
+	Rerun   EventAction = "rerun"
+	Retry   EventAction = "retry"
+	Revoke  EventAction = "revoke"
+	Rotate  EventAction = "rotate"
+	Trigger EventAction = "trigger"
+	Update  EventAction = "update"
 )
 
 // Valid indicates whether the value is a known member of the EventAction enum.
 func (e EventAction) Valid() bool {
 	switch e {
+	case Approve:
+		return true
 	case Create:
 		return true
 	case Delete:
 		return true
+	case Reject:
+		return true
+	case Rerun:
+		return true
+	case Retry:
+		return true
+	case Revoke:
+		return true
+	case Rotate:
+		return true
+	case Trigger:
+		return true
 	case Update:
 		return true
 	default:
@@ -164,9 +185,16 @@ var (
 	ErrEventAction = fmt.Errorf("invalid type for EventAction")
 
 	stringToEventAction = map[string]EventAction{
-		"create": Create,
-		"delete": Delete,
-		"update": Update,
+		"approve": Approve,
+		"create":  Create,
+		"delete":  Delete,
+		"reject":  Reject,
+		"rerun":   Rerun,
+		"retry":   Retry,
+		"revoke":  Revoke,
+		"rotate":  Rotate,
+		"trigger": Trigger,
+		"update":  Update,
 	}
 )
 
@@ -188,6 +216,7 @@ const (
 	EventObjectTypeGroupProject EventObjectType = "group_project"
 	EventObjectTypeGroupUser    EventObjectType = "group_user"
 	EventObjectTypeInventory    EventObjectType = "inventory"
+	EventObjectTypeJoinToken    EventObjectType = "join_token"
 	EventObjectTypeProject      EventObjectType = "project"
 	EventObjectTypeProjectGroup EventObjectType = "project_group"
 	EventObjectTypeProjectUser  EventObjectType = "project_user"
@@ -217,6 +246,8 @@ func (e EventObjectType) Valid() bool {
 		return true
 	case EventObjectTypeInventory:
 		return true
+	case EventObjectTypeJoinToken:
+		return true
 	case EventObjectTypeProject:
 		return true
 	case EventObjectTypeProjectGroup:
@@ -254,6 +285,7 @@ var (
 		"group_project": EventObjectTypeGroupProject,
 		"group_user":    EventObjectTypeGroupUser,
 		"inventory":     EventObjectTypeInventory,
+		"join_token":    EventObjectTypeJoinToken,
 		"project":       EventObjectTypeProject,
 		"project_group": EventObjectTypeProjectGroup,
 		"project_user":  EventObjectTypeProjectUser,
@@ -319,8 +351,9 @@ func ToGroupProjectPerm(val string) (GroupProjectPerm, error) {
 
 // Defines values for InventoryKind.
 const (
-	File   InventoryKind = "file"
-	Static InventoryKind = "static"
+	File      InventoryKind = "file"
+	Static    InventoryKind = "static"
+	Workspace InventoryKind = "workspace"
 )
 
 // Valid indicates whether the value is a known member of the InventoryKind enum.
@@ -330,6 +363,8 @@ func (e InventoryKind) Valid() bool {
 		return true
 	case Static:
 		return true
+	case Workspace:
+		return true
 	default:
 		return false
 	}
@@ -340,8 +375,9 @@ var (
 	ErrInventoryKind = fmt.Errorf("invalid type for InventoryKind")
 
 	stringToInventoryKind = map[string]InventoryKind{
-		"file":   File,
-		"static": Static,
+		"file":      File,
+		"static":    Static,
+		"workspace": Workspace,
 	}
 )
 
@@ -354,6 +390,47 @@ func ToInventoryKind(val string) (InventoryKind, error) {
 	return InventoryKind(""), ErrInventoryKind
 }
 
+// Defines values for ScheduleMissed.
+const (
+	All  ScheduleMissed = "all"
+	Once ScheduleMissed = "once"
+	Skip ScheduleMissed = "skip"
+)
+
+// Valid indicates whether the value is a known member of the ScheduleMissed enum.
+func (e ScheduleMissed) Valid() bool {
+	switch e {
+	case All:
+		return true
+	case Once:
+		return true
+	case Skip:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	// ErrScheduleMissed defines an error if an invalid value gets mapped.
+	ErrScheduleMissed = fmt.Errorf("invalid type for ScheduleMissed")
+
+	stringToScheduleMissed = map[string]ScheduleMissed{
+		"all":  All,
+		"once": Once,
+		"skip": Skip,
+	}
+)
+
+// ToScheduleMissed acts as a helper to map a string to the defined enum.
+func ToScheduleMissed(val string) (ScheduleMissed, error) {
+	if res, ok := stringToScheduleMissed[val]; ok {
+		return res, nil
+	}
+
+	return ScheduleMissed(""), ErrScheduleMissed
+}
+
 // Defines values for TemplateSurveyKind.
 const (
 	Enum   TemplateSurveyKind = "enum"
@@ -1324,17 +1401,33 @@ type EventObjectType string
 
 // Execution Model to represent execution
 type Execution struct {
-	Branch      *string    `json:"branch,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Debug       *bool      `json:"debug,omitempty"`
-	Environment *string    `json:"environment,omitempty"`
-	ID          *string    `json:"id,omitempty"`
-	Limit       *string    `json:"limit,omitempty"`
-	Name        *string    `json:"name,omitempty"`
-	Path        *string    `json:"path,omitempty"`
-	ProjectID   *string    `json:"project_id,omitempty"`
-	Secret      *string    `json:"secret,omitempty"`
-	Status      *string    `json:"status,omitempty"`
+	Answers       *map[string]string `json:"answers,omitempty"`
+	Arguments     *string            `json:"arguments,omitempty"`
+	Attempt       *int               `json:"attempt,omitempty"`
+	Branch        *string            `json:"branch,omitempty"`
+	CommitSHA     *string            `json:"commit_sha,omitempty"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+	Debug         *bool              `json:"debug,omitempty"`
+	Environment   *string            `json:"environment,omitempty"`
+	ExitCode      *int               `json:"exit_code,omitempty"`
+	FinishedAt    *time.Time         `json:"finished_at,omitempty"`
+	ID            *string            `json:"id,omitempty"`
+	Limit         *string            `json:"limit,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	NotBefore     *time.Time         `json:"not_before,omitempty"`
+	ParentID      *string            `json:"parent_id,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	Priority      *int               `json:"priority,omitempty"`
+	ProjectID     *string            `json:"project_id,omitempty"`
+	QueuePosition *int               `json:"queue_position,omitempty"`
+
+	// Runner Model to represent runner
+	Runner     *Runner    `json:"runner,omitempty"`
+	RunnerID   *string    `json:"runner_id,omitempty"`
+	ScheduleID *string    `json:"schedule_id,omitempty"`
+	Secret     *string    `json:"secret,omitempty"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	Status     *string    `json:"status,omitempty"`
 
 	// Template Model to represent template
 	Template   *Template  `json:"template,omitempty"`
@@ -1395,6 +1488,23 @@ type Inventory struct {
 // InventoryKind defines model for Inventory.Kind.
 type InventoryKind string
 
+// Join Model to represent join token
+type Join struct {
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	ID        *string            `json:"id,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+
+	// Project Model to represent project
+	Project   *Project   `json:"project,omitempty"`
+	ProjectID *string    `json:"project_id,omitempty"`
+	Token     *string    `json:"token,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Used      *int       `json:"used,omitempty"`
+	Uses      *int       `json:"uses,omitempty"`
+}
+
 // Notification Generic response for errors and validations
 type Notification struct {
 	Errors  *[]Validation `json:"errors,omitempty"`
@@ -1464,34 +1574,66 @@ type Repository struct {
 
 // Runner Model to represent runner
 type Runner struct {
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	ID        *string    `json:"id,omitempty"`
-	Name      *string    `json:"name,omitempty"`
+	Arch           *string            `json:"arch,omitempty"`
+	Capacity       *int               `json:"capacity,omitempty"`
+	CreatedAt      *time.Time         `json:"created_at,omitempty"`
+	GraceExpiresAt *time.Time         `json:"grace_expires_at,omitempty"`
+	Hostname       *string            `json:"hostname,omitempty"`
+	ID             *string            `json:"id,omitempty"`
+	Labels         *map[string]string `json:"labels,omitempty"`
+	LastSeenAt     *time.Time         `json:"last_seen_at,omitempty"`
+	Name           *string            `json:"name,omitempty"`
+	OS             *string            `json:"os,omitempty"`
 
 	// Project Model to represent project
 	Project   *Project   `json:"project,omitempty"`
 	ProjectID *string    `json:"project_id,omitempty"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
 	Slug      *string    `json:"slug,omitempty"`
 	Token     *string    `json:"token,omitempty"`
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Version   *string    `json:"version,omitempty"`
 }
 
 // Schedule Model to represent schedule
 type Schedule struct {
-	Active    *bool      `json:"active,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
-	Cron      *string    `json:"cron,omitempty"`
-	ID        *string    `json:"id,omitempty"`
-	Name      *string    `json:"name,omitempty"`
-	ProjectID *string    `json:"project_id,omitempty"`
-	Slug      *string    `json:"slug,omitempty"`
+	Active          *bool           `json:"active,omitempty"`
+	CreatedAt       *time.Time      `json:"created_at,omitempty"`
+	Cron            *string         `json:"cron,omitempty"`
+	EndAt           *time.Time      `json:"end_at,omitempty"`
+	ID              *string         `json:"id,omitempty"`
+	LastExecutionID *string         `json:"last_execution_id,omitempty"`
+	LastRunAt       *time.Time      `json:"last_run_at,omitempty"`
+	LastStatus      *string         `json:"last_status,omitempty"`
+	Missed          *ScheduleMissed `json:"missed,omitempty"`
+	Name            *string         `json:"name,omitempty"`
+	NextRuns        *[]time.Time    `json:"next_runs,omitempty"`
+	ProjectID       *string         `json:"project_id,omitempty"`
+	RunAt           *time.Time      `json:"run_at,omitempty"`
+	Slug            *string         `json:"slug,omitempty"`
+	StartAt         *time.Time      `json:"start_at,omitempty"`
 
 	// Template Model to represent template
 	Template   *Template  `json:"template,omitempty"`
 	TemplateID *string    `json:"template_id,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
 	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
 }
 
+// ScheduleMissed defines model for Schedule.Missed.
+type ScheduleMissed string
+
+// ScheduleRun Model to represent schedule run
+type ScheduleRun struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	ExecutionID *string    `json:"execution_id,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	ID          *string    `json:"id,omitempty"`
+	Message     *string    `json:"message,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+	TriggeredAt *time.Time `json:"triggered_at,omitempty"`
+}
+
 // Template Model to represent template
 type Template struct {
 	AllowOverride *bool      `json:"allow_override,omitempty"`
@@ -1505,22 +1647,28 @@ type Template struct {
 	EnvironmentID *string      `json:"environment_id,omitempty"`
 	Executor      *string      `json:"executor,omitempty"`
 	ID            *string      `json:"id,omitempty"`
+	Image         *string      `json:"image,omitempty"`
 
 	// Inventory Model to represent inventory
-	Inventory   *Inventory `json:"inventory,omitempty"`
-	InventoryID *string    `json:"inventory_id,omitempty"`
-	Limit       *string    `json:"limit,omitempty"`
-	Name        *string    `json:"name,omitempty"`
-	Path        *string    `json:"path,omitempty"`
-	ProjectID   *string    `json:"project_id,omitempty"`
+	Inventory   *Inventory         `json:"inventory,omitempty"`
+	InventoryID *string            `json:"inventory_id,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+	Limit       *string            `json:"limit,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+	Path        *string            `json:"path,omitempty"`
+	ProjectID   *string            `json:"project_id,omitempty"`
 
 	// Repository Model to represent repository
-	Repository   *Repository       `json:"repository,omitempty"`
-	RepositoryID *string           `json:"repository_id,omitempty"`
-	Slug         *string           `json:"slug,omitempty"`
-	Surveys      *[]TemplateSurvey `json:"surveys,omitempty"`
-	UpdatedAt    *time.Time        `json:"updated_at,omitempty"`
-	Vaults       *[]TemplateVault  `json:"vaults,omitempty"`
+	Repository    *Repository       `json:"repository,omitempty"`
+	RepositoryID  *string           `json:"repository_id,omitempty"`
+	RetryAttempts *int              `json:"retry_attempts,omitempty"`
+	RetryBackoff  *int              `json:"retry_backoff,omitempty"`
+	RetryCodes    *[]int            `json:"retry_codes,omitempty"`
+	Sequential    *bool             `json:"sequential,omitempty"`
+	Slug          *string           `json:"slug,omitempty"`
+	Surveys       *[]TemplateSurvey `json:"surveys,omitempty"`
+	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
+	Vaults        *[]TemplateVault  `json:"vaults,omitempty"`
 }
 
 // TemplateSurvey Model to represent template survey
@@ -1647,6 +1795,9 @@ type GroupID = string
 // InventoryID defines model for InventoryParam.
 type InventoryID = string
 
+// JoinID defines model for JoinParam.
+type JoinID = string
+
 // PagingLimitParam defines model for PagingLimitParam.
 type PagingLimitParam = int
 
@@ -1662,6 +1813,12 @@ type RepositoryID = string
 // RunnerID defines model for RunnerParam.
 type RunnerID = string
 
+// RunnerWaitParam defines model for RunnerWaitParam.
+type RunnerWaitParam = int
+
+// ScheduleNextParam defines model for ScheduleNextParam.
+type ScheduleNextParam = int
+
 // ScheduleID defines model for ScheduleParam.
 type ScheduleID = string
 
@@ -1704,6 +1861,12 @@ type BadCredentialsError = Notification
 // BadRequestError Generic response for errors and validations
 type BadRequestError = Notification
 
+// ConflictError Generic response for errors and validations
+type ConflictError = Notification
+
+// CurrentRunnerResponse Model to represent runner
+type CurrentRunnerResponse = Runner
+
 // GlobalEventsResponse defines model for GlobalEventsResponse.
 type GlobalEventsResponse struct {
 	Events []Event `json:"events"`
@@ -1712,6 +1875,17 @@ type GlobalEventsResponse struct {
 	Total  int64   `json:"total"`
 }
 
+// GlobalJoinResponse Model to represent join token
+type GlobalJoinResponse = Join
+
+// GlobalJoinsResponse defines model for GlobalJoinsResponse.
+type GlobalJoinsResponse struct {
+	Joins  []Join `json:"joins"`
+	Limit  int64  `json:"limit"`
+	Offset int64  `json:"offset"`
+	Total  int64  `json:"total"`
+}
+
 // GlobalRunnerResponse Model to represent runner
 type GlobalRunnerResponse = Runner
 
@@ -1760,6 +1934,9 @@ type InternalServerError = Notification
 // InvalidTokenError Generic response for errors and validations
 type InvalidTokenError = Notification
 
+// JoinedRunnerResponse Model to represent runner
+type JoinedRunnerResponse = Runner
+
 // LoginResponse defines model for LoginResponse.
 type LoginResponse = AuthToken
 
@@ -1859,6 +2036,20 @@ type ProjectInventoriesResponse struct {
 // ProjectInventoryResponse Model to represent inventory
 type ProjectInventoryResponse = Inventory
 
+// ProjectJoinResponse Model to represent join token
+type ProjectJoinResponse = Join
+
+// ProjectJoinsResponse defines model for ProjectJoinsResponse.
+type ProjectJoinsResponse struct {
+	Joins  []Join `json:"joins"`
+	Limit  int64  `json:"limit"`
+	Offset int64  `json:"offset"`
+
+	// Project Model to represent project
+	Project *Project `json:"project,omitempty"`
+	Total   int64    `json:"total"`
+}
+
 // ProjectOutputResponse defines model for ProjectOutputResponse.
 type ProjectOutputResponse = []Output
 
@@ -1896,6 +2087,20 @@ type ProjectRunnersResponse struct {
 // ProjectScheduleResponse Model to represent schedule
 type ProjectScheduleResponse = Schedule
 
+// ProjectScheduleRunsResponse defines model for ProjectScheduleRunsResponse.
+type ProjectScheduleRunsResponse struct {
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+
+	// Project Model to represent project
+	Project *Project      `json:"project,omitempty"`
+	Runs    []ScheduleRun `json:"runs"`
+
+	// Schedule Model to represent schedule
+	Schedule *Schedule `json:"schedule,omitempty"`
+	Total    int64     `json:"total"`
+}
+
 // ProjectSchedulesResponse defines model for ProjectSchedulesResponse.
 type ProjectSchedulesResponse struct {
 	Limit  int64 `json:"limit"`
@@ -1955,6 +2160,17 @@ type ProvidersResponse struct {
 // RefreshResponse defines model for RefreshResponse.
 type RefreshResponse = AuthToken
 
+// RunnerExecutionResponse Model to represent execution
+type RunnerExecutionResponse = Execution
+
+// RunnerHeartbeatResponse defines model for RunnerHeartbeatResponse.
+type RunnerHeartbeatResponse struct {
+	// Runner Model to represent runner
+	Runner Runner   `json:"runner"`
+	Stop   []string `json:"stop"`
+	Token  *string  `json:"token,omitempty"`
+}
+
 // SuccessMessage Generic response for errors and validations
 type SuccessMessage = Notification
 
@@ -2000,12 +2216,22 @@ type ValidationError = Notification
 // VerifyResponse defines model for VerifyResponse.
 type VerifyResponse = AuthVerify
 
+// CreateGlobalJoinBody defines model for CreateGlobalJoinBody.
+type CreateGlobalJoinBody struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Uses      *int               `json:"uses,omitempty"`
+}
+
 // CreateGlobalRunnerBody defines model for CreateGlobalRunnerBody.
 type CreateGlobalRunnerBody struct {
-	Name      *string `json:"name,omitempty"`
-	ProjectID *string `json:"project_id,omitempty"`
-	Slug      *string `json:"slug,omitempty"`
-	Token     *string `json:"token,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Slug      *string            `json:"slug,omitempty"`
+	Token     *string            `json:"token,omitempty"`
 }
 
 // CreateGroupBody defines model for CreateGroupBody.
@@ -2059,7 +2285,30 @@ type CreateProjectEnvironmentValueBody struct {
 
 // CreateProjectExecutionBody defines model for CreateProjectExecutionBody.
 type CreateProjectExecutionBody struct {
-	Debug      *bool   `json:"debug,omitempty"`
+	// Answers Answers for the survey of the template
+	Answers *map[string]string `json:"answers,omitempty"`
+
+	// Arguments Arguments to pass instead of the template arguments
+	Arguments *string `json:"arguments,omitempty"`
+
+	// Branch Branch to checkout instead of the template branch
+	Branch *string `json:"branch,omitempty"`
+	Debug  *bool   `json:"debug,omitempty"`
+
+	// Environment JSON object of extra environment variables
+	Environment *string `json:"environment,omitempty"`
+
+	// Limit Limit to apply instead of the template limit
+	Limit *string `json:"limit,omitempty"`
+
+	// Path Path to run instead of the template path
+	Path *string `json:"path,omitempty"`
+
+	// Priority Priority within the queue, defaults to 50 for manual executions
+	Priority *int `json:"priority,omitempty"`
+
+	// Secret JSON object of extra secret environment variables
+	Secret     *string `json:"secret,omitempty"`
 	TemplateID *string `json:"template_id,omitempty"`
 }
 
@@ -2074,6 +2323,14 @@ type CreateProjectInventoryBody struct {
 	Slug         *string `json:"slug,omitempty"`
 }
 
+// CreateProjectJoinBody defines model for CreateProjectJoinBody.
+type CreateProjectJoinBody struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	Uses      *int               `json:"uses,omitempty"`
+}
+
 // CreateProjectRepositoryBody defines model for CreateProjectRepositoryBody.
 type CreateProjectRepositoryBody struct {
 	Branch       *string `json:"branch,omitempty"`
@@ -2085,36 +2342,48 @@ type CreateProjectRepositoryBody struct {
 
 // CreateProjectRunnerBody defines model for CreateProjectRunnerBody.
 type CreateProjectRunnerBody struct {
-	Name  *string `json:"name,omitempty"`
-	Slug  *string `json:"slug,omitempty"`
-	Token *string `json:"token,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Slug   *string            `json:"slug,omitempty"`
+	Token  *string            `json:"token,omitempty"`
 }
 
 // CreateProjectScheduleBody defines model for CreateProjectScheduleBody.
 type CreateProjectScheduleBody struct {
-	Active     *bool   `json:"active,omitempty"`
-	Cron       *string `json:"cron,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	Slug       *string `json:"slug,omitempty"`
-	TemplateID *string `json:"template_id,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+	Cron       *string    `json:"cron,omitempty"`
+	EndAt      *time.Time `json:"end_at,omitempty"`
+	Missed     *string    `json:"missed,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	RunAt      *time.Time `json:"run_at,omitempty"`
+	Slug       *string    `json:"slug,omitempty"`
+	StartAt    *time.Time `json:"start_at,omitempty"`
+	TemplateID *string    `json:"template_id,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
 }
 
 // CreateProjectTemplateBody defines model for CreateProjectTemplateBody.
 type CreateProjectTemplateBody struct {
-	AllowOverride *bool             `json:"allow_override,omitempty"`
-	Arguments     *string           `json:"arguments,omitempty"`
-	Branch        *string           `json:"branch,omitempty"`
-	Description   *string           `json:"description,omitempty"`
-	EnvironmentID *string           `json:"environment_id,omitempty"`
-	Executor      *string           `json:"executor,omitempty"`
-	InventoryID   *string           `json:"inventory_id,omitempty"`
-	Limit         *string           `json:"limit,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Path          *string           `json:"path,omitempty"`
-	RepositoryID  *string           `json:"repository_id,omitempty"`
-	Slug          *string           `json:"slug,omitempty"`
-	Surveys       *[]TemplateSurvey `json:"surveys,omitempty"`
-	Vaults        *[]TemplateVault  `json:"vaults,omitempty"`
+	AllowOverride *bool              `json:"allow_override,omitempty"`
+	Arguments     *string            `json:"arguments,omitempty"`
+	Branch        *string            `json:"branch,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	EnvironmentID *string            `json:"environment_id,omitempty"`
+	Executor      *string            `json:"executor,omitempty"`
+	Image         *string            `json:"image,omitempty"`
+	InventoryID   *string            `json:"inventory_id,omitempty"`
+	Labels        *map[string]string `json:"labels,omitempty"`
+	Limit         *string            `json:"limit,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	RepositoryID  *string            `json:"repository_id,omitempty"`
+	RetryAttempts *int               `json:"retry_attempts,omitempty"`
+	RetryBackoff  *int               `json:"retry_backoff,omitempty"`
+	RetryCodes    *[]int             `json:"retry_codes,omitempty"`
+	Sequential    *bool              `json:"sequential,omitempty"`
+	Slug          *string            `json:"slug,omitempty"`
+	Surveys       *[]TemplateSurvey  `json:"surveys,omitempty"`
+	Vaults        *[]TemplateVault   `json:"vaults,omitempty"`
 }
 
 // CreateProjectTemplateSurveyBody defines model for CreateProjectTemplateSurveyBody.
@@ -2135,6 +2404,11 @@ type CreateProjectTemplateVaultBody struct {
 	Script       *string `json:"script,omitempty"`
 }
 
+// CreateRunnerOutputBody defines model for CreateRunnerOutputBody.
+type CreateRunnerOutputBody struct {
+	Content string `json:"content"`
+}
+
 // CreateUserBody defines model for CreateUserBody.
 type CreateUserBody struct {
 	Active   *bool   `json:"active,omitempty"`
@@ -2167,6 +2441,12 @@ type GroupUserPermBody struct {
 	User string `json:"user"`
 }
 
+// JoinRunnerBody defines model for JoinRunnerBody.
+type JoinRunnerBody struct {
+	Name  *string `json:"name,omitempty"`
+	Token string  `json:"token"`
+}
+
 // LoginAuthBody defines model for LoginAuthBody.
 type LoginAuthBody struct {
 	Password string `json:"password"`
@@ -2200,12 +2480,28 @@ type RedirectAuthBody struct {
 	Token string `json:"token"`
 }
 
+// RegisterRunnerBody defines model for RegisterRunnerBody.
+type RegisterRunnerBody struct {
+	Arch     *string `json:"arch,omitempty"`
+	Capacity *int    `json:"capacity,omitempty"`
+	Hostname *string `json:"hostname,omitempty"`
+	OS       *string `json:"os,omitempty"`
+	Version  *string `json:"version,omitempty"`
+}
+
+// RotateRunnerBody defines model for RotateRunnerBody.
+type RotateRunnerBody struct {
+	// Grace Seconds the previous token stays valid
+	Grace *int `json:"grace,omitempty"`
+}
+
 // UpdateGlobalRunnerBody defines model for UpdateGlobalRunnerBody.
 type UpdateGlobalRunnerBody struct {
-	Name      *string `json:"name,omitempty"`
-	ProjectID *string `json:"project_id,omitempty"`
-	Slug      *string `json:"slug,omitempty"`
-	Token     *string `json:"token,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Slug      *string            `json:"slug,omitempty"`
+	Token     *string            `json:"token,omitempty"`
 }
 
 // UpdateGroupBody defines model for UpdateGroupBody.
@@ -2286,35 +2582,47 @@ type UpdateProjectRepositoryBody struct {
 
 // UpdateProjectRunnerBody defines model for UpdateProjectRunnerBody.
 type UpdateProjectRunnerBody struct {
-	Name  *string `json:"name,omitempty"`
-	Slug  *string `json:"slug,omitempty"`
-	Token *string `json:"token,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Slug   *string            `json:"slug,omitempty"`
+	Token  *string            `json:"token,omitempty"`
 }
 
 // UpdateProjectScheduleBody defines model for UpdateProjectScheduleBody.
 type UpdateProjectScheduleBody struct {
-	Active     *bool   `json:"active,omitempty"`
-	Cron       *string `json:"cron,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	Slug       *string `json:"slug,omitempty"`
-	TemplateID *string `json:"template_id,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+	Cron       *string    `json:"cron,omitempty"`
+	EndAt      *time.Time `json:"end_at,omitempty"`
+	Missed     *string    `json:"missed,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	RunAt      *time.Time `json:"run_at,omitempty"`
+	Slug       *string    `json:"slug,omitempty"`
+	StartAt    *time.Time `json:"start_at,omitempty"`
+	TemplateID *string    `json:"template_id,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
 }
 
 // UpdateProjectTemplateBody defines model for UpdateProjectTemplateBody.
 type UpdateProjectTemplateBody struct {
-	AllowOverride *bool             `json:"allow_override,omitempty"`
-	Arguments     *string           `json:"arguments,omitempty"`
-	Branch        *string           `json:"branch,omitempty"`
-	Description   *string           `json:"description,omitempty"`
-	EnvironmentID *string           `json:"environment_id,omitempty"`
-	InventoryID   *string           `json:"inventory_id,omitempty"`
-	Limit         *string           `json:"limit,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Path          *string           `json:"path,omitempty"`
-	RepositoryID  *string           `json:"repository_id,omitempty"`
-	Slug          *string           `json:"slug,omitempty"`
-	Surveys       *[]TemplateSurvey `json:"surveys,omitempty"`
-	Vaults        *[]TemplateVault  `json:"vaults,omitempty"`
+	AllowOverride *bool              `json:"allow_override,omitempty"`
+	Arguments     *string            `json:"arguments,omitempty"`
+	Branch        *string            `json:"branch,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	EnvironmentID *string            `json:"environment_id,omitempty"`
+	Image         *string            `json:"image,omitempty"`
+	InventoryID   *string            `json:"inventory_id,omitempty"`
+	Labels        *map[string]string `json:"labels,omitempty"`
+	Limit         *string            `json:"limit,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	RepositoryID  *string            `json:"repository_id,omitempty"`
+	RetryAttempts *int               `json:"retry_attempts,omitempty"`
+	RetryBackoff  *int               `json:"retry_backoff,omitempty"`
+	RetryCodes    *[]int             `json:"retry_codes,omitempty"`
+	Sequential    *bool              `json:"sequential,omitempty"`
+	Slug          *string            `json:"slug,omitempty"`
+	Surveys       *[]TemplateSurvey  `json:"surveys,omitempty"`
+	Vaults        *[]TemplateVault   `json:"vaults,omitempty"`
 }
 
 // UpdateProjectTemplateSurveyBody defines model for UpdateProjectTemplateSurveyBody.
@@ -2335,6 +2643,13 @@ type UpdateProjectTemplateVaultBody struct {
 	Script       *string `json:"script,omitempty"`
 }
 
+// UpdateRunnerExecutionBody defines model for UpdateRunnerExecutionBody.
+type UpdateRunnerExecutionBody struct {
+	CommitSHA *string `json:"commit_sha,omitempty"`
+	ExitCode  *int    `json:"exit_code,omitempty"`
+	Status    string  `json:"status"`
+}
+
 // UpdateUserBody defines model for UpdateUserBody.
 type UpdateUserBody struct {
 	Active   *bool   `json:"active,omitempty"`
@@ -2508,6 +2823,27 @@ type PermitGroupUserJSONBody struct {
 	User string `json:"user"`
 }
 
+// ListGlobalJoinsParams defines parameters for ListGlobalJoins.
+type ListGlobalJoinsParams struct {
+	// Search Search query
+	Search *SearchQueryParam `form:"search,omitempty" json:"search,omitempty"`
+
+	// Limit Paging limit
+	Limit *PagingLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Paging offset
+	Offset *PagingOffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateGlobalJoinJSONBody defines parameters for CreateGlobalJoin.
+type CreateGlobalJoinJSONBody struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Uses      *int               `json:"uses,omitempty"`
+}
+
 // UpdateProfileJSONBody defines parameters for UpdateProfile.
 type UpdateProfileJSONBody struct {
 	Email    *string `json:"email,omitempty"`
@@ -2699,7 +3035,30 @@ type ListProjectExecutionsParamsOrder string
 
 // CreateProjectExecutionJSONBody defines parameters for CreateProjectExecution.
 type CreateProjectExecutionJSONBody struct {
-	Debug      *bool   `json:"debug,omitempty"`
+	// Answers Answers for the survey of the template
+	Answers *map[string]string `json:"answers,omitempty"`
+
+	// Arguments Arguments to pass instead of the template arguments
+	Arguments *string `json:"arguments,omitempty"`
+
+	// Branch Branch to checkout instead of the template branch
+	Branch *string `json:"branch,omitempty"`
+	Debug  *bool   `json:"debug,omitempty"`
+
+	// Environment JSON object of extra environment variables
+	Environment *string `json:"environment,omitempty"`
+
+	// Limit Limit to apply instead of the template limit
+	Limit *string `json:"limit,omitempty"`
+
+	// Path Path to run instead of the template path
+	Path *string `json:"path,omitempty"`
+
+	// Priority Priority within the queue, defaults to 50 for manual executions
+	Priority *int `json:"priority,omitempty"`
+
+	// Secret JSON object of extra secret environment variables
+	Secret     *string `json:"secret,omitempty"`
 	TemplateID *string `json:"template_id,omitempty"`
 }
 
@@ -2784,6 +3143,26 @@ type UpdateProjectInventoryJSONBody struct {
 	Slug         *string `json:"slug,omitempty"`
 }
 
+// ListProjectJoinsParams defines parameters for ListProjectJoins.
+type ListProjectJoinsParams struct {
+	// Search Search query
+	Search *SearchQueryParam `form:"search,omitempty" json:"search,omitempty"`
+
+	// Limit Paging limit
+	Limit *PagingLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Paging offset
+	Offset *PagingOffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateProjectJoinJSONBody defines parameters for CreateProjectJoin.
+type CreateProjectJoinJSONBody struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	Uses      *int               `json:"uses,omitempty"`
+}
+
 // ListProjectRepositoriesParams defines parameters for ListProjectRepositories.
 type ListProjectRepositoriesParams struct {
 	// Search Search query
@@ -2846,16 +3225,24 @@ type ListProjectRunnersParamsOrder string
 
 // CreateProjectRunnerJSONBody defines parameters for CreateProjectRunner.
 type CreateProjectRunnerJSONBody struct {
-	Name  *string `json:"name,omitempty"`
-	Slug  *string `json:"slug,omitempty"`
-	Token *string `json:"token,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Slug   *string            `json:"slug,omitempty"`
+	Token  *string            `json:"token,omitempty"`
 }
 
 // UpdateProjectRunnerJSONBody defines parameters for UpdateProjectRunner.
 type UpdateProjectRunnerJSONBody struct {
-	Name  *string `json:"name,omitempty"`
-	Slug  *string `json:"slug,omitempty"`
-	Token *string `json:"token,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   *string            `json:"name,omitempty"`
+	Slug   *string            `json:"slug,omitempty"`
+	Token  *string            `json:"token,omitempty"`
+}
+
+// RotateProjectRunnerJSONBody defines parameters for RotateProjectRunner.
+type RotateProjectRunnerJSONBody struct {
+	// Grace Seconds the previous token stays valid
+	Grace *int `json:"grace,omitempty"`
 }
 
 // ListProjectSchedulesParams defines parameters for ListProjectSchedules.
@@ -2881,20 +3268,45 @@ type ListProjectSchedulesParamsOrder string
 
 // CreateProjectScheduleJSONBody defines parameters for CreateProjectSchedule.
 type CreateProjectScheduleJSONBody struct {
-	Active     *bool   `json:"active,omitempty"`
-	Cron       *string `json:"cron,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	Slug       *string `json:"slug,omitempty"`
-	TemplateID *string `json:"template_id,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+	Cron       *string    `json:"cron,omitempty"`
+	EndAt      *time.Time `json:"end_at,omitempty"`
+	Missed     *string    `json:"missed,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	RunAt      *time.Time `json:"run_at,omitempty"`
+	Slug       *string    `json:"slug,omitempty"`
+	StartAt    *time.Time `json:"start_at,omitempty"`
+	TemplateID *string    `json:"template_id,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
+}
+
+// ShowProjectScheduleParams defines parameters for ShowProjectSchedule.
+type ShowProjectScheduleParams struct {
+	// Next Number of upcoming runs
+	Next *ScheduleNextParam `form:"next,omitempty" json:"next,omitempty"`
 }
 
 // UpdateProjectScheduleJSONBody defines parameters for UpdateProjectSchedule.
 type UpdateProjectScheduleJSONBody struct {
-	Active     *bool   `json:"active,omitempty"`
-	Cron       *string `json:"cron,omitempty"`
-	Name       *string `json:"name,omitempty"`
-	Slug       *string `json:"slug,omitempty"`
-	TemplateID *string `json:"template_id,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+	Cron       *string    `json:"cron,omitempty"`
+	EndAt      *time.Time `json:"end_at,omitempty"`
+	Missed     *string    `json:"missed,omitempty"`
+	Name       *string    `json:"name,omitempty"`
+	RunAt      *time.Time `json:"run_at,omitempty"`
+	Slug       *string    `json:"slug,omitempty"`
+	StartAt    *time.Time `json:"start_at,omitempty"`
+	TemplateID *string    `json:"template_id,omitempty"`
+	Timezone   *string    `json:"timezone,omitempty"`
+}
+
+// ListProjectScheduleRunsParams defines parameters for ListProjectScheduleRuns.
+type ListProjectScheduleRunsParams struct {
+	// Limit Paging limit
+	Limit *PagingLimitParam `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Paging offset
+	Offset *PagingOffsetParam `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // ListProjectTemplatesParams defines parameters for ListProjectTemplates.
@@ -2920,37 +3332,49 @@ type ListProjectTemplatesParamsOrder string
 
 // CreateProjectTemplateJSONBody defines parameters for CreateProjectTemplate.
 type CreateProjectTemplateJSONBody struct {
-	AllowOverride *bool             `json:"allow_override,omitempty"`
-	Arguments     *string           `json:"arguments,omitempty"`
-	Branch        *string           `json:"branch,omitempty"`
-	Description   *string           `json:"description,omitempty"`
-	EnvironmentID *string           `json:"environment_id,omitempty"`
-	Executor      *string           `json:"executor,omitempty"`
-	InventoryID   *string           `json:"inventory_id,omitempty"`
-	Limit         *string           `json:"limit,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Path          *string           `json:"path,omitempty"`
-	RepositoryID  *string           `json:"repository_id,omitempty"`
-	Slug          *string           `json:"slug,omitempty"`
-	Surveys       *[]TemplateSurvey `json:"surveys,omitempty"`
-	Vaults        *[]TemplateVault  `json:"vaults,omitempty"`
+	AllowOverride *bool              `json:"allow_override,omitempty"`
+	Arguments     *string            `json:"arguments,omitempty"`
+	Branch        *string            `json:"branch,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	EnvironmentID *string            `json:"environment_id,omitempty"`
+	Executor      *string            `json:"executor,omitempty"`
+	Image         *string            `json:"image,omitempty"`
+	InventoryID   *string            `json:"inventory_id,omitempty"`
+	Labels        *map[string]string `json:"labels,omitempty"`
+	Limit         *string            `json:"limit,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	RepositoryID  *string            `json:"repository_id,omitempty"`
+	RetryAttempts *int               `json:"retry_attempts,omitempty"`
+	RetryBackoff  *int               `json:"retry_backoff,omitempty"`
+	RetryCodes    *[]int             `json:"retry_codes,omitempty"`
+	Sequential    *bool              `json:"sequential,omitempty"`
+	Slug          *string            `json:"slug,omitempty"`
+	Surveys       *[]TemplateSurvey  `json:"surveys,omitempty"`
+	Vaults        *[]TemplateVault   `json:"vaults,omitempty"`
 }
 
 // UpdateProjectTemplateJSONBody defines parameters for UpdateProjectTemplate.
 type UpdateProjectTemplateJSONBody struct {
-	AllowOverride *bool             `json:"allow_override,omitempty"`
-	Arguments     *string           `json:"arguments,omitempty"`
-	Branch        *string           `json:"branch,omitempty"`
-	Description   *string           `json:"description,omitempty"`
-	EnvironmentID *string           `json:"environment_id,omitempty"`
-	InventoryID   *string           `json:"inventory_id,omitempty"`
-	Limit         *string           `json:"limit,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Path          *string           `json:"path,omitempty"`
-	RepositoryID  *string           `json:"repository_id,omitempty"`
-	Slug          *string           `json:"slug,omitempty"`
-	Surveys       *[]TemplateSurvey `json:"surveys,omitempty"`
-	Vaults        *[]TemplateVault  `json:"vaults,omitempty"`
+	AllowOverride *bool              `json:"allow_override,omitempty"`
+	Arguments     *string            `json:"arguments,omitempty"`
+	Branch        *string            `json:"branch,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	EnvironmentID *string            `json:"environment_id,omitempty"`
+	Image         *string            `json:"image,omitempty"`
+	InventoryID   *string            `json:"inventory_id,omitempty"`
+	Labels        *map[string]string `json:"labels,omitempty"`
+	Limit         *string            `json:"limit,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	Path          *string            `json:"path,omitempty"`
+	RepositoryID  *string            `json:"repository_id,omitempty"`
+	RetryAttempts *int               `json:"retry_attempts,omitempty"`
+	RetryBackoff  *int               `json:"retry_backoff,omitempty"`
+	RetryCodes    *[]int             `json:"retry_codes,omitempty"`
+	Sequential    *bool              `json:"sequential,omitempty"`
+	Slug          *string            `json:"slug,omitempty"`
+	Surveys       *[]TemplateSurvey  `json:"surveys,omitempty"`
+	Vaults        *[]TemplateVault   `json:"vaults,omitempty"`
 }
 
 // CreateProjectTemplateSurveyJSONBody defines parameters for CreateProjectTemplateSurvey.
@@ -3027,6 +3451,39 @@ type PermitProjectUserJSONBody struct {
 	User string `json:"user"`
 }
 
+// ClaimRunnerExecutionParams defines parameters for ClaimRunnerExecution.
+type ClaimRunnerExecutionParams struct {
+	// Wait Seconds to wait for a waiting execution
+	Wait *RunnerWaitParam `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
+// UpdateRunnerExecutionJSONBody defines parameters for UpdateRunnerExecution.
+type UpdateRunnerExecutionJSONBody struct {
+	CommitSHA *string `json:"commit_sha,omitempty"`
+	ExitCode  *int    `json:"exit_code,omitempty"`
+	Status    string  `json:"status"`
+}
+
+// CreateRunnerOutputJSONBody defines parameters for CreateRunnerOutput.
+type CreateRunnerOutputJSONBody struct {
+	Content string `json:"content"`
+}
+
+// JoinRunnerJSONBody defines parameters for JoinRunner.
+type JoinRunnerJSONBody struct {
+	Name  *string `json:"name,omitempty"`
+	Token string  `json:"token"`
+}
+
+// RegisterRunnerJSONBody defines parameters for RegisterRunner.
+type RegisterRunnerJSONBody struct {
+	Arch     *string `json:"arch,omitempty"`
+	Capacity *int    `json:"capacity,omitempty"`
+	Hostname *string `json:"hostname,omitempty"`
+	OS       *string `json:"os,omitempty"`
+	Version  *string `json:"version,omitempty"`
+}
+
 // ListGlobalRunnersParams defines parameters for ListGlobalRunners.
 type ListGlobalRunnersParams struct {
 	// Search Search query
@@ -3050,18 +3507,26 @@ type ListGlobalRunnersParamsOrder string
 
 // CreateGlobalRunnerJSONBody defines parameters for CreateGlobalRunner.
 type CreateGlobalRunnerJSONBody struct {
-	Name      *string `json:"name,omitempty"`
-	ProjectID *string `json:"project_id,omitempty"`
-	Slug      *string `json:"slug,omitempty"`
-	Token     *string `json:"token,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Slug      *string            `json:"slug,omitempty"`
+	Token     *string            `json:"token,omitempty"`
 }
 
 // UpdateGlobalRunnerJSONBody defines parameters for UpdateGlobalRunner.
 type UpdateGlobalRunnerJSONBody struct {
-	Name      *string `json:"name,omitempty"`
-	ProjectID *string `json:"project_id,omitempty"`
-	Slug      *string `json:"slug,omitempty"`
-	Token     *string `json:"token,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      *string            `json:"name,omitempty"`
+	ProjectID *string            `json:"project_id,omitempty"`
+	Slug      *string            `json:"slug,omitempty"`
+	Token     *string            `json:"token,omitempty"`
+}
+
+// RotateGlobalRunnerJSONBody defines parameters for RotateGlobalRunner.
+type RotateGlobalRunnerJSONBody struct {
+	// Grace Seconds the previous token stays valid
+	Grace *int `json:"grace,omitempty"`
 }
 
 // ListUsersParams defines parameters for ListUsers.
@@ -3211,6 +3676,9 @@ type AttachGroupToUserJSONRequestBody AttachGroupToUserJSONBody
 // PermitGroupUserJSONRequestBody defines body for PermitGroupUser for application/json ContentType.
 type PermitGroupUserJSONRequestBody PermitGroupUserJSONBody
 
+// CreateGlobalJoinJSONRequestBody defines body for CreateGlobalJoin for application/json ContentType.
+type CreateGlobalJoinJSONRequestBody CreateGlobalJoinJSONBody
+
 // UpdateProfileJSONRequestBody defines body for UpdateProfile for application/json ContentType.
 type UpdateProfileJSONRequestBody UpdateProfileJSONBody
 
@@ -3262,6 +3730,9 @@ type CreateProjectInventoryJSONRequestBody CreateProjectInventoryJSONBody
 // UpdateProjectInventoryJSONRequestBody defines body for UpdateProjectInventory for application/json ContentType.
 type UpdateProjectInventoryJSONRequestBody UpdateProjectInventoryJSONBody
 
+// CreateProjectJoinJSONRequestBody defines body for CreateProjectJoin for application/json ContentType.
+type CreateProjectJoinJSONRequestBody CreateProjectJoinJSONBody
+
 // CreateProjectRepositoryJSONRequestBody defines body for CreateProjectRepository for application/json ContentType.
 type CreateProjectRepositoryJSONRequestBody CreateProjectRepositoryJSONBody
 
@@ -3274,6 +3745,9 @@ type CreateProjectRunnerJSONRequestBody CreateProjectRunnerJSONBody
 // UpdateProjectRunnerJSONRequestBody defines body for UpdateProjectRunner for application/json ContentType.
 type UpdateProjectRunnerJSONRequestBody UpdateProjectRunnerJSONBody
 
+// RotateProjectRunnerJSONRequestBody defines body for RotateProjectRunner for application/json ContentType.
+type RotateProjectRunnerJSONRequestBody RotateProjectRunnerJSONBody
+
 // CreateProjectScheduleJSONRequestBody defines body for CreateProjectSchedule for application/json ContentType.
 type CreateProjectScheduleJSONRequestBody CreateProjectScheduleJSONBody
 
@@ -3307,12 +3781,27 @@ type AttachProjectToUserJSONRequestBody AttachProjectToUserJSONBody
 // PermitProjectUserJSONRequestBody defines body for PermitProjectUser for application/json ContentType.
 type PermitProjectUserJSONRequestBody PermitProjectUserJSONBody
 
+// UpdateRunnerExecutionJSONRequestBody defines body for UpdateRunnerExecution for application/json ContentType.
+type UpdateRunnerExecutionJSONRequestBody UpdateRunnerExecutionJSONBody
+
+// CreateRunnerOutputJSONRequestBody defines body for CreateRunnerOutput for application/json ContentType.
+type CreateRunnerOutputJSONRequestBody CreateRunnerOutputJSONBody
+
+// JoinRunnerJSONRequestBody defines body for JoinRunner for application/json ContentType.
+type JoinRunnerJSONRequestBody JoinRunnerJSONBody
+
+// RegisterRunnerJSONRequestBody defines body for RegisterRunner for application/json ContentType.
+type RegisterRunnerJSONRequestBody RegisterRunnerJSONBody
+
 // CreateGlobalRunnerJSONRequestBody defines body for CreateGlobalRunner for application/json ContentType.
 type CreateGlobalRunnerJSONRequestBody CreateGlobalRunnerJSONBody
 
 // UpdateGlobalRunnerJSONRequestBody defines body for UpdateGlobalRunner for application/json ContentType.
 type UpdateGlobalRunnerJSONRequestBody UpdateGlobalRunnerJSONBody
 
+// RotateGlobalRunnerJSONRequestBody defines body for RotateGlobalRunner for application/json ContentType.
+type RotateGlobalRunnerJSONRequestBody RotateGlobalRunnerJSONBody
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody CreateUserJSONBody
 
@@ -3606,6 +4095,30 @@ type ClientInterface interface {
 	// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
 	PermitGroupUser(ctx context.Context, groupID GroupID, body PermitGroupUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListGlobalJoins Fetch all join tokens
+	//
+	// Corresponds with GET /joins (the `ListGlobalJoins` operationId).
+	ListGlobalJoins(ctx context.Context, params *ListGlobalJoinsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGlobalJoinWithBody Create a new global join token
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+	CreateGlobalJoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateGlobalJoin Create a new global join token
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+	CreateGlobalJoin(ctx context.Context, body CreateGlobalJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteGlobalJoin Delete a specific join token
+	//
+	// Corresponds with DELETE /joins/{join_id} (the `DeleteGlobalJoin` operationId).
+	DeleteGlobalJoin(ctx context.Context, joinID JoinID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ShowProfile Fetch profile details of the personal account
 	//
 	// Corresponds with GET /profile/self (the `ShowProfile` operationId).
@@ -3859,6 +4372,11 @@ type ClientInterface interface {
 	// Corresponds with GET /projects/{project_id}/executions/{execution_id} (the `ShowProjectExecution` operationId).
 	ShowProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApproveProjectExecution Approve a specific execution awaiting confirmation
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/approve (the `ApproveProjectExecution` operationId).
+	ApproveProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// OutputProjectExecution Output a specific execution for a project
 	//
 	// Corresponds with GET /projects/{project_id}/executions/{execution_id}/output (the `OutputProjectExecution` operationId).
@@ -3869,6 +4387,21 @@ type ClientInterface interface {
 	// Corresponds with GET /projects/{project_id}/executions/{execution_id}/purge (the `PurgeProjectExecution` operationId).
 	PurgeProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RejectProjectExecution Reject a specific execution awaiting confirmation
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/reject (the `RejectProjectExecution` operationId).
+	RejectProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RerunProjectExecution Rerun a specific execution with the same parameters
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/rerun (the `RerunProjectExecution` operationId).
+	RerunProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StopProjectExecution Stop a specific execution for a project
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/stop (the `StopProjectExecution` operationId).
+	StopProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteProjectFromGroupWithBody Unlink a group from project
 	//
 	// Takes any type of body and a specified content type.
@@ -3959,6 +4492,30 @@ type ClientInterface interface {
 	// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
 	UpdateProjectInventory(ctx context.Context, projectID ProjectID, inventoryID InventoryID, body UpdateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProjectJoins Fetch all join tokens for a project
+	//
+	// Corresponds with GET /projects/{project_id}/joins (the `ListProjectJoins` operationId).
+	ListProjectJoins(ctx context.Context, projectID ProjectID, params *ListProjectJoinsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectJoinWithBody Create a new join token
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+	CreateProjectJoinWithBody(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateProjectJoin Create a new join token
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+	CreateProjectJoin(ctx context.Context, projectID ProjectID, body CreateProjectJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteProjectJoin Delete a specific join token for a project
+	//
+	// Corresponds with DELETE /projects/{project_id}/joins/{join_id} (the `DeleteProjectJoin` operationId).
+	DeleteProjectJoin(ctx context.Context, projectID ProjectID, joinID JoinID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectRepositories Fetch all repositories for a project
 	//
 	// Corresponds with GET /projects/{project_id}/repositories (the `ListProjectRepositories` operationId).
@@ -4045,6 +4602,25 @@ type ClientInterface interface {
 	// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
 	UpdateProjectRunner(ctx context.Context, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RevokeProjectRunner Revoke the token of a specific runner for a project
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/revoke (the `RevokeProjectRunner` operationId).
+	RevokeProjectRunner(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateProjectRunnerWithBody Rotate the token of a specific runner for a project
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+	RotateProjectRunnerWithBody(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateProjectRunner Rotate the token of a specific runner for a project
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+	RotateProjectRunner(ctx context.Context, projectID ProjectID, runnerID RunnerID, body RotateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectSchedules Fetch all schedules for a project
 	//
 	// Corresponds with GET /projects/{project_id}/schedules (the `ListProjectSchedules` operationId).
@@ -4072,7 +4648,7 @@ type ClientInterface interface {
 	// ShowProjectSchedule Fetch a specific schedule for a project
 	//
 	// Corresponds with GET /projects/{project_id}/schedules/{schedule_id} (the `ShowProjectSchedule` operationId).
-	ShowProjectSchedule(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ShowProjectSchedule(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ShowProjectScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateProjectScheduleWithBody Update a specific schedule for a project
 	//
@@ -4088,6 +4664,11 @@ type ClientInterface interface {
 	// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
 	UpdateProjectSchedule(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListProjectScheduleRuns Fetch the run history of a schedule for a project
+	//
+	// Corresponds with GET /projects/{project_id}/schedules/{schedule_id}/runs (the `ListProjectScheduleRuns` operationId).
+	ListProjectScheduleRuns(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ListProjectScheduleRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListProjectTemplates Fetch all templates for a project
 	//
 	// Corresponds with GET /projects/{project_id}/templates (the `ListProjectTemplates` operationId).
@@ -4244,6 +4825,77 @@ type ClientInterface interface {
 	// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
 	PermitProjectUser(ctx context.Context, projectID ProjectID, body PermitProjectUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ClaimRunnerExecution Claim the next waiting execution
+	//
+	// Corresponds with POST /runner/executions (the `ClaimRunnerExecution` operationId).
+	ClaimRunnerExecution(ctx context.Context, params *ClaimRunnerExecutionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ShowRunnerExecution Fetch a specific claimed execution
+	//
+	// Corresponds with GET /runner/executions/{execution_id} (the `ShowRunnerExecution` operationId).
+	ShowRunnerExecution(ctx context.Context, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRunnerExecutionWithBody Update the status of a claimed execution
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+	UpdateRunnerExecutionWithBody(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRunnerExecution Update the status of a claimed execution
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+	UpdateRunnerExecution(ctx context.Context, executionID ExecutionID, body UpdateRunnerExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRunnerOutputWithBody Append output to a claimed execution
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+	CreateRunnerOutputWithBody(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRunnerOutput Append output to a claimed execution
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+	CreateRunnerOutput(ctx context.Context, executionID ExecutionID, body CreateRunnerOutputJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HeartbeatRunner Send a heartbeat for the authenticated runner
+	//
+	// Corresponds with POST /runner/heartbeat (the `HeartbeatRunner` operationId).
+	HeartbeatRunner(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// JoinRunnerWithBody Exchange a join token for a new runner
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+	JoinRunnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// JoinRunner Exchange a join token for a new runner
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+	JoinRunner(ctx context.Context, body JoinRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterRunnerWithBody Register the authenticated runner
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+	RegisterRunnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterRunner Register the authenticated runner
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+	RegisterRunner(ctx context.Context, body RegisterRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListGlobalRunners Fetch all runners
 	//
 	// Corresponds with GET /runners (the `ListGlobalRunners` operationId).
@@ -4287,6 +4939,25 @@ type ClientInterface interface {
 	// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
 	UpdateGlobalRunner(ctx context.Context, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RevokeGlobalRunner Revoke the token of a specific runner
+	//
+	// Corresponds with POST /runners/{runner_id}/revoke (the `RevokeGlobalRunner` operationId).
+	RevokeGlobalRunner(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateGlobalRunnerWithBody Rotate the token of a specific runner
+	//
+	// Takes any type of body and a specified content type.
+	//
+	// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+	RotateGlobalRunnerWithBody(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RotateGlobalRunner Rotate the token of a specific runner
+	//
+	// Takes a body of the `application/json` content type.
+	//
+	// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+	RotateGlobalRunner(ctx context.Context, runnerID RunnerID, body RotateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListUsers Fetch all available users
 	//
 	// Corresponds with GET /users (the `ListUsers` operationId).
@@ -4930,6 +5601,70 @@ func (c *Client) PermitGroupUser(ctx context.Context, groupID GroupID, body Perm
 	return c.Client.Do(req)
 }
 
+// ListGlobalJoins Fetch all join tokens
+//
+// Corresponds with GET /joins (the `ListGlobalJoins` operationId).
+func (c *Client) ListGlobalJoins(ctx context.Context, params *ListGlobalJoinsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGlobalJoinsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateGlobalJoinWithBody Create a new global join token
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+func (c *Client) CreateGlobalJoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGlobalJoinRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateGlobalJoin Create a new global join token
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+func (c *Client) CreateGlobalJoin(ctx context.Context, body CreateGlobalJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateGlobalJoinRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteGlobalJoin Delete a specific join token
+//
+// Corresponds with DELETE /joins/{join_id} (the `DeleteGlobalJoin` operationId).
+func (c *Client) DeleteGlobalJoin(ctx context.Context, joinID JoinID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteGlobalJoinRequest(c.Server, joinID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ShowProfile Fetch profile details of the personal account
 //
 // Corresponds with GET /profile/self (the `ShowProfile` operationId).
@@ -5593,6 +6328,21 @@ func (c *Client) ShowProjectExecution(ctx context.Context, projectID ProjectID,
 	return c.Client.Do(req)
 }
 
+// ApproveProjectExecution Approve a specific execution awaiting confirmation
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/approve (the `ApproveProjectExecution` operationId).
+func (c *Client) ApproveProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveProjectExecutionRequest(c.Server, projectID, executionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // OutputProjectExecution Output a specific execution for a project
 //
 // Corresponds with GET /projects/{project_id}/executions/{execution_id}/output (the `OutputProjectExecution` operationId).
@@ -5623,6 +6373,51 @@ func (c *Client) PurgeProjectExecution(ctx context.Context, projectID ProjectID,
 	return c.Client.Do(req)
 }
 
+// RejectProjectExecution Reject a specific execution awaiting confirmation
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/reject (the `RejectProjectExecution` operationId).
+func (c *Client) RejectProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectProjectExecutionRequest(c.Server, projectID, executionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RerunProjectExecution Rerun a specific execution with the same parameters
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/rerun (the `RerunProjectExecution` operationId).
+func (c *Client) RerunProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRerunProjectExecutionRequest(c.Server, projectID, executionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// StopProjectExecution Stop a specific execution for a project
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/stop (the `StopProjectExecution` operationId).
+func (c *Client) StopProjectExecution(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopProjectExecutionRequest(c.Server, projectID, executionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // DeleteProjectFromGroupWithBody Unlink a group from project
 //
 // Takes any type of body and a specified content type.
@@ -5853,6 +6648,70 @@ func (c *Client) UpdateProjectInventory(ctx context.Context, projectID ProjectID
 	return c.Client.Do(req)
 }
 
+// ListProjectJoins Fetch all join tokens for a project
+//
+// Corresponds with GET /projects/{project_id}/joins (the `ListProjectJoins` operationId).
+func (c *Client) ListProjectJoins(ctx context.Context, projectID ProjectID, params *ListProjectJoinsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectJoinsRequest(c.Server, projectID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProjectJoinWithBody Create a new join token
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+func (c *Client) CreateProjectJoinWithBody(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectJoinRequestWithBody(c.Server, projectID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateProjectJoin Create a new join token
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+func (c *Client) CreateProjectJoin(ctx context.Context, projectID ProjectID, body CreateProjectJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateProjectJoinRequest(c.Server, projectID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// DeleteProjectJoin Delete a specific join token for a project
+//
+// Corresponds with DELETE /projects/{project_id}/joins/{join_id} (the `DeleteProjectJoin` operationId).
+func (c *Client) DeleteProjectJoin(ctx context.Context, projectID ProjectID, joinID JoinID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProjectJoinRequest(c.Server, projectID, joinID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListProjectRepositories Fetch all repositories for a project
 //
 // Corresponds with GET /projects/{project_id}/repositories (the `ListProjectRepositories` operationId).
@@ -6079,6 +6938,55 @@ func (c *Client) UpdateProjectRunner(ctx context.Context, projectID ProjectID, r
 	return c.Client.Do(req)
 }
 
+// RevokeProjectRunner Revoke the token of a specific runner for a project
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/revoke (the `RevokeProjectRunner` operationId).
+func (c *Client) RevokeProjectRunner(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeProjectRunnerRequest(c.Server, projectID, runnerID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RotateProjectRunnerWithBody Rotate the token of a specific runner for a project
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+func (c *Client) RotateProjectRunnerWithBody(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateProjectRunnerRequestWithBody(c.Server, projectID, runnerID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RotateProjectRunner Rotate the token of a specific runner for a project
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+func (c *Client) RotateProjectRunner(ctx context.Context, projectID ProjectID, runnerID RunnerID, body RotateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateProjectRunnerRequest(c.Server, projectID, runnerID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListProjectSchedules Fetch all schedules for a project
 //
 // Corresponds with GET /projects/{project_id}/schedules (the `ListProjectSchedules` operationId).
@@ -6146,8 +7054,8 @@ func (c *Client) DeleteProjectSchedule(ctx context.Context, projectID ProjectID,
 // ShowProjectSchedule Fetch a specific schedule for a project
 //
 // Corresponds with GET /projects/{project_id}/schedules/{schedule_id} (the `ShowProjectSchedule` operationId).
-func (c *Client) ShowProjectSchedule(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewShowProjectScheduleRequest(c.Server, projectID, scheduleID)
+func (c *Client) ShowProjectSchedule(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ShowProjectScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShowProjectScheduleRequest(c.Server, projectID, scheduleID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -6192,6 +7100,21 @@ func (c *Client) UpdateProjectSchedule(ctx context.Context, projectID ProjectID,
 	return c.Client.Do(req)
 }
 
+// ListProjectScheduleRuns Fetch the run history of a schedule for a project
+//
+// Corresponds with GET /projects/{project_id}/schedules/{schedule_id}/runs (the `ListProjectScheduleRuns` operationId).
+func (c *Client) ListProjectScheduleRuns(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ListProjectScheduleRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListProjectScheduleRunsRequest(c.Server, projectID, scheduleID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListProjectTemplates Fetch all templates for a project
 //
 // Corresponds with GET /projects/{project_id}/templates (the `ListProjectTemplates` operationId).
@@ -6588,6 +7511,187 @@ func (c *Client) PermitProjectUser(ctx context.Context, projectID ProjectID, bod
 	return c.Client.Do(req)
 }
 
+// ClaimRunnerExecution Claim the next waiting execution
+//
+// Corresponds with POST /runner/executions (the `ClaimRunnerExecution` operationId).
+func (c *Client) ClaimRunnerExecution(ctx context.Context, params *ClaimRunnerExecutionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClaimRunnerExecutionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// ShowRunnerExecution Fetch a specific claimed execution
+//
+// Corresponds with GET /runner/executions/{execution_id} (the `ShowRunnerExecution` operationId).
+func (c *Client) ShowRunnerExecution(ctx context.Context, executionID ExecutionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShowRunnerExecutionRequest(c.Server, executionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateRunnerExecutionWithBody Update the status of a claimed execution
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+func (c *Client) UpdateRunnerExecutionWithBody(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRunnerExecutionRequestWithBody(c.Server, executionID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// UpdateRunnerExecution Update the status of a claimed execution
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+func (c *Client) UpdateRunnerExecution(ctx context.Context, executionID ExecutionID, body UpdateRunnerExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRunnerExecutionRequest(c.Server, executionID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateRunnerOutputWithBody Append output to a claimed execution
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+func (c *Client) CreateRunnerOutputWithBody(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRunnerOutputRequestWithBody(c.Server, executionID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// CreateRunnerOutput Append output to a claimed execution
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+func (c *Client) CreateRunnerOutput(ctx context.Context, executionID ExecutionID, body CreateRunnerOutputJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRunnerOutputRequest(c.Server, executionID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// HeartbeatRunner Send a heartbeat for the authenticated runner
+//
+// Corresponds with POST /runner/heartbeat (the `HeartbeatRunner` operationId).
+func (c *Client) HeartbeatRunner(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHeartbeatRunnerRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// JoinRunnerWithBody Exchange a join token for a new runner
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+func (c *Client) JoinRunnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinRunnerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// JoinRunner Exchange a join token for a new runner
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+func (c *Client) JoinRunner(ctx context.Context, body JoinRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinRunnerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RegisterRunnerWithBody Register the authenticated runner
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+func (c *Client) RegisterRunnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRunnerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RegisterRunner Register the authenticated runner
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+func (c *Client) RegisterRunner(ctx context.Context, body RegisterRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRunnerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListGlobalRunners Fetch all runners
 //
 // Corresponds with GET /runners (the `ListGlobalRunners` operationId).
@@ -6701,6 +7805,55 @@ func (c *Client) UpdateGlobalRunner(ctx context.Context, runnerID RunnerID, body
 	return c.Client.Do(req)
 }
 
+// RevokeGlobalRunner Revoke the token of a specific runner
+//
+// Corresponds with POST /runners/{runner_id}/revoke (the `RevokeGlobalRunner` operationId).
+func (c *Client) RevokeGlobalRunner(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeGlobalRunnerRequest(c.Server, runnerID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RotateGlobalRunnerWithBody Rotate the token of a specific runner
+//
+// Takes any type of body and a specified content type.
+//
+// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+func (c *Client) RotateGlobalRunnerWithBody(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateGlobalRunnerRequestWithBody(c.Server, runnerID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// RotateGlobalRunner Rotate the token of a specific runner
+//
+// Takes a body of the `application/json` content type.
+//
+// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+func (c *Client) RotateGlobalRunner(ctx context.Context, runnerID RunnerID, body RotateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRotateGlobalRunnerRequest(c.Server, runnerID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // ListUsers Fetch all available users
 //
 // Corresponds with GET /users (the `ListUsers` operationId).
@@ -8151,75 +9304,8 @@ func NewPermitGroupUserRequestWithBody(server string, groupID GroupID, contentTy
 	return req, nil
 }
 
-// NewShowProfileRequest constructs an http.Request for the ShowProfile method
-func NewShowProfileRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/profile/self")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewUpdateProfileRequest calls the generic UpdateProfile builder with application/json body
-func NewUpdateProfileRequest(server string, body UpdateProfileJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProfileRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUpdateProfileRequestWithBody constructs an http.Request for the UpdateProfile method, with any body, and a specified content type
-func NewUpdateProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/profile/self")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewTokenProfileRequest constructs an http.Request for the TokenProfile method
-func NewTokenProfileRequest(server string) (*http.Request, error) {
+// NewListGlobalJoinsRequest constructs an http.Request for the ListGlobalJoins method
+func NewListGlobalJoinsRequest(server string, params *ListGlobalJoinsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -8227,34 +9313,7 @@ func NewTokenProfileRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/profile/token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewListProjectsRequest constructs an http.Request for the ListProjects method
-func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/projects")
+	operationPath := fmt.Sprintf("/joins")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8285,30 +9344,6 @@ func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Re
 
 		}
 
-		if params.Sort != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Order != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.Limit != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
@@ -8347,19 +9382,19 @@ func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Re
 	return req, nil
 }
 
-// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
-func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
+// NewCreateGlobalJoinRequest calls the generic CreateGlobalJoin builder with application/json body
+func NewCreateGlobalJoinRequest(server string, body CreateGlobalJoinJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateGlobalJoinRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateProjectRequestWithBody constructs an http.Request for the CreateProject method, with any body, and a specified content type
-func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateGlobalJoinRequestWithBody constructs an http.Request for the CreateGlobalJoin method, with any body, and a specified content type
+func NewCreateGlobalJoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -8367,7 +9402,7 @@ func NewCreateProjectRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects")
+	operationPath := fmt.Sprintf("/joins")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8387,13 +9422,13 @@ func NewCreateProjectRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteProjectRequest constructs an http.Request for the DeleteProject method
-func NewDeleteProjectRequest(server string, projectID ProjectID) (*http.Request, error) {
+// NewDeleteGlobalJoinRequest constructs an http.Request for the DeleteGlobalJoin method
+func NewDeleteGlobalJoinRequest(server string, joinID JoinID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "join_id", joinID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -8403,7 +9438,7 @@ func NewDeleteProjectRequest(server string, projectID ProjectID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/joins/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8421,23 +9456,16 @@ func NewDeleteProjectRequest(server string, projectID ProjectID) (*http.Request,
 	return req, nil
 }
 
-// NewShowProjectRequest constructs an http.Request for the ShowProject method
-func NewShowProjectRequest(server string, projectID ProjectID) (*http.Request, error) {
+// NewShowProfileRequest constructs an http.Request for the ShowProfile method
+func NewShowProfileRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/profile/self")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8455,34 +9483,27 @@ func NewShowProjectRequest(server string, projectID ProjectID) (*http.Request, e
 	return req, nil
 }
 
-// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
-func NewUpdateProjectRequest(server string, projectID ProjectID, body UpdateProjectJSONRequestBody) (*http.Request, error) {
+// NewUpdateProfileRequest calls the generic UpdateProfile builder with application/json body
+func NewUpdateProfileRequest(server string, body UpdateProfileJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewUpdateProfileRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateProjectRequestWithBody constructs an http.Request for the UpdateProject method, with any body, and a specified content type
-func NewUpdateProjectRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProfileRequestWithBody constructs an http.Request for the UpdateProfile method, with any body, and a specified content type
+func NewUpdateProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	operationPath := fmt.Sprintf("/profile/self")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8502,23 +9523,43 @@ func NewUpdateProjectRequestWithBody(server string, projectID ProjectID, content
 	return req, nil
 }
 
-// NewListProjectCredentialsRequest constructs an http.Request for the ListProjectCredentials method
-func NewListProjectCredentialsRequest(server string, projectID ProjectID, params *ListProjectCredentialsParams) (*http.Request, error) {
+// NewTokenProfileRequest constructs an http.Request for the TokenProfile method
+func NewTokenProfileRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/profile/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListProjectsRequest constructs an http.Request for the ListProjects method
+func NewListProjectsRequest(server string, params *ListProjectsParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/credentials", pathParam0)
+	operationPath := fmt.Sprintf("/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8611,34 +9652,27 @@ func NewListProjectCredentialsRequest(server string, projectID ProjectID, params
 	return req, nil
 }
 
-// NewCreateProjectCredentialRequest calls the generic CreateProjectCredential builder with application/json body
-func NewCreateProjectCredentialRequest(server string, projectID ProjectID, body CreateProjectCredentialJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectRequest calls the generic CreateProject builder with application/json body
+func NewCreateProjectRequest(server string, body CreateProjectJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectCredentialRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewCreateProjectRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateProjectCredentialRequestWithBody constructs an http.Request for the CreateProjectCredential method, with any body, and a specified content type
-func NewCreateProjectCredentialRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectRequestWithBody constructs an http.Request for the CreateProject method, with any body, and a specified content type
+func NewCreateProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/credentials", pathParam0)
+	operationPath := fmt.Sprintf("/projects")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8658,8 +9692,8 @@ func NewCreateProjectCredentialRequestWithBody(server string, projectID ProjectI
 	return req, nil
 }
 
-// NewDeleteProjectCredentialRequest constructs an http.Request for the DeleteProjectCredential method
-func NewDeleteProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID) (*http.Request, error) {
+// NewDeleteProjectRequest constructs an http.Request for the DeleteProject method
+func NewDeleteProjectRequest(server string, projectID ProjectID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8669,19 +9703,12 @@ func NewDeleteProjectCredentialRequest(server string, projectID ProjectID, crede
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8699,8 +9726,8 @@ func NewDeleteProjectCredentialRequest(server string, projectID ProjectID, crede
 	return req, nil
 }
 
-// NewShowProjectCredentialRequest constructs an http.Request for the ShowProjectCredential method
-func NewShowProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID) (*http.Request, error) {
+// NewShowProjectRequest constructs an http.Request for the ShowProject method
+func NewShowProjectRequest(server string, projectID ProjectID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8710,19 +9737,12 @@ func NewShowProjectCredentialRequest(server string, projectID ProjectID, credent
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8740,19 +9760,19 @@ func NewShowProjectCredentialRequest(server string, projectID ProjectID, credent
 	return req, nil
 }
 
-// NewUpdateProjectCredentialRequest calls the generic UpdateProjectCredential builder with application/json body
-func NewUpdateProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID, body UpdateProjectCredentialJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, projectID ProjectID, body UpdateProjectJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectCredentialRequestWithBody(server, projectID, credentialID, "application/json", bodyReader)
+	return NewUpdateProjectRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewUpdateProjectCredentialRequestWithBody constructs an http.Request for the UpdateProjectCredential method, with any body, and a specified content type
-func NewUpdateProjectCredentialRequestWithBody(server string, projectID ProjectID, credentialID CredentialID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectRequestWithBody constructs an http.Request for the UpdateProject method, with any body, and a specified content type
+func NewUpdateProjectRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8762,19 +9782,12 @@ func NewUpdateProjectCredentialRequestWithBody(server string, projectID ProjectI
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8794,8 +9807,8 @@ func NewUpdateProjectCredentialRequestWithBody(server string, projectID ProjectI
 	return req, nil
 }
 
-// NewListProjectEnvironmentsRequest constructs an http.Request for the ListProjectEnvironments method
-func NewListProjectEnvironmentsRequest(server string, projectID ProjectID, params *ListProjectEnvironmentsParams) (*http.Request, error) {
+// NewListProjectCredentialsRequest constructs an http.Request for the ListProjectCredentials method
+func NewListProjectCredentialsRequest(server string, projectID ProjectID, params *ListProjectCredentialsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8810,7 +9823,299 @@ func NewListProjectEnvironmentsRequest(server string, projectID ProjectID, param
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/environments", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/credentials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateProjectCredentialRequest calls the generic CreateProjectCredential builder with application/json body
+func NewCreateProjectCredentialRequest(server string, projectID ProjectID, body CreateProjectCredentialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectCredentialRequestWithBody(server, projectID, "application/json", bodyReader)
+}
+
+// NewCreateProjectCredentialRequestWithBody constructs an http.Request for the CreateProjectCredential method, with any body, and a specified content type
+func NewCreateProjectCredentialRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/credentials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectCredentialRequest constructs an http.Request for the DeleteProjectCredential method
+func NewDeleteProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShowProjectCredentialRequest constructs an http.Request for the ShowProjectCredential method
+func NewShowProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectCredentialRequest calls the generic UpdateProjectCredential builder with application/json body
+func NewUpdateProjectCredentialRequest(server string, projectID ProjectID, credentialID CredentialID, body UpdateProjectCredentialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectCredentialRequestWithBody(server, projectID, credentialID, "application/json", bodyReader)
+}
+
+// NewUpdateProjectCredentialRequestWithBody constructs an http.Request for the UpdateProjectCredential method, with any body, and a specified content type
+func NewUpdateProjectCredentialRequestWithBody(server string, projectID ProjectID, credentialID CredentialID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "credential_id", credentialID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/credentials/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectEnvironmentsRequest constructs an http.Request for the ListProjectEnvironments method
+func NewListProjectEnvironmentsRequest(server string, projectID ProjectID, params *ListProjectEnvironmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/environments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -9735,6 +11040,47 @@ func NewShowProjectExecutionRequest(server string, projectID ProjectID, executio
 	return req, nil
 }
 
+// NewApproveProjectExecutionRequest constructs an http.Request for the ApproveProjectExecution method
+func NewApproveProjectExecutionRequest(server string, projectID ProjectID, executionID ExecutionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/executions/%s/approve", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewOutputProjectExecutionRequest constructs an http.Request for the OutputProjectExecution method
 func NewOutputProjectExecutionRequest(server string, projectID ProjectID, executionID ExecutionID) (*http.Request, error) {
 	var err error
@@ -9817,6 +11163,129 @@ func NewPurgeProjectExecutionRequest(server string, projectID ProjectID, executi
 	return req, nil
 }
 
+// NewRejectProjectExecutionRequest constructs an http.Request for the RejectProjectExecution method
+func NewRejectProjectExecutionRequest(server string, projectID ProjectID, executionID ExecutionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/executions/%s/reject", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRerunProjectExecutionRequest constructs an http.Request for the RerunProjectExecution method
+func NewRerunProjectExecutionRequest(server string, projectID ProjectID, executionID ExecutionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/executions/%s/rerun", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStopProjectExecutionRequest constructs an http.Request for the StopProjectExecution method
+func NewStopProjectExecutionRequest(server string, projectID ProjectID, executionID ExecutionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/executions/%s/stop", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewDeleteProjectFromGroupRequest calls the generic DeleteProjectFromGroup builder with application/json body
 func NewDeleteProjectFromGroupRequest(server string, projectID ProjectID, body DeleteProjectFromGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
@@ -10359,8 +11828,8 @@ func NewUpdateProjectInventoryRequestWithBody(server string, projectID ProjectID
 	return req, nil
 }
 
-// NewListProjectRepositoriesRequest constructs an http.Request for the ListProjectRepositories method
-func NewListProjectRepositoriesRequest(server string, projectID ProjectID, params *ListProjectRepositoriesParams) (*http.Request, error) {
+// NewListProjectJoinsRequest constructs an http.Request for the ListProjectJoins method
+func NewListProjectJoinsRequest(server string, projectID ProjectID, params *ListProjectJoinsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10375,7 +11844,7 @@ func NewListProjectRepositoriesRequest(server string, projectID ProjectID, param
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/repositories", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/joins", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10406,30 +11875,6 @@ func NewListProjectRepositoriesRequest(server string, projectID ProjectID, param
 
 		}
 
-		if params.Sort != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Order != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.Limit != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
@@ -10468,19 +11913,19 @@ func NewListProjectRepositoriesRequest(server string, projectID ProjectID, param
 	return req, nil
 }
 
-// NewCreateProjectRepositoryRequest calls the generic CreateProjectRepository builder with application/json body
-func NewCreateProjectRepositoryRequest(server string, projectID ProjectID, body CreateProjectRepositoryJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectJoinRequest calls the generic CreateProjectJoin builder with application/json body
+func NewCreateProjectJoinRequest(server string, projectID ProjectID, body CreateProjectJoinJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRepositoryRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewCreateProjectJoinRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewCreateProjectRepositoryRequestWithBody constructs an http.Request for the CreateProjectRepository method, with any body, and a specified content type
-func NewCreateProjectRepositoryRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectJoinRequestWithBody constructs an http.Request for the CreateProjectJoin method, with any body, and a specified content type
+func NewCreateProjectJoinRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10495,7 +11940,7 @@ func NewCreateProjectRepositoryRequestWithBody(server string, projectID ProjectI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/repositories", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/joins", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10515,8 +11960,8 @@ func NewCreateProjectRepositoryRequestWithBody(server string, projectID ProjectI
 	return req, nil
 }
 
-// NewDeleteProjectRepositoryRequest constructs an http.Request for the DeleteProjectRepository method
-func NewDeleteProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID) (*http.Request, error) {
+// NewDeleteProjectJoinRequest constructs an http.Request for the DeleteProjectJoin method
+func NewDeleteProjectJoinRequest(server string, projectID ProjectID, joinID JoinID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10528,7 +11973,7 @@ func NewDeleteProjectRepositoryRequest(server string, projectID ProjectID, repos
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "join_id", joinID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10538,7 +11983,7 @@ func NewDeleteProjectRepositoryRequest(server string, projectID ProjectID, repos
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/joins/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10556,103 +12001,8 @@ func NewDeleteProjectRepositoryRequest(server string, projectID ProjectID, repos
 	return req, nil
 }
 
-// NewShowProjectRepositoryRequest constructs an http.Request for the ShowProjectRepository method
-func NewShowProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewUpdateProjectRepositoryRequest calls the generic UpdateProjectRepository builder with application/json body
-func NewUpdateProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID, body UpdateProjectRepositoryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRepositoryRequestWithBody(server, projectID, repositoryID, "application/json", bodyReader)
-}
-
-// NewUpdateProjectRepositoryRequestWithBody constructs an http.Request for the UpdateProjectRepository method, with any body, and a specified content type
-func NewUpdateProjectRepositoryRequestWithBody(server string, projectID ProjectID, repositoryID RepositoryID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewListProjectRunnersRequest constructs an http.Request for the ListProjectRunners method
-func NewListProjectRunnersRequest(server string, projectID ProjectID, params *ListProjectRunnersParams) (*http.Request, error) {
+// NewListProjectRepositoriesRequest constructs an http.Request for the ListProjectRepositories method
+func NewListProjectRepositoriesRequest(server string, projectID ProjectID, params *ListProjectRepositoriesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10667,7 +12017,7 @@ func NewListProjectRunnersRequest(server string, projectID ProjectID, params *Li
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/runners", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/repositories", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10760,19 +12110,19 @@ func NewListProjectRunnersRequest(server string, projectID ProjectID, params *Li
 	return req, nil
 }
 
-// NewCreateProjectRunnerRequest calls the generic CreateProjectRunner builder with application/json body
-func NewCreateProjectRunnerRequest(server string, projectID ProjectID, body CreateProjectRunnerJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectRepositoryRequest calls the generic CreateProjectRepository builder with application/json body
+func NewCreateProjectRepositoryRequest(server string, projectID ProjectID, body CreateProjectRepositoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectRunnerRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewCreateProjectRepositoryRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewCreateProjectRunnerRequestWithBody constructs an http.Request for the CreateProjectRunner method, with any body, and a specified content type
-func NewCreateProjectRunnerRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectRepositoryRequestWithBody constructs an http.Request for the CreateProjectRepository method, with any body, and a specified content type
+func NewCreateProjectRepositoryRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10787,7 +12137,7 @@ func NewCreateProjectRunnerRequestWithBody(server string, projectID ProjectID, c
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/runners", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/repositories", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10807,8 +12157,8 @@ func NewCreateProjectRunnerRequestWithBody(server string, projectID ProjectID, c
 	return req, nil
 }
 
-// NewDeleteProjectRunnerRequest constructs an http.Request for the DeleteProjectRunner method
-func NewDeleteProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID) (*http.Request, error) {
+// NewDeleteProjectRepositoryRequest constructs an http.Request for the DeleteProjectRepository method
+func NewDeleteProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10820,7 +12170,7 @@ func NewDeleteProjectRunnerRequest(server string, projectID ProjectID, runnerID
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10830,7 +12180,7 @@ func NewDeleteProjectRunnerRequest(server string, projectID ProjectID, runnerID
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10848,8 +12198,8 @@ func NewDeleteProjectRunnerRequest(server string, projectID ProjectID, runnerID
 	return req, nil
 }
 
-// NewShowProjectRunnerRequest constructs an http.Request for the ShowProjectRunner method
-func NewShowProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID) (*http.Request, error) {
+// NewShowProjectRepositoryRequest constructs an http.Request for the ShowProjectRepository method
+func NewShowProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10861,7 +12211,7 @@ func NewShowProjectRunnerRequest(server string, projectID ProjectID, runnerID Ru
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10871,7 +12221,7 @@ func NewShowProjectRunnerRequest(server string, projectID ProjectID, runnerID Ru
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10889,19 +12239,19 @@ func NewShowProjectRunnerRequest(server string, projectID ProjectID, runnerID Ru
 	return req, nil
 }
 
-// NewUpdateProjectRunnerRequest calls the generic UpdateProjectRunner builder with application/json body
-func NewUpdateProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectRepositoryRequest calls the generic UpdateProjectRepository builder with application/json body
+func NewUpdateProjectRepositoryRequest(server string, projectID ProjectID, repositoryID RepositoryID, body UpdateProjectRepositoryJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRunnerRequestWithBody(server, projectID, runnerID, "application/json", bodyReader)
+	return NewUpdateProjectRepositoryRequestWithBody(server, projectID, repositoryID, "application/json", bodyReader)
 }
 
-// NewUpdateProjectRunnerRequestWithBody constructs an http.Request for the UpdateProjectRunner method, with any body, and a specified content type
-func NewUpdateProjectRunnerRequestWithBody(server string, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectRepositoryRequestWithBody constructs an http.Request for the UpdateProjectRepository method, with any body, and a specified content type
+func NewUpdateProjectRepositoryRequestWithBody(server string, projectID ProjectID, repositoryID RepositoryID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10913,7 +12263,7 @@ func NewUpdateProjectRunnerRequestWithBody(server string, projectID ProjectID, r
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "repository_id", repositoryID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -10923,7 +12273,7 @@ func NewUpdateProjectRunnerRequestWithBody(server string, projectID ProjectID, r
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/repositories/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -10943,8 +12293,8 @@ func NewUpdateProjectRunnerRequestWithBody(server string, projectID ProjectID, r
 	return req, nil
 }
 
-// NewListProjectSchedulesRequest constructs an http.Request for the ListProjectSchedules method
-func NewListProjectSchedulesRequest(server string, projectID ProjectID, params *ListProjectSchedulesParams) (*http.Request, error) {
+// NewListProjectRunnersRequest constructs an http.Request for the ListProjectRunners method
+func NewListProjectRunnersRequest(server string, projectID ProjectID, params *ListProjectRunnersParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -10959,7 +12309,7 @@ func NewListProjectSchedulesRequest(server string, projectID ProjectID, params *
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/schedules", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/runners", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11052,19 +12402,19 @@ func NewListProjectSchedulesRequest(server string, projectID ProjectID, params *
 	return req, nil
 }
 
-// NewCreateProjectScheduleRequest calls the generic CreateProjectSchedule builder with application/json body
-func NewCreateProjectScheduleRequest(server string, projectID ProjectID, body CreateProjectScheduleJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectRunnerRequest calls the generic CreateProjectRunner builder with application/json body
+func NewCreateProjectRunnerRequest(server string, projectID ProjectID, body CreateProjectRunnerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectScheduleRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewCreateProjectRunnerRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewCreateProjectScheduleRequestWithBody constructs an http.Request for the CreateProjectSchedule method, with any body, and a specified content type
-func NewCreateProjectScheduleRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectRunnerRequestWithBody constructs an http.Request for the CreateProjectRunner method, with any body, and a specified content type
+func NewCreateProjectRunnerRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11079,7 +12429,7 @@ func NewCreateProjectScheduleRequestWithBody(server string, projectID ProjectID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/schedules", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/runners", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11099,8 +12449,8 @@ func NewCreateProjectScheduleRequestWithBody(server string, projectID ProjectID,
 	return req, nil
 }
 
-// NewDeleteProjectScheduleRequest constructs an http.Request for the DeleteProjectSchedule method
-func NewDeleteProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID) (*http.Request, error) {
+// NewDeleteProjectRunnerRequest constructs an http.Request for the DeleteProjectRunner method
+func NewDeleteProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11112,7 +12462,7 @@ func NewDeleteProjectScheduleRequest(server string, projectID ProjectID, schedul
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11122,7 +12472,7 @@ func NewDeleteProjectScheduleRequest(server string, projectID ProjectID, schedul
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11140,8 +12490,8 @@ func NewDeleteProjectScheduleRequest(server string, projectID ProjectID, schedul
 	return req, nil
 }
 
-// NewShowProjectScheduleRequest constructs an http.Request for the ShowProjectSchedule method
-func NewShowProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID) (*http.Request, error) {
+// NewShowProjectRunnerRequest constructs an http.Request for the ShowProjectRunner method
+func NewShowProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11153,7 +12503,7 @@ func NewShowProjectScheduleRequest(server string, projectID ProjectID, scheduleI
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11163,7 +12513,7 @@ func NewShowProjectScheduleRequest(server string, projectID ProjectID, scheduleI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11181,19 +12531,19 @@ func NewShowProjectScheduleRequest(server string, projectID ProjectID, scheduleI
 	return req, nil
 }
 
-// NewUpdateProjectScheduleRequest calls the generic UpdateProjectSchedule builder with application/json body
-func NewUpdateProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectRunnerRequest calls the generic UpdateProjectRunner builder with application/json body
+func NewUpdateProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectScheduleRequestWithBody(server, projectID, scheduleID, "application/json", bodyReader)
+	return NewUpdateProjectRunnerRequestWithBody(server, projectID, runnerID, "application/json", bodyReader)
 }
 
-// NewUpdateProjectScheduleRequestWithBody constructs an http.Request for the UpdateProjectSchedule method, with any body, and a specified content type
-func NewUpdateProjectScheduleRequestWithBody(server string, projectID ProjectID, scheduleID ScheduleID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectRunnerRequestWithBody constructs an http.Request for the UpdateProjectRunner method, with any body, and a specified content type
+func NewUpdateProjectRunnerRequestWithBody(server string, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11205,7 +12555,7 @@ func NewUpdateProjectScheduleRequestWithBody(server string, projectID ProjectID,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11215,7 +12565,7 @@ func NewUpdateProjectScheduleRequestWithBody(server string, projectID ProjectID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/runners/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11235,8 +12585,8 @@ func NewUpdateProjectScheduleRequestWithBody(server string, projectID ProjectID,
 	return req, nil
 }
 
-// NewListProjectTemplatesRequest constructs an http.Request for the ListProjectTemplates method
-func NewListProjectTemplatesRequest(server string, projectID ProjectID, params *ListProjectTemplatesParams) (*http.Request, error) {
+// NewRevokeProjectRunnerRequest constructs an http.Request for the RevokeProjectRunner method
+func NewRevokeProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11246,12 +12596,107 @@ func NewListProjectTemplatesRequest(server string, projectID ProjectID, params *
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/runners/%s/revoke", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateProjectRunnerRequest calls the generic RotateProjectRunner builder with application/json body
+func NewRotateProjectRunnerRequest(server string, projectID ProjectID, runnerID RunnerID, body RotateProjectRunnerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRotateProjectRunnerRequestWithBody(server, projectID, runnerID, "application/json", bodyReader)
+}
+
+// NewRotateProjectRunnerRequestWithBody constructs an http.Request for the RotateProjectRunner method, with any body, and a specified content type
+func NewRotateProjectRunnerRequestWithBody(server string, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/runners/%s/rotate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListProjectSchedulesRequest constructs an http.Request for the ListProjectSchedules method
+func NewListProjectSchedulesRequest(server string, projectID ProjectID, params *ListProjectSchedulesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/schedules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11344,19 +12789,19 @@ func NewListProjectTemplatesRequest(server string, projectID ProjectID, params *
 	return req, nil
 }
 
-// NewCreateProjectTemplateRequest calls the generic CreateProjectTemplate builder with application/json body
-func NewCreateProjectTemplateRequest(server string, projectID ProjectID, body CreateProjectTemplateJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectScheduleRequest calls the generic CreateProjectSchedule builder with application/json body
+func NewCreateProjectScheduleRequest(server string, projectID ProjectID, body CreateProjectScheduleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectTemplateRequestWithBody(server, projectID, "application/json", bodyReader)
+	return NewCreateProjectScheduleRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewCreateProjectTemplateRequestWithBody constructs an http.Request for the CreateProjectTemplate method, with any body, and a specified content type
-func NewCreateProjectTemplateRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectScheduleRequestWithBody constructs an http.Request for the CreateProjectSchedule method, with any body, and a specified content type
+func NewCreateProjectScheduleRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11371,7 +12816,7 @@ func NewCreateProjectTemplateRequestWithBody(server string, projectID ProjectID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates", pathParam0)
+	operationPath := fmt.Sprintf("/projects/%s/schedules", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11391,8 +12836,8 @@ func NewCreateProjectTemplateRequestWithBody(server string, projectID ProjectID,
 	return req, nil
 }
 
-// NewDeleteProjectTemplateRequest constructs an http.Request for the DeleteProjectTemplate method
-func NewDeleteProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID) (*http.Request, error) {
+// NewDeleteProjectScheduleRequest constructs an http.Request for the DeleteProjectSchedule method
+func NewDeleteProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11404,7 +12849,7 @@ func NewDeleteProjectTemplateRequest(server string, projectID ProjectID, templat
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11414,7 +12859,7 @@ func NewDeleteProjectTemplateRequest(server string, projectID ProjectID, templat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11432,8 +12877,8 @@ func NewDeleteProjectTemplateRequest(server string, projectID ProjectID, templat
 	return req, nil
 }
 
-// NewShowProjectTemplateRequest constructs an http.Request for the ShowProjectTemplate method
-func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID) (*http.Request, error) {
+// NewShowProjectScheduleRequest constructs an http.Request for the ShowProjectSchedule method
+func NewShowProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID, params *ShowProjectScheduleParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11445,7 +12890,7 @@ func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateI
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11455,7 +12900,7 @@ func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateI
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11465,6 +12910,33 @@ func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateI
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Next != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "next", *params.Next, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -11473,19 +12945,19 @@ func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateI
 	return req, nil
 }
 
-// NewUpdateProjectTemplateRequest calls the generic UpdateProjectTemplate builder with application/json body
-func NewUpdateProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID, body UpdateProjectTemplateJSONRequestBody) (*http.Request, error) {
+// NewUpdateProjectScheduleRequest calls the generic UpdateProjectSchedule builder with application/json body
+func NewUpdateProjectScheduleRequest(server string, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectTemplateRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
+	return NewUpdateProjectScheduleRequestWithBody(server, projectID, scheduleID, "application/json", bodyReader)
 }
 
-// NewUpdateProjectTemplateRequestWithBody constructs an http.Request for the UpdateProjectTemplate method, with any body, and a specified content type
-func NewUpdateProjectTemplateRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateProjectScheduleRequestWithBody constructs an http.Request for the UpdateProjectSchedule method, with any body, and a specified content type
+func NewUpdateProjectScheduleRequestWithBody(server string, projectID ProjectID, scheduleID ScheduleID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11497,7 +12969,7 @@ func NewUpdateProjectTemplateRequestWithBody(server string, projectID ProjectID,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11507,7 +12979,7 @@ func NewUpdateProjectTemplateRequestWithBody(server string, projectID ProjectID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/schedules/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11527,19 +12999,8 @@ func NewUpdateProjectTemplateRequestWithBody(server string, projectID ProjectID,
 	return req, nil
 }
 
-// NewCreateProjectTemplateSurveyRequest calls the generic CreateProjectTemplateSurvey builder with application/json body
-func NewCreateProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateSurveyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectTemplateSurveyRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
-}
-
-// NewCreateProjectTemplateSurveyRequestWithBody constructs an http.Request for the CreateProjectTemplateSurvey method, with any body, and a specified content type
-func NewCreateProjectTemplateSurveyRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewListProjectScheduleRunsRequest constructs an http.Request for the ListProjectScheduleRuns method
+func NewListProjectScheduleRunsRequest(server string, projectID ProjectID, scheduleID ScheduleID, params *ListProjectScheduleRunsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11551,7 +13012,7 @@ func NewCreateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "schedule_id", scheduleID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11561,7 +13022,7 @@ func NewCreateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/projects/%s/schedules/%s/runs", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11571,37 +13032,60 @@ func NewCreateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Limit != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// NewDeleteProjectTemplateSurveyRequest constructs an http.Request for the DeleteProjectTemplateSurvey method
-func NewDeleteProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		if params.Offset != nil {
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	var pathParam1 string
+		}
 
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	return req, nil
+}
 
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "survey_id", surveyID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewListProjectTemplatesRequest constructs an http.Request for the ListProjectTemplates method
+func NewListProjectTemplatesRequest(server string, projectID ProjectID, params *ListProjectTemplatesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -11611,7 +13095,7 @@ func NewDeleteProjectTemplateSurveyRequest(server string, projectID ProjectID, t
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s/templates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11621,7 +13105,82 @@ func NewDeleteProjectTemplateSurveyRequest(server string, projectID ProjectID, t
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -11629,19 +13188,19 @@ func NewDeleteProjectTemplateSurveyRequest(server string, projectID ProjectID, t
 	return req, nil
 }
 
-// NewUpdateProjectTemplateSurveyRequest calls the generic UpdateProjectTemplateSurvey builder with application/json body
-func NewUpdateProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID, body UpdateProjectTemplateSurveyJSONRequestBody) (*http.Request, error) {
+// NewCreateProjectTemplateRequest calls the generic CreateProjectTemplate builder with application/json body
+func NewCreateProjectTemplateRequest(server string, projectID ProjectID, body CreateProjectTemplateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectTemplateSurveyRequestWithBody(server, projectID, templateID, surveyID, "application/json", bodyReader)
+	return NewCreateProjectTemplateRequestWithBody(server, projectID, "application/json", bodyReader)
 }
 
-// NewUpdateProjectTemplateSurveyRequestWithBody constructs an http.Request for the UpdateProjectTemplateSurvey method, with any body, and a specified content type
-func NewUpdateProjectTemplateSurveyRequestWithBody(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateProjectTemplateRequestWithBody constructs an http.Request for the CreateProjectTemplate method, with any body, and a specified content type
+func NewCreateProjectTemplateRequestWithBody(server string, projectID ProjectID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -11651,26 +13210,12 @@ func NewUpdateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "survey_id", surveyID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/projects/%s/templates", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -11680,7 +13225,7 @@ func NewUpdateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -11690,19 +13235,318 @@ func NewUpdateProjectTemplateSurveyRequestWithBody(server string, projectID Proj
 	return req, nil
 }
 
-// NewCreateProjectTemplateVaultRequest calls the generic CreateProjectTemplateVault builder with application/json body
-func NewCreateProjectTemplateVaultRequest(server string, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateVaultJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateProjectTemplateVaultRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
-}
-
-// NewCreateProjectTemplateVaultRequestWithBody constructs an http.Request for the CreateProjectTemplateVault method, with any body, and a specified content type
-func NewCreateProjectTemplateVaultRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteProjectTemplateRequest constructs an http.Request for the DeleteProjectTemplate method
+func NewDeleteProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShowProjectTemplateRequest constructs an http.Request for the ShowProjectTemplate method
+func NewShowProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectTemplateRequest calls the generic UpdateProjectTemplate builder with application/json body
+func NewUpdateProjectTemplateRequest(server string, projectID ProjectID, templateID TemplateID, body UpdateProjectTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectTemplateRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
+}
+
+// NewUpdateProjectTemplateRequestWithBody constructs an http.Request for the UpdateProjectTemplate method, with any body, and a specified content type
+func NewUpdateProjectTemplateRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectTemplateSurveyRequest calls the generic CreateProjectTemplateSurvey builder with application/json body
+func NewCreateProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateSurveyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectTemplateSurveyRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
+}
+
+// NewCreateProjectTemplateSurveyRequestWithBody constructs an http.Request for the CreateProjectTemplateSurvey method, with any body, and a specified content type
+func NewCreateProjectTemplateSurveyRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProjectTemplateSurveyRequest constructs an http.Request for the DeleteProjectTemplateSurvey method
+func NewDeleteProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "survey_id", surveyID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectTemplateSurveyRequest calls the generic UpdateProjectTemplateSurvey builder with application/json body
+func NewUpdateProjectTemplateSurveyRequest(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID, body UpdateProjectTemplateSurveyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectTemplateSurveyRequestWithBody(server, projectID, templateID, surveyID, "application/json", bodyReader)
+}
+
+// NewUpdateProjectTemplateSurveyRequestWithBody constructs an http.Request for the UpdateProjectTemplateSurvey method, with any body, and a specified content type
+func NewUpdateProjectTemplateSurveyRequestWithBody(server string, projectID ProjectID, templateID TemplateID, surveyID SurveyID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "project_id", projectID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "template_id", templateID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithOptions("simple", false, "survey_id", surveyID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/templates/%s/surveys/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateProjectTemplateVaultRequest calls the generic CreateProjectTemplateVault builder with application/json body
+func NewCreateProjectTemplateVaultRequest(server string, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateVaultJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateProjectTemplateVaultRequestWithBody(server, projectID, templateID, "application/json", bodyReader)
+}
+
+// NewCreateProjectTemplateVaultRequestWithBody constructs an http.Request for the CreateProjectTemplateVault method, with any body, and a specified content type
+func NewCreateProjectTemplateVaultRequestWithBody(server string, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -12103,8 +13947,8 @@ func NewPermitProjectUserRequestWithBody(server string, projectID ProjectID, con
 	return req, nil
 }
 
-// NewListGlobalRunnersRequest constructs an http.Request for the ListGlobalRunners method
-func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams) (*http.Request, error) {
+// NewClaimRunnerExecutionRequest constructs an http.Request for the ClaimRunnerExecution method
+func NewClaimRunnerExecutionRequest(server string, params *ClaimRunnerExecutionParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -12112,7 +13956,7 @@ func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runners")
+	operationPath := fmt.Sprintf("/runner/executions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12131,9 +13975,9 @@ func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams)
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Search != nil {
+		if params.Wait != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "wait", *params.Wait, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -12143,58 +13987,44 @@ func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams)
 
 		}
 
-		if params.Sort != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-		if params.Order != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Limit != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+// NewShowRunnerExecutionRequest constructs an http.Request for the ShowRunnerExecution method
+func NewShowRunnerExecutionRequest(server string, executionID ExecutionID) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.Offset != nil {
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/runner/executions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
@@ -12205,27 +14035,34 @@ func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams)
 	return req, nil
 }
 
-// NewCreateGlobalRunnerRequest calls the generic CreateGlobalRunner builder with application/json body
-func NewCreateGlobalRunnerRequest(server string, body CreateGlobalRunnerJSONRequestBody) (*http.Request, error) {
+// NewUpdateRunnerExecutionRequest calls the generic UpdateRunnerExecution builder with application/json body
+func NewUpdateRunnerExecutionRequest(server string, executionID ExecutionID, body UpdateRunnerExecutionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateGlobalRunnerRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateRunnerExecutionRequestWithBody(server, executionID, "application/json", bodyReader)
 }
 
-// NewCreateGlobalRunnerRequestWithBody constructs an http.Request for the CreateGlobalRunner method, with any body, and a specified content type
-func NewCreateGlobalRunnerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateRunnerExecutionRequestWithBody constructs an http.Request for the UpdateRunnerExecution method, with any body, and a specified content type
+func NewUpdateRunnerExecutionRequestWithBody(server string, executionID ExecutionID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runners")
+	operationPath := fmt.Sprintf("/runner/executions/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12235,7 +14072,7 @@ func NewCreateGlobalRunnerRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -12245,13 +14082,24 @@ func NewCreateGlobalRunnerRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewDeleteGlobalRunnerRequest constructs an http.Request for the DeleteGlobalRunner method
-func NewDeleteGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request, error) {
+// NewCreateRunnerOutputRequest calls the generic CreateRunnerOutput builder with application/json body
+func NewCreateRunnerOutputRequest(server string, executionID ExecutionID, body CreateRunnerOutputJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRunnerOutputRequestWithBody(server, executionID, "application/json", bodyReader)
+}
+
+// NewCreateRunnerOutputRequestWithBody constructs an http.Request for the CreateRunnerOutput method, with any body, and a specified content type
+func NewCreateRunnerOutputRequestWithBody(server string, executionID ExecutionID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "execution_id", executionID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -12261,7 +14109,7 @@ func NewDeleteGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runner/executions/%s/output", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12271,31 +14119,26 @@ func NewDeleteGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewShowGlobalRunnerRequest constructs an http.Request for the ShowGlobalRunner method
-func NewShowGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request, error) {
+// NewHeartbeatRunnerRequest constructs an http.Request for the HeartbeatRunner method
+func NewHeartbeatRunnerRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runner/heartbeat")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12305,7 +14148,7 @@ func NewShowGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -12313,34 +14156,67 @@ func NewShowGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request
 	return req, nil
 }
 
-// NewUpdateGlobalRunnerRequest calls the generic UpdateGlobalRunner builder with application/json body
-func NewUpdateGlobalRunnerRequest(server string, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody) (*http.Request, error) {
+// NewJoinRunnerRequest calls the generic JoinRunner builder with application/json body
+func NewJoinRunnerRequest(server string, body JoinRunnerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateGlobalRunnerRequestWithBody(server, runnerID, "application/json", bodyReader)
+	return NewJoinRunnerRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateGlobalRunnerRequestWithBody constructs an http.Request for the UpdateGlobalRunner method, with any body, and a specified content type
-func NewUpdateGlobalRunnerRequestWithBody(server string, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
+// NewJoinRunnerRequestWithBody constructs an http.Request for the JoinRunner method, with any body, and a specified content type
+func NewJoinRunnerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/runner/join")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterRunnerRequest calls the generic RegisterRunner builder with application/json body
+func NewRegisterRunnerRequest(server string, body RegisterRunnerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterRunnerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterRunnerRequestWithBody constructs an http.Request for the RegisterRunner method, with any body, and a specified content type
+func NewRegisterRunnerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runner/register")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12350,7 +14226,7 @@ func NewUpdateGlobalRunnerRequestWithBody(server string, runnerID RunnerID, cont
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -12360,8 +14236,8 @@ func NewUpdateGlobalRunnerRequestWithBody(server string, runnerID RunnerID, cont
 	return req, nil
 }
 
-// NewListUsersRequest constructs an http.Request for the ListUsers method
-func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+// NewListGlobalRunnersRequest constructs an http.Request for the ListGlobalRunners method
+func NewListGlobalRunnersRequest(server string, params *ListGlobalRunnersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -12369,7 +14245,7 @@ func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/runners")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12462,19 +14338,19 @@ func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request,
 	return req, nil
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+// NewCreateGlobalRunnerRequest calls the generic CreateGlobalRunner builder with application/json body
+func NewCreateGlobalRunnerRequest(server string, body CreateGlobalRunnerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateGlobalRunnerRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateUserRequestWithBody constructs an http.Request for the CreateUser method, with any body, and a specified content type
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateGlobalRunnerRequestWithBody constructs an http.Request for the CreateGlobalRunner method, with any body, and a specified content type
+func NewCreateGlobalRunnerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -12482,7 +14358,7 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/runners")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12502,13 +14378,13 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteUserRequest constructs an http.Request for the DeleteUser method
-func NewDeleteUserRequest(server string, userID UserID) (*http.Request, error) {
+// NewDeleteGlobalRunnerRequest constructs an http.Request for the DeleteGlobalRunner method
+func NewDeleteGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -12518,7 +14394,7 @@ func NewDeleteUserRequest(server string, userID UserID) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12536,13 +14412,13 @@ func NewDeleteUserRequest(server string, userID UserID) (*http.Request, error) {
 	return req, nil
 }
 
-// NewShowUserRequest constructs an http.Request for the ShowUser method
-func NewShowUserRequest(server string, userID UserID) (*http.Request, error) {
+// NewShowGlobalRunnerRequest constructs an http.Request for the ShowGlobalRunner method
+func NewShowGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -12552,7 +14428,7 @@ func NewShowUserRequest(server string, userID UserID) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12570,24 +14446,24 @@ func NewShowUserRequest(server string, userID UserID) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
-func NewUpdateUserRequest(server string, userID UserID, body UpdateUserJSONRequestBody) (*http.Request, error) {
+// NewUpdateGlobalRunnerRequest calls the generic UpdateGlobalRunner builder with application/json body
+func NewUpdateGlobalRunnerRequest(server string, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserRequestWithBody(server, userID, "application/json", bodyReader)
+	return NewUpdateGlobalRunnerRequestWithBody(server, runnerID, "application/json", bodyReader)
 }
 
-// NewUpdateUserRequestWithBody constructs an http.Request for the UpdateUser method, with any body, and a specified content type
-func NewUpdateUserRequestWithBody(server string, userID UserID, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateGlobalRunnerRequestWithBody constructs an http.Request for the UpdateGlobalRunner method, with any body, and a specified content type
+func NewUpdateGlobalRunnerRequestWithBody(server string, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -12597,7 +14473,7 @@ func NewUpdateUserRequestWithBody(server string, userID UserID, contentType stri
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/runners/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -12617,8 +14493,346 @@ func NewUpdateUserRequestWithBody(server string, userID UserID, contentType stri
 	return req, nil
 }
 
-// NewDeleteUserFromGroupRequest calls the generic DeleteUserFromGroup builder with application/json body
-func NewDeleteUserFromGroupRequest(server string, userID UserID, body DeleteUserFromGroupJSONRequestBody) (*http.Request, error) {
+// NewRevokeGlobalRunnerRequest constructs an http.Request for the RevokeGlobalRunner method
+func NewRevokeGlobalRunnerRequest(server string, runnerID RunnerID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runners/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRotateGlobalRunnerRequest calls the generic RotateGlobalRunner builder with application/json body
+func NewRotateGlobalRunnerRequest(server string, runnerID RunnerID, body RotateGlobalRunnerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRotateGlobalRunnerRequestWithBody(server, runnerID, "application/json", bodyReader)
+}
+
+// NewRotateGlobalRunnerRequestWithBody constructs an http.Request for the RotateGlobalRunner method, with any body, and a specified content type
+func NewRotateGlobalRunnerRequestWithBody(server string, runnerID RunnerID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "runner_id", runnerID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/runners/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersRequest constructs an http.Request for the ListUsers method
+func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search", *params.Search, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort", *params.Sort, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "order", *params.Order, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody constructs an http.Request for the CreateUser method, with any body, and a specified content type
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserRequest constructs an http.Request for the DeleteUser method
+func NewDeleteUserRequest(server string, userID UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShowUserRequest constructs an http.Request for the ShowUser method
+func NewShowUserRequest(server string, userID UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserRequest calls the generic UpdateUser builder with application/json body
+func NewUpdateUserRequest(server string, userID UserID, body UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserRequestWithBody(server, userID, "application/json", bodyReader)
+}
+
+// NewUpdateUserRequestWithBody constructs an http.Request for the UpdateUser method, with any body, and a specified content type
+func NewUpdateUserRequestWithBody(server string, userID UserID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "user_id", userID, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserFromGroupRequest calls the generic DeleteUserFromGroup builder with application/json body
+func NewDeleteUserFromGroupRequest(server string, userID UserID, body DeleteUserFromGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
@@ -13378,6 +15592,34 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
 	PermitGroupUserWithResponse(ctx context.Context, groupID GroupID, body PermitGroupUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitGroupUserResponse, error)
 
+	// ListGlobalJoinsWithResponse Fetch all join tokens
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /joins (the `ListGlobalJoins` operationId).
+	ListGlobalJoinsWithResponse(ctx context.Context, params *ListGlobalJoinsParams, reqEditors ...RequestEditorFn) (*ListGlobalJoinsResponse, error)
+
+	// CreateGlobalJoinWithBodyWithResponse Create a new global join token
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+	CreateGlobalJoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGlobalJoinResponse, error)
+
+	// CreateGlobalJoinWithResponse Create a new global join token
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+	CreateGlobalJoinWithResponse(ctx context.Context, body CreateGlobalJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGlobalJoinResponse, error)
+
+	// DeleteGlobalJoinWithResponse Delete a specific join token
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /joins/{join_id} (the `DeleteGlobalJoin` operationId).
+	DeleteGlobalJoinWithResponse(ctx context.Context, joinID JoinID, reqEditors ...RequestEditorFn) (*DeleteGlobalJoinResponse, error)
+
 	// ShowProfileWithResponse Fetch profile details of the personal account
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -13665,6 +15907,13 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with GET /projects/{project_id}/executions/{execution_id} (the `ShowProjectExecution` operationId).
 	ShowProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ShowProjectExecutionResponse, error)
 
+	// ApproveProjectExecutionWithResponse Approve a specific execution awaiting confirmation
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/approve (the `ApproveProjectExecution` operationId).
+	ApproveProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ApproveProjectExecutionResponse, error)
+
 	// OutputProjectExecutionWithResponse Output a specific execution for a project
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -13679,6 +15928,27 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with GET /projects/{project_id}/executions/{execution_id}/purge (the `PurgeProjectExecution` operationId).
 	PurgeProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*PurgeProjectExecutionResponse, error)
 
+	// RejectProjectExecutionWithResponse Reject a specific execution awaiting confirmation
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/reject (the `RejectProjectExecution` operationId).
+	RejectProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*RejectProjectExecutionResponse, error)
+
+	// RerunProjectExecutionWithResponse Rerun a specific execution with the same parameters
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/rerun (the `RerunProjectExecution` operationId).
+	RerunProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*RerunProjectExecutionResponse, error)
+
+	// StopProjectExecutionWithResponse Stop a specific execution for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/executions/{execution_id}/stop (the `StopProjectExecution` operationId).
+	StopProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*StopProjectExecutionResponse, error)
+
 	// DeleteProjectFromGroupWithBodyWithResponse Unlink a group from project
 	//
 	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
@@ -13777,6 +16047,34 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
 	UpdateProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, body UpdateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectInventoryResponse, error)
 
+	// ListProjectJoinsWithResponse Fetch all join tokens for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /projects/{project_id}/joins (the `ListProjectJoins` operationId).
+	ListProjectJoinsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectJoinsParams, reqEditors ...RequestEditorFn) (*ListProjectJoinsResponse, error)
+
+	// CreateProjectJoinWithBodyWithResponse Create a new join token
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+	CreateProjectJoinWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectJoinResponse, error)
+
+	// CreateProjectJoinWithResponse Create a new join token
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+	CreateProjectJoinWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectJoinResponse, error)
+
+	// DeleteProjectJoinWithResponse Delete a specific join token for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with DELETE /projects/{project_id}/joins/{join_id} (the `DeleteProjectJoin` operationId).
+	DeleteProjectJoinWithResponse(ctx context.Context, projectID ProjectID, joinID JoinID, reqEditors ...RequestEditorFn) (*DeleteProjectJoinResponse, error)
+
 	// ListProjectRepositoriesWithResponse Fetch all repositories for a project
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -13875,6 +16173,27 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
 	UpdateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRunnerResponse, error)
 
+	// RevokeProjectRunnerWithResponse Revoke the token of a specific runner for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/revoke (the `RevokeProjectRunner` operationId).
+	RevokeProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*RevokeProjectRunnerResponse, error)
+
+	// RotateProjectRunnerWithBodyWithResponse Rotate the token of a specific runner for a project
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+	RotateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RotateProjectRunnerResponse, error)
+
+	// RotateProjectRunnerWithResponse Rotate the token of a specific runner for a project
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+	RotateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, body RotateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RotateProjectRunnerResponse, error)
+
 	// ListProjectSchedulesWithResponse Fetch all schedules for a project
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -13908,7 +16227,7 @@ type ClientWithResponsesInterface interface {
 	// Returns a wrapper object for the known response body format(s).
 	//
 	// Corresponds with GET /projects/{project_id}/schedules/{schedule_id} (the `ShowProjectSchedule` operationId).
-	ShowProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*ShowProjectScheduleResponse, error)
+	ShowProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ShowProjectScheduleParams, reqEditors ...RequestEditorFn) (*ShowProjectScheduleResponse, error)
 
 	// UpdateProjectScheduleWithBodyWithResponse Update a specific schedule for a project
 	//
@@ -13924,6 +16243,13 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
 	UpdateProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectScheduleResponse, error)
 
+	// ListProjectScheduleRunsWithResponse Fetch the run history of a schedule for a project
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /projects/{project_id}/schedules/{schedule_id}/runs (the `ListProjectScheduleRuns` operationId).
+	ListProjectScheduleRunsWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ListProjectScheduleRunsParams, reqEditors ...RequestEditorFn) (*ListProjectScheduleRunsResponse, error)
+
 	// ListProjectTemplatesWithResponse Fetch all templates for a project
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -14092,6 +16418,83 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
 	PermitProjectUserWithResponse(ctx context.Context, projectID ProjectID, body PermitProjectUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitProjectUserResponse, error)
 
+	// ClaimRunnerExecutionWithResponse Claim the next waiting execution
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/executions (the `ClaimRunnerExecution` operationId).
+	ClaimRunnerExecutionWithResponse(ctx context.Context, params *ClaimRunnerExecutionParams, reqEditors ...RequestEditorFn) (*ClaimRunnerExecutionResponse, error)
+
+	// ShowRunnerExecutionWithResponse Fetch a specific claimed execution
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with GET /runner/executions/{execution_id} (the `ShowRunnerExecution` operationId).
+	ShowRunnerExecutionWithResponse(ctx context.Context, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ShowRunnerExecutionResponse, error)
+
+	// UpdateRunnerExecutionWithBodyWithResponse Update the status of a claimed execution
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+	UpdateRunnerExecutionWithBodyWithResponse(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRunnerExecutionResponse, error)
+
+	// UpdateRunnerExecutionWithResponse Update the status of a claimed execution
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+	UpdateRunnerExecutionWithResponse(ctx context.Context, executionID ExecutionID, body UpdateRunnerExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRunnerExecutionResponse, error)
+
+	// CreateRunnerOutputWithBodyWithResponse Append output to a claimed execution
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+	CreateRunnerOutputWithBodyWithResponse(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRunnerOutputResponse, error)
+
+	// CreateRunnerOutputWithResponse Append output to a claimed execution
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+	CreateRunnerOutputWithResponse(ctx context.Context, executionID ExecutionID, body CreateRunnerOutputJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRunnerOutputResponse, error)
+
+	// HeartbeatRunnerWithResponse Send a heartbeat for the authenticated runner
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/heartbeat (the `HeartbeatRunner` operationId).
+	HeartbeatRunnerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeartbeatRunnerResponse, error)
+
+	// JoinRunnerWithBodyWithResponse Exchange a join token for a new runner
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+	JoinRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinRunnerResponse, error)
+
+	// JoinRunnerWithResponse Exchange a join token for a new runner
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+	JoinRunnerWithResponse(ctx context.Context, body JoinRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinRunnerResponse, error)
+
+	// RegisterRunnerWithBodyWithResponse Register the authenticated runner
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+	RegisterRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterRunnerResponse, error)
+
+	// RegisterRunnerWithResponse Register the authenticated runner
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+	RegisterRunnerWithResponse(ctx context.Context, body RegisterRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterRunnerResponse, error)
+
 	// ListGlobalRunnersWithResponse Fetch all runners
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -14141,6 +16544,27 @@ type ClientWithResponsesInterface interface {
 	// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
 	UpdateGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGlobalRunnerResponse, error)
 
+	// RevokeGlobalRunnerWithResponse Revoke the token of a specific runner
+	//
+	// Returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runners/{runner_id}/revoke (the `RevokeGlobalRunner` operationId).
+	RevokeGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*RevokeGlobalRunnerResponse, error)
+
+	// RotateGlobalRunnerWithBodyWithResponse Rotate the token of a specific runner
+	//
+	// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+	RotateGlobalRunnerWithBodyWithResponse(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RotateGlobalRunnerResponse, error)
+
+	// RotateGlobalRunnerWithResponse Rotate the token of a specific runner
+	//
+	// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+	//
+	// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+	RotateGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, body RotateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RotateGlobalRunnerResponse, error)
+
 	// ListUsersWithResponse Fetch all available users
 	//
 	// Returns a wrapper object for the known response body format(s).
@@ -15626,6 +18050,199 @@ func (r PermitGroupUserResponse) ContentType() string {
 	return ""
 }
 
+type ListGlobalJoinsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *GlobalJoinsResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListGlobalJoinsResponse) GetJSON200() *GlobalJoinsResponse {
+	return r.JSON200
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ListGlobalJoinsResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ListGlobalJoinsResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListGlobalJoinsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListGlobalJoinsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListGlobalJoinsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListGlobalJoinsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateGlobalJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *GlobalJoinResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r CreateGlobalJoinResponse) GetJSON200() *GlobalJoinResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r CreateGlobalJoinResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r CreateGlobalJoinResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r CreateGlobalJoinResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r CreateGlobalJoinResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateGlobalJoinResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateGlobalJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateGlobalJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateGlobalJoinResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteGlobalJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r DeleteGlobalJoinResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r DeleteGlobalJoinResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r DeleteGlobalJoinResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r DeleteGlobalJoinResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r DeleteGlobalJoinResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteGlobalJoinResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteGlobalJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteGlobalJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteGlobalJoinResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type ShowProfileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -17599,6 +20216,75 @@ func (r ShowProjectExecutionResponse) ContentType() string {
 	return ""
 }
 
+type ApproveProjectExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectExecutionResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ApproveProjectExecutionResponse) GetJSON200() *ProjectExecutionResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r ApproveProjectExecutionResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ApproveProjectExecutionResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ApproveProjectExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ApproveProjectExecutionResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ApproveProjectExecutionResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveProjectExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveProjectExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ApproveProjectExecutionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type OutputProjectExecutionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -17730,6 +20416,213 @@ func (r PurgeProjectExecutionResponse) ContentType() string {
 	return ""
 }
 
+type RejectProjectExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectExecutionResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RejectProjectExecutionResponse) GetJSON200() *ProjectExecutionResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r RejectProjectExecutionResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r RejectProjectExecutionResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RejectProjectExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r RejectProjectExecutionResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RejectProjectExecutionResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectProjectExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectProjectExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RejectProjectExecutionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RerunProjectExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectExecutionResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RerunProjectExecutionResponse) GetJSON200() *ProjectExecutionResponse {
+	return r.JSON200
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r RerunProjectExecutionResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RerunProjectExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r RerunProjectExecutionResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r RerunProjectExecutionResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RerunProjectExecutionResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RerunProjectExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RerunProjectExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RerunProjectExecutionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type StopProjectExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectExecutionResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r StopProjectExecutionResponse) GetJSON200() *ProjectExecutionResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r StopProjectExecutionResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r StopProjectExecutionResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r StopProjectExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r StopProjectExecutionResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r StopProjectExecutionResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r StopProjectExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopProjectExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r StopProjectExecutionResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type DeleteProjectFromGroupResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -18379,6 +21272,213 @@ func (r UpdateProjectInventoryResponse) ContentType() string {
 	return ""
 }
 
+type ListProjectJoinsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectJoinsResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListProjectJoinsResponse) GetJSON200() *ProjectJoinsResponse {
+	return r.JSON200
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ListProjectJoinsResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ListProjectJoinsResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ListProjectJoinsResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListProjectJoinsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectJoinsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectJoinsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectJoinsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type CreateProjectJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectJoinResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON200() *ProjectJoinResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r CreateProjectJoinResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r CreateProjectJoinResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateProjectJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateProjectJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r CreateProjectJoinResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteProjectJoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r DeleteProjectJoinResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r DeleteProjectJoinResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r DeleteProjectJoinResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r DeleteProjectJoinResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r DeleteProjectJoinResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteProjectJoinResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProjectJoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProjectJoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteProjectJoinResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type ListProjectRepositoriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -19069,6 +22169,151 @@ func (r UpdateProjectRunnerResponse) ContentType() string {
 	return ""
 }
 
+type RevokeProjectRunnerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *ActionFailedError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RevokeProjectRunnerResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r RevokeProjectRunnerResponse) GetJSON400() *ActionFailedError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r RevokeProjectRunnerResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RevokeProjectRunnerResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r RevokeProjectRunnerResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RevokeProjectRunnerResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeProjectRunnerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeProjectRunnerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RevokeProjectRunnerResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type RotateProjectRunnerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectRunnerResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON200() *ProjectRunnerResponse {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r RotateProjectRunnerResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r RotateProjectRunnerResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r RotateProjectRunnerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RotateProjectRunnerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r RotateProjectRunnerResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type ListProjectSchedulesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -19414,6 +22659,68 @@ func (r UpdateProjectScheduleResponse) ContentType() string {
 	return ""
 }
 
+type ListProjectScheduleRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *ProjectScheduleRunsResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListProjectScheduleRunsResponse) GetJSON200() *ProjectScheduleRunsResponse {
+	return r.JSON200
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ListProjectScheduleRunsResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ListProjectScheduleRunsResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ListProjectScheduleRunsResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListProjectScheduleRunsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListProjectScheduleRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListProjectScheduleRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListProjectScheduleRunsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
 type ListProjectTemplatesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -20505,39 +23812,46 @@ func (r PermitProjectUserResponse) ContentType() string {
 	return ""
 }
 
-type ListGlobalRunnersResponse struct {
+type ClaimRunnerExecutionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *GlobalRunnersResponse
+	JSON200 *RunnerExecutionResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListGlobalRunnersResponse) GetJSON200() *GlobalRunnersResponse {
+func (r ClaimRunnerExecutionResponse) GetJSON200() *RunnerExecutionResponse {
 	return r.JSON200
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ListGlobalRunnersResponse) GetJSON403() *NotAuthorizedError {
+func (r ClaimRunnerExecutionResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ClaimRunnerExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ListGlobalRunnersResponse) GetJSON500() *InternalServerError {
+func (r ClaimRunnerExecutionResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ListGlobalRunnersResponse) GetBody() []byte {
+func (r ClaimRunnerExecutionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListGlobalRunnersResponse) Status() string {
+func (r ClaimRunnerExecutionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20545,7 +23859,7 @@ func (r ListGlobalRunnersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListGlobalRunnersResponse) StatusCode() int {
+func (r ClaimRunnerExecutionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20553,60 +23867,53 @@ func (r ListGlobalRunnersResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListGlobalRunnersResponse) ContentType() string {
+func (r ClaimRunnerExecutionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateGlobalRunnerResponse struct {
+type ShowRunnerExecutionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *GlobalRunnerResponse
-	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *BadRequestError
+	JSON200 *RunnerExecutionResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON422 the response for an HTTP 422 `application/json` response
-	JSON422 *ValidationError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r CreateGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
+func (r ShowRunnerExecutionResponse) GetJSON200() *RunnerExecutionResponse {
 	return r.JSON200
 }
 
-// GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r CreateGlobalRunnerResponse) GetJSON400() *BadRequestError {
-	return r.JSON400
-}
-
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r CreateGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
+func (r ShowRunnerExecutionResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r CreateGlobalRunnerResponse) GetJSON422() *ValidationError {
-	return r.JSON422
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ShowRunnerExecutionResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r CreateGlobalRunnerResponse) GetJSON500() *InternalServerError {
+func (r ShowRunnerExecutionResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateGlobalRunnerResponse) GetBody() []byte {
+func (r ShowRunnerExecutionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateGlobalRunnerResponse) Status() string {
+func (r ShowRunnerExecutionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20614,7 +23921,7 @@ func (r CreateGlobalRunnerResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateGlobalRunnerResponse) StatusCode() int {
+func (r ShowRunnerExecutionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20622,122 +23929,74 @@ func (r CreateGlobalRunnerResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateGlobalRunnerResponse) ContentType() string {
+func (r ShowRunnerExecutionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteGlobalRunnerResponse struct {
+type UpdateRunnerExecutionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *SuccessMessage
+	JSON200 *RunnerExecutionResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *ActionFailedError
+	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
+	// JSON409 the response for an HTTP 409 `application/json` response
+	JSON409 *ConflictError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r DeleteGlobalRunnerResponse) GetJSON200() *SuccessMessage {
+func (r UpdateRunnerExecutionResponse) GetJSON200() *RunnerExecutionResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r DeleteGlobalRunnerResponse) GetJSON400() *ActionFailedError {
+func (r UpdateRunnerExecutionResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r DeleteGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
+func (r UpdateRunnerExecutionResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r DeleteGlobalRunnerResponse) GetJSON404() *NotFoundError {
+func (r UpdateRunnerExecutionResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r DeleteGlobalRunnerResponse) GetJSON500() *InternalServerError {
-	return r.JSON500
-}
-
-// GetBody returns the raw response body bytes
-func (r DeleteGlobalRunnerResponse) GetBody() []byte {
-	return r.Body
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteGlobalRunnerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteGlobalRunnerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteGlobalRunnerResponse) ContentType() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Header.Get("Content-Type")
-	}
-	return ""
-}
-
-type ShowGlobalRunnerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *GlobalRunnerResponse
-	// JSON403 the response for an HTTP 403 `application/json` response
-	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
-	// JSON500 the response for an HTTP 500 `application/json` response
-	JSON500 *InternalServerError
-}
-
-// GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ShowGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
-	return r.JSON200
-}
-
-// GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ShowGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
-	return r.JSON403
+// GetJSON409 returns the response for an HTTP 409 `application/json` response
+func (r UpdateRunnerExecutionResponse) GetJSON409() *ConflictError {
+	return r.JSON409
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r ShowGlobalRunnerResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r UpdateRunnerExecutionResponse) GetJSON422() *ValidationError {
+	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ShowGlobalRunnerResponse) GetJSON500() *InternalServerError {
+func (r UpdateRunnerExecutionResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ShowGlobalRunnerResponse) GetBody() []byte {
+func (r UpdateRunnerExecutionResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ShowGlobalRunnerResponse) Status() string {
+func (r UpdateRunnerExecutionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20745,7 +24004,7 @@ func (r ShowGlobalRunnerResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShowGlobalRunnerResponse) StatusCode() int {
+func (r UpdateRunnerExecutionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20753,67 +24012,60 @@ func (r ShowGlobalRunnerResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ShowGlobalRunnerResponse) ContentType() string {
+func (r UpdateRunnerExecutionResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateGlobalRunnerResponse struct {
+type CreateRunnerOutputResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *GlobalRunnerResponse
+	JSON200 *SuccessMessage
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON422 the response for an HTTP 422 `application/json` response
-	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
+func (r CreateRunnerOutputResponse) GetJSON200() *SuccessMessage {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON400() *BadRequestError {
+func (r CreateRunnerOutputResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
+func (r CreateRunnerOutputResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON404() *NotFoundError {
+func (r CreateRunnerOutputResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON422() *ValidationError {
-	return r.JSON422
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r UpdateGlobalRunnerResponse) GetJSON500() *InternalServerError {
+func (r CreateRunnerOutputResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateGlobalRunnerResponse) GetBody() []byte {
+func (r CreateRunnerOutputResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateGlobalRunnerResponse) Status() string {
+func (r CreateRunnerOutputResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20821,7 +24073,7 @@ func (r UpdateGlobalRunnerResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateGlobalRunnerResponse) StatusCode() int {
+func (r CreateRunnerOutputResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20829,18 +24081,18 @@ func (r UpdateGlobalRunnerResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateGlobalRunnerResponse) ContentType() string {
+func (r CreateRunnerOutputResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListUsersResponse struct {
+type HeartbeatRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UsersResponse
+	JSON200 *RunnerHeartbeatResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON500 the response for an HTTP 500 `application/json` response
@@ -20848,27 +24100,27 @@ type ListUsersResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListUsersResponse) GetJSON200() *UsersResponse {
+func (r HeartbeatRunnerResponse) GetJSON200() *RunnerHeartbeatResponse {
 	return r.JSON200
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ListUsersResponse) GetJSON403() *NotAuthorizedError {
+func (r HeartbeatRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ListUsersResponse) GetJSON500() *InternalServerError {
+func (r HeartbeatRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ListUsersResponse) GetBody() []byte {
+func (r HeartbeatRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListUsersResponse) Status() string {
+func (r HeartbeatRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20876,7 +24128,7 @@ func (r ListUsersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListUsersResponse) StatusCode() int {
+func (r HeartbeatRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20884,18 +24136,18 @@ func (r ListUsersResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListUsersResponse) ContentType() string {
+func (r HeartbeatRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type CreateUserResponse struct {
+type JoinRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UserResponse
+	JSON200 *JoinedRunnerResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
@@ -20907,37 +24159,37 @@ type CreateUserResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r CreateUserResponse) GetJSON200() *UserResponse {
+func (r JoinRunnerResponse) GetJSON200() *JoinedRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r CreateUserResponse) GetJSON400() *BadRequestError {
+func (r JoinRunnerResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r CreateUserResponse) GetJSON403() *NotAuthorizedError {
+func (r JoinRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r CreateUserResponse) GetJSON422() *ValidationError {
+func (r JoinRunnerResponse) GetJSON422() *ValidationError {
 	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r CreateUserResponse) GetJSON500() *InternalServerError {
+func (r JoinRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r CreateUserResponse) GetBody() []byte {
+func (r JoinRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateUserResponse) Status() string {
+func (r JoinRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -20945,7 +24197,7 @@ func (r CreateUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResponse) StatusCode() int {
+func (r JoinRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -20953,60 +24205,53 @@ func (r CreateUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r CreateUserResponse) ContentType() string {
+func (r JoinRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteUserResponse struct {
+type RegisterRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *SuccessMessage
+	JSON200 *CurrentRunnerResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *ActionFailedError
+	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r DeleteUserResponse) GetJSON200() *SuccessMessage {
+func (r RegisterRunnerResponse) GetJSON200() *CurrentRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r DeleteUserResponse) GetJSON400() *ActionFailedError {
+func (r RegisterRunnerResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r DeleteUserResponse) GetJSON403() *NotAuthorizedError {
+func (r RegisterRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r DeleteUserResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r DeleteUserResponse) GetJSON500() *InternalServerError {
+func (r RegisterRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteUserResponse) GetBody() []byte {
+func (r RegisterRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteUserResponse) Status() string {
+func (r RegisterRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21014,7 +24259,7 @@ func (r DeleteUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserResponse) StatusCode() int {
+func (r RegisterRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21022,53 +24267,46 @@ func (r DeleteUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteUserResponse) ContentType() string {
+func (r RegisterRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ShowUserResponse struct {
+type ListGlobalRunnersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UserResponse
+	JSON200 *GlobalRunnersResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ShowUserResponse) GetJSON200() *UserResponse {
+func (r ListGlobalRunnersResponse) GetJSON200() *GlobalRunnersResponse {
 	return r.JSON200
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ShowUserResponse) GetJSON403() *NotAuthorizedError {
+func (r ListGlobalRunnersResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r ShowUserResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ShowUserResponse) GetJSON500() *InternalServerError {
+func (r ListGlobalRunnersResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ShowUserResponse) GetBody() []byte {
+func (r ListGlobalRunnersResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ShowUserResponse) Status() string {
+func (r ListGlobalRunnersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21076,7 +24314,7 @@ func (r ShowUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ShowUserResponse) StatusCode() int {
+func (r ListGlobalRunnersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21084,24 +24322,22 @@ func (r ShowUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ShowUserResponse) ContentType() string {
+func (r ListGlobalRunnersResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type UpdateUserResponse struct {
+type CreateGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UserResponse
+	JSON200 *GlobalRunnerResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
 	// JSON422 the response for an HTTP 422 `application/json` response
 	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
@@ -21109,42 +24345,37 @@ type UpdateUserResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r UpdateUserResponse) GetJSON200() *UserResponse {
+func (r CreateGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r UpdateUserResponse) GetJSON400() *BadRequestError {
+func (r CreateGlobalRunnerResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r UpdateUserResponse) GetJSON403() *NotAuthorizedError {
+func (r CreateGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r UpdateUserResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
-}
-
 // GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r UpdateUserResponse) GetJSON422() *ValidationError {
+func (r CreateGlobalRunnerResponse) GetJSON422() *ValidationError {
 	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r UpdateUserResponse) GetJSON500() *InternalServerError {
+func (r CreateGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r UpdateUserResponse) GetBody() []byte {
+func (r CreateGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateUserResponse) Status() string {
+func (r CreateGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21152,7 +24383,7 @@ func (r UpdateUserResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateUserResponse) StatusCode() int {
+func (r CreateGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21160,67 +24391,60 @@ func (r UpdateUserResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r UpdateUserResponse) ContentType() string {
+func (r CreateGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteUserFromGroupResponse struct {
+type DeleteGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
 	JSON200 *SuccessMessage
 	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *BadRequestError
+	JSON400 *ActionFailedError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *NotAttachedError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON200() *SuccessMessage {
+func (r DeleteGlobalRunnerResponse) GetJSON200() *SuccessMessage {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON400() *BadRequestError {
+func (r DeleteGlobalRunnerResponse) GetJSON400() *ActionFailedError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON403() *NotAuthorizedError {
+func (r DeleteGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON404() *NotFoundError {
+func (r DeleteGlobalRunnerResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON412() *NotAttachedError {
-	return r.JSON412
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r DeleteUserFromGroupResponse) GetJSON500() *InternalServerError {
+func (r DeleteGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteUserFromGroupResponse) GetBody() []byte {
+func (r DeleteGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteUserFromGroupResponse) Status() string {
+func (r DeleteGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21228,7 +24452,7 @@ func (r DeleteUserFromGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserFromGroupResponse) StatusCode() int {
+func (r DeleteGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21236,18 +24460,18 @@ func (r DeleteUserFromGroupResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteUserFromGroupResponse) ContentType() string {
+func (r DeleteGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListUserGroupsResponse struct {
+type ShowGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UserGroupsResponse
+	JSON200 *GlobalRunnerResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
@@ -21257,32 +24481,32 @@ type ListUserGroupsResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListUserGroupsResponse) GetJSON200() *UserGroupsResponse {
+func (r ShowGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ListUserGroupsResponse) GetJSON403() *NotAuthorizedError {
+func (r ShowGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r ListUserGroupsResponse) GetJSON404() *NotFoundError {
+func (r ShowGlobalRunnerResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ListUserGroupsResponse) GetJSON500() *InternalServerError {
+func (r ShowGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ListUserGroupsResponse) GetBody() []byte {
+func (r ShowGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListUserGroupsResponse) Status() string {
+func (r ShowGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21290,7 +24514,7 @@ func (r ListUserGroupsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListUserGroupsResponse) StatusCode() int {
+func (r ShowGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21298,26 +24522,24 @@ func (r ListUserGroupsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListUserGroupsResponse) ContentType() string {
+func (r ShowGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type AttachUserToGroupResponse struct {
+type UpdateGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *SuccessMessage
+	JSON200 *GlobalRunnerResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *AlreadyAttachedError
 	// JSON422 the response for an HTTP 422 `application/json` response
 	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
@@ -21325,47 +24547,42 @@ type AttachUserToGroupResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON200() *SuccessMessage {
+func (r UpdateGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON400() *BadRequestError {
+func (r UpdateGlobalRunnerResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON403() *NotAuthorizedError {
+func (r UpdateGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON404() *NotFoundError {
+func (r UpdateGlobalRunnerResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON412() *AlreadyAttachedError {
-	return r.JSON412
-}
-
 // GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON422() *ValidationError {
+func (r UpdateGlobalRunnerResponse) GetJSON422() *ValidationError {
 	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r AttachUserToGroupResponse) GetJSON500() *InternalServerError {
+func (r UpdateGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r AttachUserToGroupResponse) GetBody() []byte {
+func (r UpdateGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r AttachUserToGroupResponse) Status() string {
+func (r UpdateGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21373,7 +24590,7 @@ func (r AttachUserToGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AttachUserToGroupResponse) StatusCode() int {
+func (r UpdateGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21381,74 +24598,60 @@ func (r AttachUserToGroupResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r AttachUserToGroupResponse) ContentType() string {
+func (r UpdateGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PermitUserGroupResponse struct {
+type RevokeGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
 	JSON200 *SuccessMessage
 	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *BadRequestError
+	JSON400 *ActionFailedError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *NotAttachedError
-	// JSON422 the response for an HTTP 422 `application/json` response
-	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r PermitUserGroupResponse) GetJSON200() *SuccessMessage {
+func (r RevokeGlobalRunnerResponse) GetJSON200() *SuccessMessage {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r PermitUserGroupResponse) GetJSON400() *BadRequestError {
+func (r RevokeGlobalRunnerResponse) GetJSON400() *ActionFailedError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r PermitUserGroupResponse) GetJSON403() *NotAuthorizedError {
+func (r RevokeGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r PermitUserGroupResponse) GetJSON404() *NotFoundError {
+func (r RevokeGlobalRunnerResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r PermitUserGroupResponse) GetJSON412() *NotAttachedError {
-	return r.JSON412
-}
-
-// GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r PermitUserGroupResponse) GetJSON422() *ValidationError {
-	return r.JSON422
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r PermitUserGroupResponse) GetJSON500() *InternalServerError {
+func (r RevokeGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r PermitUserGroupResponse) GetBody() []byte {
+func (r RevokeGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r PermitUserGroupResponse) Status() string {
+func (r RevokeGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21456,7 +24659,7 @@ func (r PermitUserGroupResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PermitUserGroupResponse) StatusCode() int {
+func (r RevokeGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21464,67 +24667,67 @@ func (r PermitUserGroupResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PermitUserGroupResponse) ContentType() string {
+func (r RevokeGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type DeleteUserFromProjectResponse struct {
+type RotateGlobalRunnerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *SuccessMessage
+	JSON200 *GlobalRunnerResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *NotAttachedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON200() *SuccessMessage {
+func (r RotateGlobalRunnerResponse) GetJSON200() *GlobalRunnerResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON400() *BadRequestError {
+func (r RotateGlobalRunnerResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON403() *NotAuthorizedError {
+func (r RotateGlobalRunnerResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON404() *NotFoundError {
+func (r RotateGlobalRunnerResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON412() *NotAttachedError {
-	return r.JSON412
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r RotateGlobalRunnerResponse) GetJSON422() *ValidationError {
+	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r DeleteUserFromProjectResponse) GetJSON500() *InternalServerError {
+func (r RotateGlobalRunnerResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r DeleteUserFromProjectResponse) GetBody() []byte {
+func (r RotateGlobalRunnerResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteUserFromProjectResponse) Status() string {
+func (r RotateGlobalRunnerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21532,7 +24735,7 @@ func (r DeleteUserFromProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserFromProjectResponse) StatusCode() int {
+func (r RotateGlobalRunnerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21540,53 +24743,46 @@ func (r DeleteUserFromProjectResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r DeleteUserFromProjectResponse) ContentType() string {
+func (r RotateGlobalRunnerResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type ListUserProjectsResponse struct {
+type ListUsersResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *UserProjectsResponse
+	JSON200 *UsersResponse
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r ListUserProjectsResponse) GetJSON200() *UserProjectsResponse {
+func (r ListUsersResponse) GetJSON200() *UsersResponse {
 	return r.JSON200
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r ListUserProjectsResponse) GetJSON403() *NotAuthorizedError {
+func (r ListUsersResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r ListUserProjectsResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r ListUserProjectsResponse) GetJSON500() *InternalServerError {
+func (r ListUsersResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r ListUserProjectsResponse) GetBody() []byte {
+func (r ListUsersResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r ListUserProjectsResponse) Status() string {
+func (r ListUsersResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21594,7 +24790,7 @@ func (r ListUserProjectsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ListUserProjectsResponse) StatusCode() int {
+func (r ListUsersResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21602,26 +24798,22 @@ func (r ListUserProjectsResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r ListUserProjectsResponse) ContentType() string {
+func (r ListUsersResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type AttachUserToProjectResponse struct {
+type CreateUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
-	JSON200 *SuccessMessage
+	JSON200 *UserResponse
 	// JSON400 the response for an HTTP 400 `application/json` response
 	JSON400 *BadRequestError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
-	// JSON404 the response for an HTTP 404 `application/json` response
-	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *AlreadyAttachedError
 	// JSON422 the response for an HTTP 422 `application/json` response
 	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
@@ -21629,47 +24821,37 @@ type AttachUserToProjectResponse struct {
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON200() *SuccessMessage {
+func (r CreateUserResponse) GetJSON200() *UserResponse {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON400() *BadRequestError {
+func (r CreateUserResponse) GetJSON400() *BadRequestError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON403() *NotAuthorizedError {
+func (r CreateUserResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
-// GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON404() *NotFoundError {
-	return r.JSON404
-}
-
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON412() *AlreadyAttachedError {
-	return r.JSON412
-}
-
 // GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON422() *ValidationError {
+func (r CreateUserResponse) GetJSON422() *ValidationError {
 	return r.JSON422
 }
 
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r AttachUserToProjectResponse) GetJSON500() *InternalServerError {
+func (r CreateUserResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r AttachUserToProjectResponse) GetBody() []byte {
+func (r CreateUserResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r AttachUserToProjectResponse) Status() string {
+func (r CreateUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21677,7 +24859,7 @@ func (r AttachUserToProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AttachUserToProjectResponse) StatusCode() int {
+func (r CreateUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21685,74 +24867,60 @@ func (r AttachUserToProjectResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r AttachUserToProjectResponse) ContentType() string {
+func (r CreateUserResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-type PermitUserProjectResponse struct {
+type DeleteUserResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	// JSON200 the response for an HTTP 200 `application/json` response
 	JSON200 *SuccessMessage
 	// JSON400 the response for an HTTP 400 `application/json` response
-	JSON400 *BadRequestError
+	JSON400 *ActionFailedError
 	// JSON403 the response for an HTTP 403 `application/json` response
 	JSON403 *NotAuthorizedError
 	// JSON404 the response for an HTTP 404 `application/json` response
 	JSON404 *NotFoundError
-	// JSON412 the response for an HTTP 412 `application/json` response
-	JSON412 *NotAttachedError
-	// JSON422 the response for an HTTP 422 `application/json` response
-	JSON422 *ValidationError
 	// JSON500 the response for an HTTP 500 `application/json` response
 	JSON500 *InternalServerError
 }
 
 // GetJSON200 returns the response for an HTTP 200 `application/json` response
-func (r PermitUserProjectResponse) GetJSON200() *SuccessMessage {
+func (r DeleteUserResponse) GetJSON200() *SuccessMessage {
 	return r.JSON200
 }
 
 // GetJSON400 returns the response for an HTTP 400 `application/json` response
-func (r PermitUserProjectResponse) GetJSON400() *BadRequestError {
+func (r DeleteUserResponse) GetJSON400() *ActionFailedError {
 	return r.JSON400
 }
 
 // GetJSON403 returns the response for an HTTP 403 `application/json` response
-func (r PermitUserProjectResponse) GetJSON403() *NotAuthorizedError {
+func (r DeleteUserResponse) GetJSON403() *NotAuthorizedError {
 	return r.JSON403
 }
 
 // GetJSON404 returns the response for an HTTP 404 `application/json` response
-func (r PermitUserProjectResponse) GetJSON404() *NotFoundError {
+func (r DeleteUserResponse) GetJSON404() *NotFoundError {
 	return r.JSON404
 }
 
-// GetJSON412 returns the response for an HTTP 412 `application/json` response
-func (r PermitUserProjectResponse) GetJSON412() *NotAttachedError {
-	return r.JSON412
-}
-
-// GetJSON422 returns the response for an HTTP 422 `application/json` response
-func (r PermitUserProjectResponse) GetJSON422() *ValidationError {
-	return r.JSON422
-}
-
 // GetJSON500 returns the response for an HTTP 500 `application/json` response
-func (r PermitUserProjectResponse) GetJSON500() *InternalServerError {
+func (r DeleteUserResponse) GetJSON500() *InternalServerError {
 	return r.JSON500
 }
 
 // GetBody returns the raw response body bytes
-func (r PermitUserProjectResponse) GetBody() []byte {
+func (r DeleteUserResponse) GetBody() []byte {
 	return r.Body
 }
 
 // Status returns HTTPResponse.Status
-func (r PermitUserProjectResponse) Status() string {
+func (r DeleteUserResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -21760,7 +24928,7 @@ func (r PermitUserProjectResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PermitUserProjectResponse) StatusCode() int {
+func (r DeleteUserResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -21768,2122 +24936,4206 @@ func (r PermitUserProjectResponse) StatusCode() int {
 }
 
 // ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
-func (r PermitUserProjectResponse) ContentType() string {
+func (r DeleteUserResponse) ContentType() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Header.Get("Content-Type")
 	}
 	return ""
 }
 
-// LoginAuthWithBodyWithResponse Authenticate an user by credentials
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /auth/login (the `LoginAuth` operationId).
-func (c *ClientWithResponses) LoginAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginAuthResponse, error) {
-	rsp, err := c.LoginAuthWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseLoginAuthResponse(rsp)
+type ShowUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UserResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// LoginAuthWithResponse Authenticate an user by credentials
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /auth/login (the `LoginAuth` operationId).
-func (c *ClientWithResponses) LoginAuthWithResponse(ctx context.Context, body LoginAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginAuthResponse, error) {
-	rsp, err := c.LoginAuth(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseLoginAuthResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ShowUserResponse) GetJSON200() *UserResponse {
+	return r.JSON200
 }
 
-// ListProvidersWithResponse Fetch the available auth providers
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /auth/providers (the `ListProviders` operationId).
-func (c *ClientWithResponses) ListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProvidersResponse, error) {
-	rsp, err := c.ListProviders(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListProvidersResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ShowUserResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// RedirectAuthWithBodyWithResponse Retrieve real token after redirect
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /auth/redirect (the `RedirectAuth` operationId).
-func (c *ClientWithResponses) RedirectAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RedirectAuthResponse, error) {
-	rsp, err := c.RedirectAuthWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRedirectAuthResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ShowUserResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// RedirectAuthWithResponse Retrieve real token after redirect
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /auth/redirect (the `RedirectAuth` operationId).
-func (c *ClientWithResponses) RedirectAuthWithResponse(ctx context.Context, body RedirectAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*RedirectAuthResponse, error) {
-	rsp, err := c.RedirectAuth(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRedirectAuthResponse(rsp)
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ShowUserResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
 }
 
-// RefreshAuthWithResponse Refresh an auth token before it expires
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /auth/refresh (the `RefreshAuth` operationId).
-func (c *ClientWithResponses) RefreshAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error) {
-	rsp, err := c.RefreshAuth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRefreshAuthResponse(rsp)
+// GetBody returns the raw response body bytes
+func (r ShowUserResponse) GetBody() []byte {
+	return r.Body
 }
 
-// VerifyAuthWithResponse Verify validity for an authentication token
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /auth/verify (the `VerifyAuth` operationId).
-func (c *ClientWithResponses) VerifyAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyAuthResponse, error) {
-	rsp, err := c.VerifyAuth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ShowUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseVerifyAuthResponse(rsp)
+	return http.StatusText(0)
 }
 
-// CallbackProviderWithResponse Callback to parse the defined provider
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /auth/{provider}/callback (the `CallbackProvider` operationId).
-func (c *ClientWithResponses) CallbackProviderWithResponse(ctx context.Context, provider AuthProviderParam, params *CallbackProviderParams, reqEditors ...RequestEditorFn) (*CallbackProviderResponse, error) {
-	rsp, err := c.CallbackProvider(ctx, provider, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShowUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseCallbackProviderResponse(rsp)
+	return 0
 }
 
-// RequestProviderWithResponse Request the redirect to defined provider
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /auth/{provider}/request (the `RequestProvider` operationId).
-func (c *ClientWithResponses) RequestProviderWithResponse(ctx context.Context, provider AuthProviderParam, reqEditors ...RequestEditorFn) (*RequestProviderResponse, error) {
-	rsp, err := c.RequestProvider(ctx, provider, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ShowUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseRequestProviderResponse(rsp)
+	return ""
 }
 
-// ListGlobalEventsWithResponse Fetch all events
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /events (the `ListGlobalEvents` operationId).
-func (c *ClientWithResponses) ListGlobalEventsWithResponse(ctx context.Context, params *ListGlobalEventsParams, reqEditors ...RequestEditorFn) (*ListGlobalEventsResponse, error) {
-	rsp, err := c.ListGlobalEvents(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListGlobalEventsResponse(rsp)
+type UpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UserResponse
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// ListGroupsWithResponse Fetch all available groups
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /groups (the `ListGroups` operationId).
-func (c *ClientWithResponses) ListGroupsWithResponse(ctx context.Context, params *ListGroupsParams, reqEditors ...RequestEditorFn) (*ListGroupsResponse, error) {
-	rsp, err := c.ListGroups(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListGroupsResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r UpdateUserResponse) GetJSON200() *UserResponse {
+	return r.JSON200
 }
 
-// CreateGroupWithBodyWithResponse Create a new group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups (the `CreateGroup` operationId).
-func (c *ClientWithResponses) CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
-	rsp, err := c.CreateGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateGroupResponse(rsp)
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r UpdateUserResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
 }
 
-// CreateGroupWithResponse Create a new group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups (the `CreateGroup` operationId).
-func (c *ClientWithResponses) CreateGroupWithResponse(ctx context.Context, body CreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
-	rsp, err := c.CreateGroup(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateGroupResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r UpdateUserResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// DeleteGroupWithResponse Delete a specific group
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /groups/{group_id} (the `DeleteGroup` operationId).
-func (c *ClientWithResponses) DeleteGroupWithResponse(ctx context.Context, groupID GroupID, reqEditors ...RequestEditorFn) (*DeleteGroupResponse, error) {
-	rsp, err := c.DeleteGroup(ctx, groupID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteGroupResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r UpdateUserResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// ShowGroupWithResponse Fetch a specific group
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /groups/{group_id} (the `ShowGroup` operationId).
-func (c *ClientWithResponses) ShowGroupWithResponse(ctx context.Context, groupID GroupID, reqEditors ...RequestEditorFn) (*ShowGroupResponse, error) {
-	rsp, err := c.ShowGroup(ctx, groupID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseShowGroupResponse(rsp)
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r UpdateUserResponse) GetJSON422() *ValidationError {
+	return r.JSON422
 }
 
-// UpdateGroupWithBodyWithResponse Update a specific group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id} (the `UpdateGroup` operationId).
-func (c *ClientWithResponses) UpdateGroupWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
-	rsp, err := c.UpdateGroupWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateGroupResponse(rsp)
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r UpdateUserResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
 }
 
-// UpdateGroupWithResponse Update a specific group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id} (the `UpdateGroup` operationId).
-func (c *ClientWithResponses) UpdateGroupWithResponse(ctx context.Context, groupID GroupID, body UpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
-	rsp, err := c.UpdateGroup(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateGroupResponse(rsp)
+// GetBody returns the raw response body bytes
+func (r UpdateUserResponse) GetBody() []byte {
+	return r.Body
 }
 
-// DeleteGroupFromProjectWithBodyWithResponse Unlink a project from group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /groups/{group_id}/projects (the `DeleteGroupFromProject` operationId).
-func (c *ClientWithResponses) DeleteGroupFromProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteGroupFromProjectResponse, error) {
-	rsp, err := c.DeleteGroupFromProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r UpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseDeleteGroupFromProjectResponse(rsp)
+	return http.StatusText(0)
 }
 
-// DeleteGroupFromProjectWithResponse Unlink a project from group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /groups/{group_id}/projects (the `DeleteGroupFromProject` operationId).
-func (c *ClientWithResponses) DeleteGroupFromProjectWithResponse(ctx context.Context, groupID GroupID, body DeleteGroupFromProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteGroupFromProjectResponse, error) {
-	rsp, err := c.DeleteGroupFromProject(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseDeleteGroupFromProjectResponse(rsp)
+	return 0
 }
 
-// ListGroupProjectsWithResponse Fetch all projects attached to group
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /groups/{group_id}/projects (the `ListGroupProjects` operationId).
-func (c *ClientWithResponses) ListGroupProjectsWithResponse(ctx context.Context, groupID GroupID, params *ListGroupProjectsParams, reqEditors ...RequestEditorFn) (*ListGroupProjectsResponse, error) {
-	rsp, err := c.ListGroupProjects(ctx, groupID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r UpdateUserResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseListGroupProjectsResponse(rsp)
+	return ""
 }
 
-// AttachGroupToProjectWithBodyWithResponse Attach a project to group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups/{group_id}/projects (the `AttachGroupToProject` operationId).
-func (c *ClientWithResponses) AttachGroupToProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachGroupToProjectResponse, error) {
-	rsp, err := c.AttachGroupToProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAttachGroupToProjectResponse(rsp)
+type DeleteUserFromGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *NotAttachedError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// AttachGroupToProjectWithResponse Attach a project to group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups/{group_id}/projects (the `AttachGroupToProject` operationId).
-func (c *ClientWithResponses) AttachGroupToProjectWithResponse(ctx context.Context, groupID GroupID, body AttachGroupToProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachGroupToProjectResponse, error) {
-	rsp, err := c.AttachGroupToProject(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAttachGroupToProjectResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
 }
 
-// PermitGroupProjectWithBodyWithResponse Update project perms for group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id}/projects (the `PermitGroupProject` operationId).
-func (c *ClientWithResponses) PermitGroupProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitGroupProjectResponse, error) {
-	rsp, err := c.PermitGroupProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePermitGroupProjectResponse(rsp)
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
 }
 
-// PermitGroupProjectWithResponse Update project perms for group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id}/projects (the `PermitGroupProject` operationId).
-func (c *ClientWithResponses) PermitGroupProjectWithResponse(ctx context.Context, groupID GroupID, body PermitGroupProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitGroupProjectResponse, error) {
-	rsp, err := c.PermitGroupProject(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePermitGroupProjectResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// DeleteGroupFromUserWithBodyWithResponse Unlink a user from group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /groups/{group_id}/users (the `DeleteGroupFromUser` operationId).
-func (c *ClientWithResponses) DeleteGroupFromUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteGroupFromUserResponse, error) {
-	rsp, err := c.DeleteGroupFromUserWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteGroupFromUserResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// DeleteGroupFromUserWithResponse Unlink a user from group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /groups/{group_id}/users (the `DeleteGroupFromUser` operationId).
-func (c *ClientWithResponses) DeleteGroupFromUserWithResponse(ctx context.Context, groupID GroupID, body DeleteGroupFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteGroupFromUserResponse, error) {
-	rsp, err := c.DeleteGroupFromUser(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteGroupFromUserResponse(rsp)
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON412() *NotAttachedError {
+	return r.JSON412
 }
 
-// ListGroupUsersWithResponse Fetch all users attached to group
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /groups/{group_id}/users (the `ListGroupUsers` operationId).
-func (c *ClientWithResponses) ListGroupUsersWithResponse(ctx context.Context, groupID GroupID, params *ListGroupUsersParams, reqEditors ...RequestEditorFn) (*ListGroupUsersResponse, error) {
-	rsp, err := c.ListGroupUsers(ctx, groupID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListGroupUsersResponse(rsp)
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r DeleteUserFromGroupResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
 }
 
-// AttachGroupToUserWithBodyWithResponse Attach a user to group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups/{group_id}/users (the `AttachGroupToUser` operationId).
-func (c *ClientWithResponses) AttachGroupToUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachGroupToUserResponse, error) {
-	rsp, err := c.AttachGroupToUserWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAttachGroupToUserResponse(rsp)
+// GetBody returns the raw response body bytes
+func (r DeleteUserFromGroupResponse) GetBody() []byte {
+	return r.Body
 }
 
-// AttachGroupToUserWithResponse Attach a user to group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /groups/{group_id}/users (the `AttachGroupToUser` operationId).
-func (c *ClientWithResponses) AttachGroupToUserWithResponse(ctx context.Context, groupID GroupID, body AttachGroupToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachGroupToUserResponse, error) {
-	rsp, err := c.AttachGroupToUser(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r DeleteUserFromGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseAttachGroupToUserResponse(rsp)
+	return http.StatusText(0)
 }
 
-// PermitGroupUserWithBodyWithResponse Update user perms for group
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
-func (c *ClientWithResponses) PermitGroupUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitGroupUserResponse, error) {
-	rsp, err := c.PermitGroupUserWithBody(ctx, groupID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserFromGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePermitGroupUserResponse(rsp)
+	return 0
 }
 
-// PermitGroupUserWithResponse Update user perms for group
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
-func (c *ClientWithResponses) PermitGroupUserWithResponse(ctx context.Context, groupID GroupID, body PermitGroupUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitGroupUserResponse, error) {
-	rsp, err := c.PermitGroupUser(ctx, groupID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteUserFromGroupResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParsePermitGroupUserResponse(rsp)
+	return ""
 }
 
-// ShowProfileWithResponse Fetch profile details of the personal account
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /profile/self (the `ShowProfile` operationId).
-func (c *ClientWithResponses) ShowProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ShowProfileResponse, error) {
-	rsp, err := c.ShowProfile(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseShowProfileResponse(rsp)
+type ListUserGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UserGroupsResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// UpdateProfileWithBodyWithResponse Update your own profile information
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /profile/self (the `UpdateProfile` operationId).
-func (c *ClientWithResponses) UpdateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
-	rsp, err := c.UpdateProfileWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProfileResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListUserGroupsResponse) GetJSON200() *UserGroupsResponse {
+	return r.JSON200
 }
 
-// UpdateProfileWithResponse Update your own profile information
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /profile/self (the `UpdateProfile` operationId).
-func (c *ClientWithResponses) UpdateProfileWithResponse(ctx context.Context, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
-	rsp, err := c.UpdateProfile(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProfileResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ListUserGroupsResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// TokenProfileWithResponse Retrieve an unlimited auth token
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /profile/token (the `TokenProfile` operationId).
-func (c *ClientWithResponses) TokenProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TokenProfileResponse, error) {
-	rsp, err := c.TokenProfile(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseTokenProfileResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ListUserGroupsResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// ListProjectsWithResponse Fetch all available projects
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects (the `ListProjects` operationId).
-func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
-	rsp, err := c.ListProjects(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListProjectsResponse(rsp)
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ListUserGroupsResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
 }
 
-// CreateProjectWithBodyWithResponse Create a new project
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects (the `CreateProject` operationId).
-func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectResponse(rsp)
+// GetBody returns the raw response body bytes
+func (r ListUserGroupsResponse) GetBody() []byte {
+	return r.Body
 }
 
-// CreateProjectWithResponse Create a new project
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects (the `CreateProject` operationId).
-func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
-	rsp, err := c.CreateProject(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r ListUserGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseCreateProjectResponse(rsp)
+	return http.StatusText(0)
 }
 
-// DeleteProjectWithResponse Delete a specific project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /projects/{project_id} (the `DeleteProject` operationId).
-func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, projectID ProjectID, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
-	rsp, err := c.DeleteProject(ctx, projectID, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseDeleteProjectResponse(rsp)
+	return 0
 }
 
-// ShowProjectWithResponse Fetch a specific project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects/{project_id} (the `ShowProject` operationId).
-func (c *ClientWithResponses) ShowProjectWithResponse(ctx context.Context, projectID ProjectID, reqEditors ...RequestEditorFn) (*ShowProjectResponse, error) {
-	rsp, err := c.ShowProject(ctx, projectID, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListUserGroupsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseShowProjectResponse(rsp)
+	return ""
 }
 
-// UpdateProjectWithBodyWithResponse Update a specific project
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id} (the `UpdateProject` operationId).
-func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
-	rsp, err := c.UpdateProjectWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectResponse(rsp)
+type AttachUserToGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *AlreadyAttachedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// UpdateProjectWithResponse Update a specific project
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id} (the `UpdateProject` operationId).
-func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, projectID ProjectID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
-	rsp, err := c.UpdateProject(ctx, projectID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
 }
 
-// ListProjectCredentialsWithResponse Fetch all credentials for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects/{project_id}/credentials (the `ListProjectCredentials` operationId).
-func (c *ClientWithResponses) ListProjectCredentialsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectCredentialsParams, reqEditors ...RequestEditorFn) (*ListProjectCredentialsResponse, error) {
-	rsp, err := c.ListProjectCredentials(ctx, projectID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListProjectCredentialsResponse(rsp)
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
 }
 
-// CreateProjectCredentialWithBodyWithResponse Create a new credential
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/credentials (the `CreateProjectCredential` operationId).
-func (c *ClientWithResponses) CreateProjectCredentialWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectCredentialResponse, error) {
-	rsp, err := c.CreateProjectCredentialWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectCredentialResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// CreateProjectCredentialWithResponse Create a new credential
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/credentials (the `CreateProjectCredential` operationId).
-func (c *ClientWithResponses) CreateProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectCredentialResponse, error) {
-	rsp, err := c.CreateProjectCredential(ctx, projectID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectCredentialResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// DeleteProjectCredentialWithResponse Delete a specific credential for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /projects/{project_id}/credentials/{credential_id} (the `DeleteProjectCredential` operationId).
-func (c *ClientWithResponses) DeleteProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, reqEditors ...RequestEditorFn) (*DeleteProjectCredentialResponse, error) {
-	rsp, err := c.DeleteProjectCredential(ctx, projectID, credentialID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteProjectCredentialResponse(rsp)
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON412() *AlreadyAttachedError {
+	return r.JSON412
 }
 
-// ShowProjectCredentialWithResponse Fetch a specific credential for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects/{project_id}/credentials/{credential_id} (the `ShowProjectCredential` operationId).
-func (c *ClientWithResponses) ShowProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, reqEditors ...RequestEditorFn) (*ShowProjectCredentialResponse, error) {
-	rsp, err := c.ShowProjectCredential(ctx, projectID, credentialID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseShowProjectCredentialResponse(rsp)
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON422() *ValidationError {
+	return r.JSON422
 }
 
-// UpdateProjectCredentialWithBodyWithResponse Update a specific credential for a project
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/credentials/{credential_id} (the `UpdateProjectCredential` operationId).
-func (c *ClientWithResponses) UpdateProjectCredentialWithBodyWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCredentialResponse, error) {
-	rsp, err := c.UpdateProjectCredentialWithBody(ctx, projectID, credentialID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectCredentialResponse(rsp)
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r AttachUserToGroupResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
 }
 
-// UpdateProjectCredentialWithResponse Update a specific credential for a project
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/credentials/{credential_id} (the `UpdateProjectCredential` operationId).
-func (c *ClientWithResponses) UpdateProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, body UpdateProjectCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCredentialResponse, error) {
-	rsp, err := c.UpdateProjectCredential(ctx, projectID, credentialID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectCredentialResponse(rsp)
+// GetBody returns the raw response body bytes
+func (r AttachUserToGroupResponse) GetBody() []byte {
+	return r.Body
 }
 
-// ListProjectEnvironmentsWithResponse Fetch all environments for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects/{project_id}/environments (the `ListProjectEnvironments` operationId).
-func (c *ClientWithResponses) ListProjectEnvironmentsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*ListProjectEnvironmentsResponse, error) {
-	rsp, err := c.ListProjectEnvironments(ctx, projectID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r AttachUserToGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseListProjectEnvironmentsResponse(rsp)
+	return http.StatusText(0)
 }
 
-// CreateProjectEnvironmentWithBodyWithResponse Create a new environment
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/environments (the `CreateProjectEnvironment` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentResponse, error) {
-	rsp, err := c.CreateProjectEnvironmentWithBody(ctx, projectID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachUserToGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseCreateProjectEnvironmentResponse(rsp)
+	return 0
 }
 
-// CreateProjectEnvironmentWithResponse Create a new environment
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/environments (the `CreateProjectEnvironment` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentResponse, error) {
-	rsp, err := c.CreateProjectEnvironment(ctx, projectID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AttachUserToGroupResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
 	}
-	return ParseCreateProjectEnvironmentResponse(rsp)
+	return ""
 }
 
-// DeleteProjectEnvironmentWithResponse Delete a specific environment for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /projects/{project_id}/environments/{environment_id} (the `DeleteProjectEnvironment` operationId).
-func (c *ClientWithResponses) DeleteProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentResponse, error) {
-	rsp, err := c.DeleteProjectEnvironment(ctx, projectID, environmentID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteProjectEnvironmentResponse(rsp)
+type PermitUserGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *NotAttachedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
 }
 
-// ShowProjectEnvironmentWithResponse Fetch a specific environment for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /projects/{project_id}/environments/{environment_id} (the `ShowProjectEnvironment` operationId).
-func (c *ClientWithResponses) ShowProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, reqEditors ...RequestEditorFn) (*ShowProjectEnvironmentResponse, error) {
-	rsp, err := c.ShowProjectEnvironment(ctx, projectID, environmentID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseShowProjectEnvironmentResponse(rsp)
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r PermitUserGroupResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
 }
 
-// UpdateProjectEnvironmentWithBodyWithResponse Update a specific environment for a project
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id} (the `UpdateProjectEnvironment` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
-	rsp, err := c.UpdateProjectEnvironmentWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectEnvironmentResponse(rsp)
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r PermitUserGroupResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
 }
 
-// UpdateProjectEnvironmentWithResponse Update a specific environment for a project
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id} (the `UpdateProjectEnvironment` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
-	rsp, err := c.UpdateProjectEnvironment(ctx, projectID, environmentID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateProjectEnvironmentResponse(rsp)
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r PermitUserGroupResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
 }
 
-// CreateProjectEnvironmentSecretWithBodyWithResponse Create a new secret on an environment
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/environments/{environment_id}/secrets (the `CreateProjectEnvironmentSecret` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentSecretWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentSecretResponse, error) {
-	rsp, err := c.CreateProjectEnvironmentSecretWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectEnvironmentSecretResponse(rsp)
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r PermitUserGroupResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
 }
 
-// CreateProjectEnvironmentSecretWithResponse Create a new secret on an environment
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /projects/{project_id}/environments/{environment_id}/secrets (the `CreateProjectEnvironmentSecret` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body CreateProjectEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentSecretResponse, error) {
-	rsp, err := c.CreateProjectEnvironmentSecret(ctx, projectID, environmentID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateProjectEnvironmentSecretResponse(rsp)
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r PermitUserGroupResponse) GetJSON412() *NotAttachedError {
+	return r.JSON412
 }
 
-// DeleteProjectEnvironmentSecretWithResponse Delete a secret on an environment for a project
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `DeleteProjectEnvironmentSecret` operationId).
-func (c *ClientWithResponses) DeleteProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentSecretResponse, error) {
-	rsp, err := c.DeleteProjectEnvironmentSecret(ctx, projectID, environmentID, secretID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteProjectEnvironmentSecretResponse(rsp)
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r PermitUserGroupResponse) GetJSON422() *ValidationError {
+	return r.JSON422
 }
 
-// UpdateProjectEnvironmentSecretWithBodyWithResponse Update a secret on an environment for a project
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `UpdateProjectEnvironmentSecret` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentSecretWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentSecretResponse, error) {
-	rsp, err := c.UpdateProjectEnvironmentSecretWithBody(ctx, projectID, environmentID, secretID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r PermitUserGroupResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r PermitUserGroupResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r PermitUserGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseUpdateProjectEnvironmentSecretResponse(rsp)
+	return http.StatusText(0)
 }
 
-// UpdateProjectEnvironmentSecretWithResponse Update a secret on an environment for a project
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `UpdateProjectEnvironmentSecret` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, body UpdateProjectEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentSecretResponse, error) {
-	rsp, err := c.UpdateProjectEnvironmentSecret(ctx, projectID, environmentID, secretID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r PermitUserGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParseUpdateProjectEnvironmentSecretResponse(rsp)
+	return 0
 }
 
-// CreateProjectEnvironmentValueWithBodyWithResponse Create a new value on an environment
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PermitUserGroupResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteUserFromProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *NotAttachedError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON412() *NotAttachedError {
+	return r.JSON412
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r DeleteUserFromProjectResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r DeleteUserFromProjectResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserFromProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserFromProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteUserFromProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type ListUserProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *UserProjectsResponse
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r ListUserProjectsResponse) GetJSON200() *UserProjectsResponse {
+	return r.JSON200
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r ListUserProjectsResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r ListUserProjectsResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r ListUserProjectsResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r ListUserProjectsResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r ListUserProjectsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type AttachUserToProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *AlreadyAttachedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON412() *AlreadyAttachedError {
+	return r.JSON412
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r AttachUserToProjectResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r AttachUserToProjectResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachUserToProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachUserToProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r AttachUserToProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PermitUserProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	// JSON200 the response for an HTTP 200 `application/json` response
+	JSON200 *SuccessMessage
+	// JSON400 the response for an HTTP 400 `application/json` response
+	JSON400 *BadRequestError
+	// JSON403 the response for an HTTP 403 `application/json` response
+	JSON403 *NotAuthorizedError
+	// JSON404 the response for an HTTP 404 `application/json` response
+	JSON404 *NotFoundError
+	// JSON412 the response for an HTTP 412 `application/json` response
+	JSON412 *NotAttachedError
+	// JSON422 the response for an HTTP 422 `application/json` response
+	JSON422 *ValidationError
+	// JSON500 the response for an HTTP 500 `application/json` response
+	JSON500 *InternalServerError
+}
+
+// GetJSON200 returns the response for an HTTP 200 `application/json` response
+func (r PermitUserProjectResponse) GetJSON200() *SuccessMessage {
+	return r.JSON200
+}
+
+// GetJSON400 returns the response for an HTTP 400 `application/json` response
+func (r PermitUserProjectResponse) GetJSON400() *BadRequestError {
+	return r.JSON400
+}
+
+// GetJSON403 returns the response for an HTTP 403 `application/json` response
+func (r PermitUserProjectResponse) GetJSON403() *NotAuthorizedError {
+	return r.JSON403
+}
+
+// GetJSON404 returns the response for an HTTP 404 `application/json` response
+func (r PermitUserProjectResponse) GetJSON404() *NotFoundError {
+	return r.JSON404
+}
+
+// GetJSON412 returns the response for an HTTP 412 `application/json` response
+func (r PermitUserProjectResponse) GetJSON412() *NotAttachedError {
+	return r.JSON412
+}
+
+// GetJSON422 returns the response for an HTTP 422 `application/json` response
+func (r PermitUserProjectResponse) GetJSON422() *ValidationError {
+	return r.JSON422
+}
+
+// GetJSON500 returns the response for an HTTP 500 `application/json` response
+func (r PermitUserProjectResponse) GetJSON500() *InternalServerError {
+	return r.JSON500
+}
+
+// GetBody returns the raw response body bytes
+func (r PermitUserProjectResponse) GetBody() []byte {
+	return r.Body
+}
+
+// Status returns HTTPResponse.Status
+func (r PermitUserProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PermitUserProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PermitUserProjectResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// LoginAuthWithBodyWithResponse Authenticate an user by credentials
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/environments/{environment_id}/values (the `CreateProjectEnvironmentValue` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentValueWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentValueResponse, error) {
-	rsp, err := c.CreateProjectEnvironmentValueWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
+// Corresponds with POST /auth/login (the `LoginAuth` operationId).
+func (c *ClientWithResponses) LoginAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginAuthResponse, error) {
+	rsp, err := c.LoginAuthWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectEnvironmentValueResponse(rsp)
+	return ParseLoginAuthResponse(rsp)
 }
 
-// CreateProjectEnvironmentValueWithResponse Create a new value on an environment
+// LoginAuthWithResponse Authenticate an user by credentials
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/environments/{environment_id}/values (the `CreateProjectEnvironmentValue` operationId).
-func (c *ClientWithResponses) CreateProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body CreateProjectEnvironmentValueJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentValueResponse, error) {
-	rsp, err := c.CreateProjectEnvironmentValue(ctx, projectID, environmentID, body, reqEditors...)
+// Corresponds with POST /auth/login (the `LoginAuth` operationId).
+func (c *ClientWithResponses) LoginAuthWithResponse(ctx context.Context, body LoginAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginAuthResponse, error) {
+	rsp, err := c.LoginAuth(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectEnvironmentValueResponse(rsp)
+	return ParseLoginAuthResponse(rsp)
 }
 
-// DeleteProjectEnvironmentValueWithResponse Delete a value on an environment for a project
+// ListProvidersWithResponse Fetch the available auth providers
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `DeleteProjectEnvironmentValue` operationId).
-func (c *ClientWithResponses) DeleteProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentValueResponse, error) {
-	rsp, err := c.DeleteProjectEnvironmentValue(ctx, projectID, environmentID, valueID, reqEditors...)
+// Corresponds with GET /auth/providers (the `ListProviders` operationId).
+func (c *ClientWithResponses) ListProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListProvidersResponse, error) {
+	rsp, err := c.ListProviders(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectEnvironmentValueResponse(rsp)
+	return ParseListProvidersResponse(rsp)
 }
 
-// UpdateProjectEnvironmentValueWithBodyWithResponse Update a value on an environment for a project
+// RedirectAuthWithBodyWithResponse Retrieve real token after redirect
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `UpdateProjectEnvironmentValue` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentValueWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentValueResponse, error) {
-	rsp, err := c.UpdateProjectEnvironmentValueWithBody(ctx, projectID, environmentID, valueID, contentType, body, reqEditors...)
+// Corresponds with POST /auth/redirect (the `RedirectAuth` operationId).
+func (c *ClientWithResponses) RedirectAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RedirectAuthResponse, error) {
+	rsp, err := c.RedirectAuthWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectEnvironmentValueResponse(rsp)
+	return ParseRedirectAuthResponse(rsp)
 }
 
-// UpdateProjectEnvironmentValueWithResponse Update a value on an environment for a project
+// RedirectAuthWithResponse Retrieve real token after redirect
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `UpdateProjectEnvironmentValue` operationId).
-func (c *ClientWithResponses) UpdateProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, body UpdateProjectEnvironmentValueJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentValueResponse, error) {
-	rsp, err := c.UpdateProjectEnvironmentValue(ctx, projectID, environmentID, valueID, body, reqEditors...)
+// Corresponds with POST /auth/redirect (the `RedirectAuth` operationId).
+func (c *ClientWithResponses) RedirectAuthWithResponse(ctx context.Context, body RedirectAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*RedirectAuthResponse, error) {
+	rsp, err := c.RedirectAuth(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectEnvironmentValueResponse(rsp)
+	return ParseRedirectAuthResponse(rsp)
 }
 
-// ListProjectEventsWithResponse Fetch all events for a project
+// RefreshAuthWithResponse Refresh an auth token before it expires
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/events (the `ListProjectEvents` operationId).
-func (c *ClientWithResponses) ListProjectEventsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectEventsParams, reqEditors ...RequestEditorFn) (*ListProjectEventsResponse, error) {
-	rsp, err := c.ListProjectEvents(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /auth/refresh (the `RefreshAuth` operationId).
+func (c *ClientWithResponses) RefreshAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error) {
+	rsp, err := c.RefreshAuth(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectEventsResponse(rsp)
+	return ParseRefreshAuthResponse(rsp)
 }
 
-// ListProjectExecutionsWithResponse Fetch all executions for a project
+// VerifyAuthWithResponse Verify validity for an authentication token
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/executions (the `ListProjectExecutions` operationId).
-func (c *ClientWithResponses) ListProjectExecutionsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectExecutionsParams, reqEditors ...RequestEditorFn) (*ListProjectExecutionsResponse, error) {
-	rsp, err := c.ListProjectExecutions(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /auth/verify (the `VerifyAuth` operationId).
+func (c *ClientWithResponses) VerifyAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyAuthResponse, error) {
+	rsp, err := c.VerifyAuth(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectExecutionsResponse(rsp)
+	return ParseVerifyAuthResponse(rsp)
 }
 
-// CreateProjectExecutionWithBodyWithResponse Create a new execution
+// CallbackProviderWithResponse Callback to parse the defined provider
 //
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+// Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/executions (the `CreateProjectExecution` operationId).
-func (c *ClientWithResponses) CreateProjectExecutionWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectExecutionResponse, error) {
-	rsp, err := c.CreateProjectExecutionWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with GET /auth/{provider}/callback (the `CallbackProvider` operationId).
+func (c *ClientWithResponses) CallbackProviderWithResponse(ctx context.Context, provider AuthProviderParam, params *CallbackProviderParams, reqEditors ...RequestEditorFn) (*CallbackProviderResponse, error) {
+	rsp, err := c.CallbackProvider(ctx, provider, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectExecutionResponse(rsp)
+	return ParseCallbackProviderResponse(rsp)
 }
 
-// CreateProjectExecutionWithResponse Create a new execution
+// RequestProviderWithResponse Request the redirect to defined provider
 //
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+// Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/executions (the `CreateProjectExecution` operationId).
-func (c *ClientWithResponses) CreateProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectExecutionResponse, error) {
-	rsp, err := c.CreateProjectExecution(ctx, projectID, body, reqEditors...)
+// Corresponds with GET /auth/{provider}/request (the `RequestProvider` operationId).
+func (c *ClientWithResponses) RequestProviderWithResponse(ctx context.Context, provider AuthProviderParam, reqEditors ...RequestEditorFn) (*RequestProviderResponse, error) {
+	rsp, err := c.RequestProvider(ctx, provider, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectExecutionResponse(rsp)
+	return ParseRequestProviderResponse(rsp)
 }
 
-// DeleteProjectExecutionWithResponse Delete a specific execution for a project
+// ListGlobalEventsWithResponse Fetch all events
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/executions/{execution_id} (the `DeleteProjectExecution` operationId).
-func (c *ClientWithResponses) DeleteProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*DeleteProjectExecutionResponse, error) {
-	rsp, err := c.DeleteProjectExecution(ctx, projectID, executionID, reqEditors...)
+// Corresponds with GET /events (the `ListGlobalEvents` operationId).
+func (c *ClientWithResponses) ListGlobalEventsWithResponse(ctx context.Context, params *ListGlobalEventsParams, reqEditors ...RequestEditorFn) (*ListGlobalEventsResponse, error) {
+	rsp, err := c.ListGlobalEvents(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectExecutionResponse(rsp)
+	return ParseListGlobalEventsResponse(rsp)
 }
 
-// ShowProjectExecutionWithResponse Fetch a specific execution for a project
+// ListGroupsWithResponse Fetch all available groups
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/executions/{execution_id} (the `ShowProjectExecution` operationId).
-func (c *ClientWithResponses) ShowProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ShowProjectExecutionResponse, error) {
-	rsp, err := c.ShowProjectExecution(ctx, projectID, executionID, reqEditors...)
+// Corresponds with GET /groups (the `ListGroups` operationId).
+func (c *ClientWithResponses) ListGroupsWithResponse(ctx context.Context, params *ListGroupsParams, reqEditors ...RequestEditorFn) (*ListGroupsResponse, error) {
+	rsp, err := c.ListGroups(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectExecutionResponse(rsp)
+	return ParseListGroupsResponse(rsp)
 }
 
-// OutputProjectExecutionWithResponse Output a specific execution for a project
+// CreateGroupWithBodyWithResponse Create a new group
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/executions/{execution_id}/output (the `OutputProjectExecution` operationId).
-func (c *ClientWithResponses) OutputProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*OutputProjectExecutionResponse, error) {
-	rsp, err := c.OutputProjectExecution(ctx, projectID, executionID, reqEditors...)
+// Corresponds with POST /groups (the `CreateGroup` operationId).
+func (c *ClientWithResponses) CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	rsp, err := c.CreateGroupWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseOutputProjectExecutionResponse(rsp)
+	return ParseCreateGroupResponse(rsp)
 }
 
-// PurgeProjectExecutionWithResponse Purge a specific execution for a project
+// CreateGroupWithResponse Create a new group
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/executions/{execution_id}/purge (the `PurgeProjectExecution` operationId).
-func (c *ClientWithResponses) PurgeProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*PurgeProjectExecutionResponse, error) {
-	rsp, err := c.PurgeProjectExecution(ctx, projectID, executionID, reqEditors...)
+// Corresponds with POST /groups (the `CreateGroup` operationId).
+func (c *ClientWithResponses) CreateGroupWithResponse(ctx context.Context, body CreateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGroupResponse, error) {
+	rsp, err := c.CreateGroup(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePurgeProjectExecutionResponse(rsp)
+	return ParseCreateGroupResponse(rsp)
 }
 
-// DeleteProjectFromGroupWithBodyWithResponse Unlink a group from project
+// DeleteGroupWithResponse Delete a specific group
 //
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+// Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/groups (the `DeleteProjectFromGroup` operationId).
-func (c *ClientWithResponses) DeleteProjectFromGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectFromGroupResponse, error) {
-	rsp, err := c.DeleteProjectFromGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with DELETE /groups/{group_id} (the `DeleteGroup` operationId).
+func (c *ClientWithResponses) DeleteGroupWithResponse(ctx context.Context, groupID GroupID, reqEditors ...RequestEditorFn) (*DeleteGroupResponse, error) {
+	rsp, err := c.DeleteGroup(ctx, groupID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectFromGroupResponse(rsp)
+	return ParseDeleteGroupResponse(rsp)
 }
 
-// DeleteProjectFromGroupWithResponse Unlink a group from project
+// ShowGroupWithResponse Fetch a specific group
 //
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+// Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/groups (the `DeleteProjectFromGroup` operationId).
-func (c *ClientWithResponses) DeleteProjectFromGroupWithResponse(ctx context.Context, projectID ProjectID, body DeleteProjectFromGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectFromGroupResponse, error) {
-	rsp, err := c.DeleteProjectFromGroup(ctx, projectID, body, reqEditors...)
+// Corresponds with GET /groups/{group_id} (the `ShowGroup` operationId).
+func (c *ClientWithResponses) ShowGroupWithResponse(ctx context.Context, groupID GroupID, reqEditors ...RequestEditorFn) (*ShowGroupResponse, error) {
+	rsp, err := c.ShowGroup(ctx, groupID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectFromGroupResponse(rsp)
+	return ParseShowGroupResponse(rsp)
 }
 
-// ListProjectGroupsWithResponse Fetch all groups attached to project
+// UpdateGroupWithBodyWithResponse Update a specific group
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/groups (the `ListProjectGroups` operationId).
-func (c *ClientWithResponses) ListProjectGroupsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectGroupsParams, reqEditors ...RequestEditorFn) (*ListProjectGroupsResponse, error) {
-	rsp, err := c.ListProjectGroups(ctx, projectID, params, reqEditors...)
+// Corresponds with PUT /groups/{group_id} (the `UpdateGroup` operationId).
+func (c *ClientWithResponses) UpdateGroupWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
+	rsp, err := c.UpdateGroupWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectGroupsResponse(rsp)
+	return ParseUpdateGroupResponse(rsp)
 }
 
-// AttachProjectToGroupWithBodyWithResponse Attach a group to project
+// UpdateGroupWithResponse Update a specific group
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /groups/{group_id} (the `UpdateGroup` operationId).
+func (c *ClientWithResponses) UpdateGroupWithResponse(ctx context.Context, groupID GroupID, body UpdateGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupResponse, error) {
+	rsp, err := c.UpdateGroup(ctx, groupID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGroupResponse(rsp)
+}
+
+// DeleteGroupFromProjectWithBodyWithResponse Unlink a project from group
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/groups (the `AttachProjectToGroup` operationId).
-func (c *ClientWithResponses) AttachProjectToGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachProjectToGroupResponse, error) {
-	rsp, err := c.AttachProjectToGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with DELETE /groups/{group_id}/projects (the `DeleteGroupFromProject` operationId).
+func (c *ClientWithResponses) DeleteGroupFromProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteGroupFromProjectResponse, error) {
+	rsp, err := c.DeleteGroupFromProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachProjectToGroupResponse(rsp)
+	return ParseDeleteGroupFromProjectResponse(rsp)
 }
 
-// AttachProjectToGroupWithResponse Attach a group to project
+// DeleteGroupFromProjectWithResponse Unlink a project from group
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/groups (the `AttachProjectToGroup` operationId).
-func (c *ClientWithResponses) AttachProjectToGroupWithResponse(ctx context.Context, projectID ProjectID, body AttachProjectToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachProjectToGroupResponse, error) {
-	rsp, err := c.AttachProjectToGroup(ctx, projectID, body, reqEditors...)
+// Corresponds with DELETE /groups/{group_id}/projects (the `DeleteGroupFromProject` operationId).
+func (c *ClientWithResponses) DeleteGroupFromProjectWithResponse(ctx context.Context, groupID GroupID, body DeleteGroupFromProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteGroupFromProjectResponse, error) {
+	rsp, err := c.DeleteGroupFromProject(ctx, groupID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachProjectToGroupResponse(rsp)
+	return ParseDeleteGroupFromProjectResponse(rsp)
 }
 
-// PermitProjectGroupWithBodyWithResponse Update group perms for project
+// ListGroupProjectsWithResponse Fetch all projects attached to group
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /groups/{group_id}/projects (the `ListGroupProjects` operationId).
+func (c *ClientWithResponses) ListGroupProjectsWithResponse(ctx context.Context, groupID GroupID, params *ListGroupProjectsParams, reqEditors ...RequestEditorFn) (*ListGroupProjectsResponse, error) {
+	rsp, err := c.ListGroupProjects(ctx, groupID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGroupProjectsResponse(rsp)
+}
+
+// AttachGroupToProjectWithBodyWithResponse Attach a project to group
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/groups (the `PermitProjectGroup` operationId).
-func (c *ClientWithResponses) PermitProjectGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitProjectGroupResponse, error) {
-	rsp, err := c.PermitProjectGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /groups/{group_id}/projects (the `AttachGroupToProject` operationId).
+func (c *ClientWithResponses) AttachGroupToProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachGroupToProjectResponse, error) {
+	rsp, err := c.AttachGroupToProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePermitProjectGroupResponse(rsp)
+	return ParseAttachGroupToProjectResponse(rsp)
 }
 
-// PermitProjectGroupWithResponse Update group perms for project
+// AttachGroupToProjectWithResponse Attach a project to group
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/groups (the `PermitProjectGroup` operationId).
-func (c *ClientWithResponses) PermitProjectGroupWithResponse(ctx context.Context, projectID ProjectID, body PermitProjectGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitProjectGroupResponse, error) {
-	rsp, err := c.PermitProjectGroup(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /groups/{group_id}/projects (the `AttachGroupToProject` operationId).
+func (c *ClientWithResponses) AttachGroupToProjectWithResponse(ctx context.Context, groupID GroupID, body AttachGroupToProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachGroupToProjectResponse, error) {
+	rsp, err := c.AttachGroupToProject(ctx, groupID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePermitProjectGroupResponse(rsp)
+	return ParseAttachGroupToProjectResponse(rsp)
 }
 
-// ListProjectInventoriesWithResponse Fetch all inventories for a project
+// PermitGroupProjectWithBodyWithResponse Update project perms for group
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/inventories (the `ListProjectInventories` operationId).
-func (c *ClientWithResponses) ListProjectInventoriesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectInventoriesParams, reqEditors ...RequestEditorFn) (*ListProjectInventoriesResponse, error) {
-	rsp, err := c.ListProjectInventories(ctx, projectID, params, reqEditors...)
+// Corresponds with PUT /groups/{group_id}/projects (the `PermitGroupProject` operationId).
+func (c *ClientWithResponses) PermitGroupProjectWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitGroupProjectResponse, error) {
+	rsp, err := c.PermitGroupProjectWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectInventoriesResponse(rsp)
+	return ParsePermitGroupProjectResponse(rsp)
 }
 
-// CreateProjectInventoryWithBodyWithResponse Create a new inventory
+// PermitGroupProjectWithResponse Update project perms for group
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /groups/{group_id}/projects (the `PermitGroupProject` operationId).
+func (c *ClientWithResponses) PermitGroupProjectWithResponse(ctx context.Context, groupID GroupID, body PermitGroupProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitGroupProjectResponse, error) {
+	rsp, err := c.PermitGroupProject(ctx, groupID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitGroupProjectResponse(rsp)
+}
+
+// DeleteGroupFromUserWithBodyWithResponse Unlink a user from group
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/inventories (the `CreateProjectInventory` operationId).
-func (c *ClientWithResponses) CreateProjectInventoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectInventoryResponse, error) {
-	rsp, err := c.CreateProjectInventoryWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with DELETE /groups/{group_id}/users (the `DeleteGroupFromUser` operationId).
+func (c *ClientWithResponses) DeleteGroupFromUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteGroupFromUserResponse, error) {
+	rsp, err := c.DeleteGroupFromUserWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectInventoryResponse(rsp)
+	return ParseDeleteGroupFromUserResponse(rsp)
 }
 
-// CreateProjectInventoryWithResponse Create a new inventory
+// DeleteGroupFromUserWithResponse Unlink a user from group
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/inventories (the `CreateProjectInventory` operationId).
-func (c *ClientWithResponses) CreateProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectInventoryResponse, error) {
-	rsp, err := c.CreateProjectInventory(ctx, projectID, body, reqEditors...)
+// Corresponds with DELETE /groups/{group_id}/users (the `DeleteGroupFromUser` operationId).
+func (c *ClientWithResponses) DeleteGroupFromUserWithResponse(ctx context.Context, groupID GroupID, body DeleteGroupFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteGroupFromUserResponse, error) {
+	rsp, err := c.DeleteGroupFromUser(ctx, groupID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectInventoryResponse(rsp)
+	return ParseDeleteGroupFromUserResponse(rsp)
 }
 
-// DeleteProjectInventoryWithResponse Delete a specific inventory for a project
+// ListGroupUsersWithResponse Fetch all users attached to group
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/inventories/{inventory_id} (the `DeleteProjectInventory` operationId).
-func (c *ClientWithResponses) DeleteProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, reqEditors ...RequestEditorFn) (*DeleteProjectInventoryResponse, error) {
-	rsp, err := c.DeleteProjectInventory(ctx, projectID, inventoryID, reqEditors...)
+// Corresponds with GET /groups/{group_id}/users (the `ListGroupUsers` operationId).
+func (c *ClientWithResponses) ListGroupUsersWithResponse(ctx context.Context, groupID GroupID, params *ListGroupUsersParams, reqEditors ...RequestEditorFn) (*ListGroupUsersResponse, error) {
+	rsp, err := c.ListGroupUsers(ctx, groupID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectInventoryResponse(rsp)
+	return ParseListGroupUsersResponse(rsp)
 }
 
-// ShowProjectInventoryWithResponse Fetch a specific inventory for a project
+// AttachGroupToUserWithBodyWithResponse Attach a user to group
 //
-// Returns a wrapper object for the known response body format(s).
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/inventories/{inventory_id} (the `ShowProjectInventory` operationId).
-func (c *ClientWithResponses) ShowProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, reqEditors ...RequestEditorFn) (*ShowProjectInventoryResponse, error) {
-	rsp, err := c.ShowProjectInventory(ctx, projectID, inventoryID, reqEditors...)
+// Corresponds with POST /groups/{group_id}/users (the `AttachGroupToUser` operationId).
+func (c *ClientWithResponses) AttachGroupToUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachGroupToUserResponse, error) {
+	rsp, err := c.AttachGroupToUserWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectInventoryResponse(rsp)
+	return ParseAttachGroupToUserResponse(rsp)
 }
 
-// UpdateProjectInventoryWithBodyWithResponse Update a specific inventory for a project
+// AttachGroupToUserWithResponse Attach a user to group
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /groups/{group_id}/users (the `AttachGroupToUser` operationId).
+func (c *ClientWithResponses) AttachGroupToUserWithResponse(ctx context.Context, groupID GroupID, body AttachGroupToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachGroupToUserResponse, error) {
+	rsp, err := c.AttachGroupToUser(ctx, groupID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachGroupToUserResponse(rsp)
+}
+
+// PermitGroupUserWithBodyWithResponse Update user perms for group
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
-func (c *ClientWithResponses) UpdateProjectInventoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectInventoryResponse, error) {
-	rsp, err := c.UpdateProjectInventoryWithBody(ctx, projectID, inventoryID, contentType, body, reqEditors...)
+// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
+func (c *ClientWithResponses) PermitGroupUserWithBodyWithResponse(ctx context.Context, groupID GroupID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitGroupUserResponse, error) {
+	rsp, err := c.PermitGroupUserWithBody(ctx, groupID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectInventoryResponse(rsp)
+	return ParsePermitGroupUserResponse(rsp)
 }
 
-// UpdateProjectInventoryWithResponse Update a specific inventory for a project
+// PermitGroupUserWithResponse Update user perms for group
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
-func (c *ClientWithResponses) UpdateProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, body UpdateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectInventoryResponse, error) {
-	rsp, err := c.UpdateProjectInventory(ctx, projectID, inventoryID, body, reqEditors...)
+// Corresponds with PUT /groups/{group_id}/users (the `PermitGroupUser` operationId).
+func (c *ClientWithResponses) PermitGroupUserWithResponse(ctx context.Context, groupID GroupID, body PermitGroupUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitGroupUserResponse, error) {
+	rsp, err := c.PermitGroupUser(ctx, groupID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectInventoryResponse(rsp)
+	return ParsePermitGroupUserResponse(rsp)
 }
 
-// ListProjectRepositoriesWithResponse Fetch all repositories for a project
+// ListGlobalJoinsWithResponse Fetch all join tokens
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/repositories (the `ListProjectRepositories` operationId).
-func (c *ClientWithResponses) ListProjectRepositoriesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectRepositoriesParams, reqEditors ...RequestEditorFn) (*ListProjectRepositoriesResponse, error) {
-	rsp, err := c.ListProjectRepositories(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /joins (the `ListGlobalJoins` operationId).
+func (c *ClientWithResponses) ListGlobalJoinsWithResponse(ctx context.Context, params *ListGlobalJoinsParams, reqEditors ...RequestEditorFn) (*ListGlobalJoinsResponse, error) {
+	rsp, err := c.ListGlobalJoins(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectRepositoriesResponse(rsp)
+	return ParseListGlobalJoinsResponse(rsp)
 }
 
-// CreateProjectRepositoryWithBodyWithResponse Create a new repository
+// CreateGlobalJoinWithBodyWithResponse Create a new global join token
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/repositories (the `CreateProjectRepository` operationId).
-func (c *ClientWithResponses) CreateProjectRepositoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRepositoryResponse, error) {
-	rsp, err := c.CreateProjectRepositoryWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+func (c *ClientWithResponses) CreateGlobalJoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGlobalJoinResponse, error) {
+	rsp, err := c.CreateGlobalJoinWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectRepositoryResponse(rsp)
+	return ParseCreateGlobalJoinResponse(rsp)
 }
 
-// CreateProjectRepositoryWithResponse Create a new repository
+// CreateGlobalJoinWithResponse Create a new global join token
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/repositories (the `CreateProjectRepository` operationId).
-func (c *ClientWithResponses) CreateProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRepositoryResponse, error) {
-	rsp, err := c.CreateProjectRepository(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /joins (the `CreateGlobalJoin` operationId).
+func (c *ClientWithResponses) CreateGlobalJoinWithResponse(ctx context.Context, body CreateGlobalJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGlobalJoinResponse, error) {
+	rsp, err := c.CreateGlobalJoin(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectRepositoryResponse(rsp)
+	return ParseCreateGlobalJoinResponse(rsp)
 }
 
-// DeleteProjectRepositoryWithResponse Delete a specific repository for a project
+// DeleteGlobalJoinWithResponse Delete a specific join token
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/repositories/{repository_id} (the `DeleteProjectRepository` operationId).
-func (c *ClientWithResponses) DeleteProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, reqEditors ...RequestEditorFn) (*DeleteProjectRepositoryResponse, error) {
-	rsp, err := c.DeleteProjectRepository(ctx, projectID, repositoryID, reqEditors...)
+// Corresponds with DELETE /joins/{join_id} (the `DeleteGlobalJoin` operationId).
+func (c *ClientWithResponses) DeleteGlobalJoinWithResponse(ctx context.Context, joinID JoinID, reqEditors ...RequestEditorFn) (*DeleteGlobalJoinResponse, error) {
+	rsp, err := c.DeleteGlobalJoin(ctx, joinID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectRepositoryResponse(rsp)
+	return ParseDeleteGlobalJoinResponse(rsp)
 }
 
-// ShowProjectRepositoryWithResponse Fetch a specific repository for a project
+// ShowProfileWithResponse Fetch profile details of the personal account
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/repositories/{repository_id} (the `ShowProjectRepository` operationId).
-func (c *ClientWithResponses) ShowProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, reqEditors ...RequestEditorFn) (*ShowProjectRepositoryResponse, error) {
-	rsp, err := c.ShowProjectRepository(ctx, projectID, repositoryID, reqEditors...)
+// Corresponds with GET /profile/self (the `ShowProfile` operationId).
+func (c *ClientWithResponses) ShowProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ShowProfileResponse, error) {
+	rsp, err := c.ShowProfile(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectRepositoryResponse(rsp)
+	return ParseShowProfileResponse(rsp)
 }
 
-// UpdateProjectRepositoryWithBodyWithResponse Update a specific repository for a project
+// UpdateProfileWithBodyWithResponse Update your own profile information
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/repositories/{repository_id} (the `UpdateProjectRepository` operationId).
-func (c *ClientWithResponses) UpdateProjectRepositoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRepositoryResponse, error) {
-	rsp, err := c.UpdateProjectRepositoryWithBody(ctx, projectID, repositoryID, contentType, body, reqEditors...)
+// Corresponds with PUT /profile/self (the `UpdateProfile` operationId).
+func (c *ClientWithResponses) UpdateProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
+	rsp, err := c.UpdateProfileWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRepositoryResponse(rsp)
+	return ParseUpdateProfileResponse(rsp)
 }
 
-// UpdateProjectRepositoryWithResponse Update a specific repository for a project
+// UpdateProfileWithResponse Update your own profile information
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/repositories/{repository_id} (the `UpdateProjectRepository` operationId).
-func (c *ClientWithResponses) UpdateProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, body UpdateProjectRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRepositoryResponse, error) {
-	rsp, err := c.UpdateProjectRepository(ctx, projectID, repositoryID, body, reqEditors...)
+// Corresponds with PUT /profile/self (the `UpdateProfile` operationId).
+func (c *ClientWithResponses) UpdateProfileWithResponse(ctx context.Context, body UpdateProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProfileResponse, error) {
+	rsp, err := c.UpdateProfile(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRepositoryResponse(rsp)
+	return ParseUpdateProfileResponse(rsp)
 }
 
-// ListProjectRunnersWithResponse Fetch all runners for a project
+// TokenProfileWithResponse Retrieve an unlimited auth token
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/runners (the `ListProjectRunners` operationId).
-func (c *ClientWithResponses) ListProjectRunnersWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectRunnersParams, reqEditors ...RequestEditorFn) (*ListProjectRunnersResponse, error) {
-	rsp, err := c.ListProjectRunners(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /profile/token (the `TokenProfile` operationId).
+func (c *ClientWithResponses) TokenProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TokenProfileResponse, error) {
+	rsp, err := c.TokenProfile(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectRunnersResponse(rsp)
+	return ParseTokenProfileResponse(rsp)
 }
 
-// CreateProjectRunnerWithBodyWithResponse Create a new runner
+// ListProjectsWithResponse Fetch all available projects
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects (the `ListProjects` operationId).
+func (c *ClientWithResponses) ListProjectsWithResponse(ctx context.Context, params *ListProjectsParams, reqEditors ...RequestEditorFn) (*ListProjectsResponse, error) {
+	rsp, err := c.ListProjects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectsResponse(rsp)
+}
+
+// CreateProjectWithBodyWithResponse Create a new project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/runners (the `CreateProjectRunner` operationId).
-func (c *ClientWithResponses) CreateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRunnerResponse, error) {
-	rsp, err := c.CreateProjectRunnerWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /projects (the `CreateProject` operationId).
+func (c *ClientWithResponses) CreateProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProjectWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectRunnerResponse(rsp)
+	return ParseCreateProjectResponse(rsp)
 }
 
-// CreateProjectRunnerWithResponse Create a new runner
+// CreateProjectWithResponse Create a new project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/runners (the `CreateProjectRunner` operationId).
-func (c *ClientWithResponses) CreateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRunnerResponse, error) {
-	rsp, err := c.CreateProjectRunner(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /projects (the `CreateProject` operationId).
+func (c *ClientWithResponses) CreateProjectWithResponse(ctx context.Context, body CreateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectResponse, error) {
+	rsp, err := c.CreateProject(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectRunnerResponse(rsp)
+	return ParseCreateProjectResponse(rsp)
 }
 
-// DeleteProjectRunnerWithResponse Delete a specific runner for a project
+// DeleteProjectWithResponse Delete a specific project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/runners/{runner_id} (the `DeleteProjectRunner` operationId).
-func (c *ClientWithResponses) DeleteProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*DeleteProjectRunnerResponse, error) {
-	rsp, err := c.DeleteProjectRunner(ctx, projectID, runnerID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id} (the `DeleteProject` operationId).
+func (c *ClientWithResponses) DeleteProjectWithResponse(ctx context.Context, projectID ProjectID, reqEditors ...RequestEditorFn) (*DeleteProjectResponse, error) {
+	rsp, err := c.DeleteProject(ctx, projectID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectRunnerResponse(rsp)
+	return ParseDeleteProjectResponse(rsp)
 }
 
-// ShowProjectRunnerWithResponse Fetch a specific runner for a project
+// ShowProjectWithResponse Fetch a specific project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/runners/{runner_id} (the `ShowProjectRunner` operationId).
-func (c *ClientWithResponses) ShowProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*ShowProjectRunnerResponse, error) {
-	rsp, err := c.ShowProjectRunner(ctx, projectID, runnerID, reqEditors...)
+// Corresponds with GET /projects/{project_id} (the `ShowProject` operationId).
+func (c *ClientWithResponses) ShowProjectWithResponse(ctx context.Context, projectID ProjectID, reqEditors ...RequestEditorFn) (*ShowProjectResponse, error) {
+	rsp, err := c.ShowProject(ctx, projectID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectRunnerResponse(rsp)
+	return ParseShowProjectResponse(rsp)
 }
 
-// UpdateProjectRunnerWithBodyWithResponse Update a specific runner for a project
+// UpdateProjectWithBodyWithResponse Update a specific project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
-func (c *ClientWithResponses) UpdateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRunnerResponse, error) {
-	rsp, err := c.UpdateProjectRunnerWithBody(ctx, projectID, runnerID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id} (the `UpdateProject` operationId).
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRunnerResponse(rsp)
+	return ParseUpdateProjectResponse(rsp)
 }
 
-// UpdateProjectRunnerWithResponse Update a specific runner for a project
+// UpdateProjectWithResponse Update a specific project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
-func (c *ClientWithResponses) UpdateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRunnerResponse, error) {
-	rsp, err := c.UpdateProjectRunner(ctx, projectID, runnerID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id} (the `UpdateProject` operationId).
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, projectID ProjectID, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProject(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectRunnerResponse(rsp)
+	return ParseUpdateProjectResponse(rsp)
 }
 
-// ListProjectSchedulesWithResponse Fetch all schedules for a project
+// ListProjectCredentialsWithResponse Fetch all credentials for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/schedules (the `ListProjectSchedules` operationId).
-func (c *ClientWithResponses) ListProjectSchedulesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectSchedulesParams, reqEditors ...RequestEditorFn) (*ListProjectSchedulesResponse, error) {
-	rsp, err := c.ListProjectSchedules(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /projects/{project_id}/credentials (the `ListProjectCredentials` operationId).
+func (c *ClientWithResponses) ListProjectCredentialsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectCredentialsParams, reqEditors ...RequestEditorFn) (*ListProjectCredentialsResponse, error) {
+	rsp, err := c.ListProjectCredentials(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectSchedulesResponse(rsp)
+	return ParseListProjectCredentialsResponse(rsp)
 }
 
-// CreateProjectScheduleWithBodyWithResponse Create a new schedule
+// CreateProjectCredentialWithBodyWithResponse Create a new credential
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/schedules (the `CreateProjectSchedule` operationId).
-func (c *ClientWithResponses) CreateProjectScheduleWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectScheduleResponse, error) {
-	rsp, err := c.CreateProjectScheduleWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/credentials (the `CreateProjectCredential` operationId).
+func (c *ClientWithResponses) CreateProjectCredentialWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectCredentialResponse, error) {
+	rsp, err := c.CreateProjectCredentialWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectScheduleResponse(rsp)
+	return ParseCreateProjectCredentialResponse(rsp)
 }
 
-// CreateProjectScheduleWithResponse Create a new schedule
+// CreateProjectCredentialWithResponse Create a new credential
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/schedules (the `CreateProjectSchedule` operationId).
-func (c *ClientWithResponses) CreateProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectScheduleResponse, error) {
-	rsp, err := c.CreateProjectSchedule(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/credentials (the `CreateProjectCredential` operationId).
+func (c *ClientWithResponses) CreateProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectCredentialResponse, error) {
+	rsp, err := c.CreateProjectCredential(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectScheduleResponse(rsp)
+	return ParseCreateProjectCredentialResponse(rsp)
 }
 
-// DeleteProjectScheduleWithResponse Delete a specific schedule for a project
+// DeleteProjectCredentialWithResponse Delete a specific credential for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/schedules/{schedule_id} (the `DeleteProjectSchedule` operationId).
-func (c *ClientWithResponses) DeleteProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*DeleteProjectScheduleResponse, error) {
-	rsp, err := c.DeleteProjectSchedule(ctx, projectID, scheduleID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/credentials/{credential_id} (the `DeleteProjectCredential` operationId).
+func (c *ClientWithResponses) DeleteProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, reqEditors ...RequestEditorFn) (*DeleteProjectCredentialResponse, error) {
+	rsp, err := c.DeleteProjectCredential(ctx, projectID, credentialID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectScheduleResponse(rsp)
+	return ParseDeleteProjectCredentialResponse(rsp)
 }
 
-// ShowProjectScheduleWithResponse Fetch a specific schedule for a project
+// ShowProjectCredentialWithResponse Fetch a specific credential for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/schedules/{schedule_id} (the `ShowProjectSchedule` operationId).
-func (c *ClientWithResponses) ShowProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*ShowProjectScheduleResponse, error) {
-	rsp, err := c.ShowProjectSchedule(ctx, projectID, scheduleID, reqEditors...)
+// Corresponds with GET /projects/{project_id}/credentials/{credential_id} (the `ShowProjectCredential` operationId).
+func (c *ClientWithResponses) ShowProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, reqEditors ...RequestEditorFn) (*ShowProjectCredentialResponse, error) {
+	rsp, err := c.ShowProjectCredential(ctx, projectID, credentialID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectScheduleResponse(rsp)
+	return ParseShowProjectCredentialResponse(rsp)
 }
 
-// UpdateProjectScheduleWithBodyWithResponse Update a specific schedule for a project
+// UpdateProjectCredentialWithBodyWithResponse Update a specific credential for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
-func (c *ClientWithResponses) UpdateProjectScheduleWithBodyWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectScheduleResponse, error) {
-	rsp, err := c.UpdateProjectScheduleWithBody(ctx, projectID, scheduleID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/credentials/{credential_id} (the `UpdateProjectCredential` operationId).
+func (c *ClientWithResponses) UpdateProjectCredentialWithBodyWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectCredentialResponse, error) {
+	rsp, err := c.UpdateProjectCredentialWithBody(ctx, projectID, credentialID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectScheduleResponse(rsp)
+	return ParseUpdateProjectCredentialResponse(rsp)
 }
 
-// UpdateProjectScheduleWithResponse Update a specific schedule for a project
+// UpdateProjectCredentialWithResponse Update a specific credential for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
-func (c *ClientWithResponses) UpdateProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectScheduleResponse, error) {
-	rsp, err := c.UpdateProjectSchedule(ctx, projectID, scheduleID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/credentials/{credential_id} (the `UpdateProjectCredential` operationId).
+func (c *ClientWithResponses) UpdateProjectCredentialWithResponse(ctx context.Context, projectID ProjectID, credentialID CredentialID, body UpdateProjectCredentialJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectCredentialResponse, error) {
+	rsp, err := c.UpdateProjectCredential(ctx, projectID, credentialID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectScheduleResponse(rsp)
+	return ParseUpdateProjectCredentialResponse(rsp)
 }
 
-// ListProjectTemplatesWithResponse Fetch all templates for a project
+// ListProjectEnvironmentsWithResponse Fetch all environments for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/templates (the `ListProjectTemplates` operationId).
-func (c *ClientWithResponses) ListProjectTemplatesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectTemplatesParams, reqEditors ...RequestEditorFn) (*ListProjectTemplatesResponse, error) {
-	rsp, err := c.ListProjectTemplates(ctx, projectID, params, reqEditors...)
+// Corresponds with GET /projects/{project_id}/environments (the `ListProjectEnvironments` operationId).
+func (c *ClientWithResponses) ListProjectEnvironmentsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectEnvironmentsParams, reqEditors ...RequestEditorFn) (*ListProjectEnvironmentsResponse, error) {
+	rsp, err := c.ListProjectEnvironments(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectTemplatesResponse(rsp)
+	return ParseListProjectEnvironmentsResponse(rsp)
 }
 
-// CreateProjectTemplateWithBodyWithResponse Create a new template
+// CreateProjectEnvironmentWithBodyWithResponse Create a new environment
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates (the `CreateProjectTemplate` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateResponse, error) {
-	rsp, err := c.CreateProjectTemplateWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments (the `CreateProjectEnvironment` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentResponse, error) {
+	rsp, err := c.CreateProjectEnvironmentWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateResponse(rsp)
+	return ParseCreateProjectEnvironmentResponse(rsp)
 }
 
-// CreateProjectTemplateWithResponse Create a new template
+// CreateProjectEnvironmentWithResponse Create a new environment
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates (the `CreateProjectTemplate` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateResponse, error) {
-	rsp, err := c.CreateProjectTemplate(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments (the `CreateProjectEnvironment` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentResponse, error) {
+	rsp, err := c.CreateProjectEnvironment(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateResponse(rsp)
+	return ParseCreateProjectEnvironmentResponse(rsp)
 }
 
-// DeleteProjectTemplateWithResponse Delete a specific template for a project
+// DeleteProjectEnvironmentWithResponse Delete a specific environment for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/templates/{template_id} (the `DeleteProjectTemplate` operationId).
-func (c *ClientWithResponses) DeleteProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateResponse, error) {
-	rsp, err := c.DeleteProjectTemplate(ctx, projectID, templateID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/environments/{environment_id} (the `DeleteProjectEnvironment` operationId).
+func (c *ClientWithResponses) DeleteProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentResponse, error) {
+	rsp, err := c.DeleteProjectEnvironment(ctx, projectID, environmentID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectTemplateResponse(rsp)
+	return ParseDeleteProjectEnvironmentResponse(rsp)
 }
 
-// ShowProjectTemplateWithResponse Fetch a specific template for a project
+// ShowProjectEnvironmentWithResponse Fetch a specific environment for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/templates/{template_id} (the `ShowProjectTemplate` operationId).
-func (c *ClientWithResponses) ShowProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, reqEditors ...RequestEditorFn) (*ShowProjectTemplateResponse, error) {
-	rsp, err := c.ShowProjectTemplate(ctx, projectID, templateID, reqEditors...)
+// Corresponds with GET /projects/{project_id}/environments/{environment_id} (the `ShowProjectEnvironment` operationId).
+func (c *ClientWithResponses) ShowProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, reqEditors ...RequestEditorFn) (*ShowProjectEnvironmentResponse, error) {
+	rsp, err := c.ShowProjectEnvironment(ctx, projectID, environmentID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowProjectTemplateResponse(rsp)
+	return ParseShowProjectEnvironmentResponse(rsp)
 }
 
-// UpdateProjectTemplateWithBodyWithResponse Update a specific template for a project
+// UpdateProjectEnvironmentWithBodyWithResponse Update a specific environment for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id} (the `UpdateProjectTemplate` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateResponse, error) {
-	rsp, err := c.UpdateProjectTemplateWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id} (the `UpdateProjectEnvironment` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateResponse(rsp)
+	return ParseUpdateProjectEnvironmentResponse(rsp)
 }
 
-// UpdateProjectTemplateWithResponse Update a specific template for a project
+// UpdateProjectEnvironmentWithResponse Update a specific environment for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id} (the `UpdateProjectTemplate` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body UpdateProjectTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateResponse, error) {
-	rsp, err := c.UpdateProjectTemplate(ctx, projectID, templateID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id} (the `UpdateProjectEnvironment` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body UpdateProjectEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentResponse, error) {
+	rsp, err := c.UpdateProjectEnvironment(ctx, projectID, environmentID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateResponse(rsp)
+	return ParseUpdateProjectEnvironmentResponse(rsp)
 }
 
-// CreateProjectTemplateSurveyWithBodyWithResponse Create a new survey on a template
+// CreateProjectEnvironmentSecretWithBodyWithResponse Create a new secret on an environment
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates/{template_id}/surveys (the `CreateProjectTemplateSurvey` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateSurveyWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateSurveyResponse, error) {
-	rsp, err := c.CreateProjectTemplateSurveyWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments/{environment_id}/secrets (the `CreateProjectEnvironmentSecret` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentSecretWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentSecretResponse, error) {
+	rsp, err := c.CreateProjectEnvironmentSecretWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateSurveyResponse(rsp)
+	return ParseCreateProjectEnvironmentSecretResponse(rsp)
 }
 
-// CreateProjectTemplateSurveyWithResponse Create a new survey on a template
+// CreateProjectEnvironmentSecretWithResponse Create a new secret on an environment
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates/{template_id}/surveys (the `CreateProjectTemplateSurvey` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateSurveyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateSurveyResponse, error) {
-	rsp, err := c.CreateProjectTemplateSurvey(ctx, projectID, templateID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments/{environment_id}/secrets (the `CreateProjectEnvironmentSecret` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body CreateProjectEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentSecretResponse, error) {
+	rsp, err := c.CreateProjectEnvironmentSecret(ctx, projectID, environmentID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateSurveyResponse(rsp)
+	return ParseCreateProjectEnvironmentSecretResponse(rsp)
 }
 
-// DeleteProjectTemplateSurveyWithResponse Delete a survey on a template for a project
+// DeleteProjectEnvironmentSecretWithResponse Delete a secret on an environment for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `DeleteProjectTemplateSurvey` operationId).
-func (c *ClientWithResponses) DeleteProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateSurveyResponse, error) {
-	rsp, err := c.DeleteProjectTemplateSurvey(ctx, projectID, templateID, surveyID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `DeleteProjectEnvironmentSecret` operationId).
+func (c *ClientWithResponses) DeleteProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentSecretResponse, error) {
+	rsp, err := c.DeleteProjectEnvironmentSecret(ctx, projectID, environmentID, secretID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectTemplateSurveyResponse(rsp)
+	return ParseDeleteProjectEnvironmentSecretResponse(rsp)
 }
 
-// UpdateProjectTemplateSurveyWithBodyWithResponse Update a survey on a template for a project
+// UpdateProjectEnvironmentSecretWithBodyWithResponse Update a secret on an environment for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `UpdateProjectTemplateSurvey` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateSurveyWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateSurveyResponse, error) {
-	rsp, err := c.UpdateProjectTemplateSurveyWithBody(ctx, projectID, templateID, surveyID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `UpdateProjectEnvironmentSecret` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentSecretWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentSecretResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentSecretWithBody(ctx, projectID, environmentID, secretID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateSurveyResponse(rsp)
+	return ParseUpdateProjectEnvironmentSecretResponse(rsp)
 }
 
-// UpdateProjectTemplateSurveyWithResponse Update a survey on a template for a project
+// UpdateProjectEnvironmentSecretWithResponse Update a secret on an environment for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `UpdateProjectTemplateSurvey` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, body UpdateProjectTemplateSurveyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateSurveyResponse, error) {
-	rsp, err := c.UpdateProjectTemplateSurvey(ctx, projectID, templateID, surveyID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/secrets/{secret_id} (the `UpdateProjectEnvironmentSecret` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentSecretWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, secretID SecretID, body UpdateProjectEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentSecretResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentSecret(ctx, projectID, environmentID, secretID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateSurveyResponse(rsp)
+	return ParseUpdateProjectEnvironmentSecretResponse(rsp)
 }
 
-// CreateProjectTemplateVaultWithBodyWithResponse Create a new vault on a template
+// CreateProjectEnvironmentValueWithBodyWithResponse Create a new value on an environment
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates/{template_id}/vaults (the `CreateProjectTemplateVault` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateVaultWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateVaultResponse, error) {
-	rsp, err := c.CreateProjectTemplateVaultWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments/{environment_id}/values (the `CreateProjectEnvironmentValue` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentValueWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentValueResponse, error) {
+	rsp, err := c.CreateProjectEnvironmentValueWithBody(ctx, projectID, environmentID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateVaultResponse(rsp)
+	return ParseCreateProjectEnvironmentValueResponse(rsp)
 }
 
-// CreateProjectTemplateVaultWithResponse Create a new vault on a template
+// CreateProjectEnvironmentValueWithResponse Create a new value on an environment
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/templates/{template_id}/vaults (the `CreateProjectTemplateVault` operationId).
-func (c *ClientWithResponses) CreateProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateVaultJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateVaultResponse, error) {
-	rsp, err := c.CreateProjectTemplateVault(ctx, projectID, templateID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/environments/{environment_id}/values (the `CreateProjectEnvironmentValue` operationId).
+func (c *ClientWithResponses) CreateProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, body CreateProjectEnvironmentValueJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectEnvironmentValueResponse, error) {
+	rsp, err := c.CreateProjectEnvironmentValue(ctx, projectID, environmentID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateProjectTemplateVaultResponse(rsp)
+	return ParseCreateProjectEnvironmentValueResponse(rsp)
 }
 
-// DeleteProjectTemplateVaultWithResponse Delete a vault on a template for a project
+// DeleteProjectEnvironmentValueWithResponse Delete a value on an environment for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `DeleteProjectTemplateVault` operationId).
-func (c *ClientWithResponses) DeleteProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateVaultResponse, error) {
-	rsp, err := c.DeleteProjectTemplateVault(ctx, projectID, templateID, vaultID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `DeleteProjectEnvironmentValue` operationId).
+func (c *ClientWithResponses) DeleteProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, reqEditors ...RequestEditorFn) (*DeleteProjectEnvironmentValueResponse, error) {
+	rsp, err := c.DeleteProjectEnvironmentValue(ctx, projectID, environmentID, valueID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectTemplateVaultResponse(rsp)
+	return ParseDeleteProjectEnvironmentValueResponse(rsp)
 }
 
-// UpdateProjectTemplateVaultWithBodyWithResponse Update a vault on a template for a project
+// UpdateProjectEnvironmentValueWithBodyWithResponse Update a value on an environment for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `UpdateProjectTemplateVault` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateVaultWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateVaultResponse, error) {
-	rsp, err := c.UpdateProjectTemplateVaultWithBody(ctx, projectID, templateID, vaultID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `UpdateProjectEnvironmentValue` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentValueWithBodyWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentValueResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentValueWithBody(ctx, projectID, environmentID, valueID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateVaultResponse(rsp)
+	return ParseUpdateProjectEnvironmentValueResponse(rsp)
 }
 
-// UpdateProjectTemplateVaultWithResponse Update a vault on a template for a project
+// UpdateProjectEnvironmentValueWithResponse Update a value on an environment for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `UpdateProjectTemplateVault` operationId).
-func (c *ClientWithResponses) UpdateProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, body UpdateProjectTemplateVaultJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateVaultResponse, error) {
-	rsp, err := c.UpdateProjectTemplateVault(ctx, projectID, templateID, vaultID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/environments/{environment_id}/values/{value_id} (the `UpdateProjectEnvironmentValue` operationId).
+func (c *ClientWithResponses) UpdateProjectEnvironmentValueWithResponse(ctx context.Context, projectID ProjectID, environmentID EnvironmentID, valueID ValueID, body UpdateProjectEnvironmentValueJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectEnvironmentValueResponse, error) {
+	rsp, err := c.UpdateProjectEnvironmentValue(ctx, projectID, environmentID, valueID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProjectTemplateVaultResponse(rsp)
+	return ParseUpdateProjectEnvironmentValueResponse(rsp)
 }
 
-// DeleteProjectFromUserWithBodyWithResponse Unlink a user from project
+// ListProjectEventsWithResponse Fetch all events for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/events (the `ListProjectEvents` operationId).
+func (c *ClientWithResponses) ListProjectEventsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectEventsParams, reqEditors ...RequestEditorFn) (*ListProjectEventsResponse, error) {
+	rsp, err := c.ListProjectEvents(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectEventsResponse(rsp)
+}
+
+// ListProjectExecutionsWithResponse Fetch all executions for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/executions (the `ListProjectExecutions` operationId).
+func (c *ClientWithResponses) ListProjectExecutionsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectExecutionsParams, reqEditors ...RequestEditorFn) (*ListProjectExecutionsResponse, error) {
+	rsp, err := c.ListProjectExecutions(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectExecutionsResponse(rsp)
+}
+
+// CreateProjectExecutionWithBodyWithResponse Create a new execution
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/users (the `DeleteProjectFromUser` operationId).
-func (c *ClientWithResponses) DeleteProjectFromUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectFromUserResponse, error) {
-	rsp, err := c.DeleteProjectFromUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/executions (the `CreateProjectExecution` operationId).
+func (c *ClientWithResponses) CreateProjectExecutionWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectExecutionResponse, error) {
+	rsp, err := c.CreateProjectExecutionWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectFromUserResponse(rsp)
+	return ParseCreateProjectExecutionResponse(rsp)
 }
 
-// DeleteProjectFromUserWithResponse Unlink a user from project
+// CreateProjectExecutionWithResponse Create a new execution
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /projects/{project_id}/users (the `DeleteProjectFromUser` operationId).
-func (c *ClientWithResponses) DeleteProjectFromUserWithResponse(ctx context.Context, projectID ProjectID, body DeleteProjectFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectFromUserResponse, error) {
-	rsp, err := c.DeleteProjectFromUser(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/executions (the `CreateProjectExecution` operationId).
+func (c *ClientWithResponses) CreateProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectExecutionResponse, error) {
+	rsp, err := c.CreateProjectExecution(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProjectFromUserResponse(rsp)
+	return ParseCreateProjectExecutionResponse(rsp)
 }
 
-// ListProjectUsersWithResponse Fetch all users attached to project
+// DeleteProjectExecutionWithResponse Delete a specific execution for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /projects/{project_id}/users (the `ListProjectUsers` operationId).
-func (c *ClientWithResponses) ListProjectUsersWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectUsersParams, reqEditors ...RequestEditorFn) (*ListProjectUsersResponse, error) {
-	rsp, err := c.ListProjectUsers(ctx, projectID, params, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/executions/{execution_id} (the `DeleteProjectExecution` operationId).
+func (c *ClientWithResponses) DeleteProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*DeleteProjectExecutionResponse, error) {
+	rsp, err := c.DeleteProjectExecution(ctx, projectID, executionID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListProjectUsersResponse(rsp)
+	return ParseDeleteProjectExecutionResponse(rsp)
 }
 
-// AttachProjectToUserWithBodyWithResponse Attach a user to project
+// ShowProjectExecutionWithResponse Fetch a specific execution for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/executions/{execution_id} (the `ShowProjectExecution` operationId).
+func (c *ClientWithResponses) ShowProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ShowProjectExecutionResponse, error) {
+	rsp, err := c.ShowProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowProjectExecutionResponse(rsp)
+}
+
+// ApproveProjectExecutionWithResponse Approve a specific execution awaiting confirmation
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/approve (the `ApproveProjectExecution` operationId).
+func (c *ClientWithResponses) ApproveProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ApproveProjectExecutionResponse, error) {
+	rsp, err := c.ApproveProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveProjectExecutionResponse(rsp)
+}
+
+// OutputProjectExecutionWithResponse Output a specific execution for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/executions/{execution_id}/output (the `OutputProjectExecution` operationId).
+func (c *ClientWithResponses) OutputProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*OutputProjectExecutionResponse, error) {
+	rsp, err := c.OutputProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOutputProjectExecutionResponse(rsp)
+}
+
+// PurgeProjectExecutionWithResponse Purge a specific execution for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/executions/{execution_id}/purge (the `PurgeProjectExecution` operationId).
+func (c *ClientWithResponses) PurgeProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*PurgeProjectExecutionResponse, error) {
+	rsp, err := c.PurgeProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeProjectExecutionResponse(rsp)
+}
+
+// RejectProjectExecutionWithResponse Reject a specific execution awaiting confirmation
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/reject (the `RejectProjectExecution` operationId).
+func (c *ClientWithResponses) RejectProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*RejectProjectExecutionResponse, error) {
+	rsp, err := c.RejectProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectProjectExecutionResponse(rsp)
+}
+
+// RerunProjectExecutionWithResponse Rerun a specific execution with the same parameters
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/rerun (the `RerunProjectExecution` operationId).
+func (c *ClientWithResponses) RerunProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*RerunProjectExecutionResponse, error) {
+	rsp, err := c.RerunProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRerunProjectExecutionResponse(rsp)
+}
+
+// StopProjectExecutionWithResponse Stop a specific execution for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/executions/{execution_id}/stop (the `StopProjectExecution` operationId).
+func (c *ClientWithResponses) StopProjectExecutionWithResponse(ctx context.Context, projectID ProjectID, executionID ExecutionID, reqEditors ...RequestEditorFn) (*StopProjectExecutionResponse, error) {
+	rsp, err := c.StopProjectExecution(ctx, projectID, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopProjectExecutionResponse(rsp)
+}
+
+// DeleteProjectFromGroupWithBodyWithResponse Unlink a group from project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/users (the `AttachProjectToUser` operationId).
-func (c *ClientWithResponses) AttachProjectToUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachProjectToUserResponse, error) {
-	rsp, err := c.AttachProjectToUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/groups (the `DeleteProjectFromGroup` operationId).
+func (c *ClientWithResponses) DeleteProjectFromGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectFromGroupResponse, error) {
+	rsp, err := c.DeleteProjectFromGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachProjectToUserResponse(rsp)
+	return ParseDeleteProjectFromGroupResponse(rsp)
 }
 
-// AttachProjectToUserWithResponse Attach a user to project
+// DeleteProjectFromGroupWithResponse Unlink a group from project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /projects/{project_id}/users (the `AttachProjectToUser` operationId).
-func (c *ClientWithResponses) AttachProjectToUserWithResponse(ctx context.Context, projectID ProjectID, body AttachProjectToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachProjectToUserResponse, error) {
-	rsp, err := c.AttachProjectToUser(ctx, projectID, body, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/groups (the `DeleteProjectFromGroup` operationId).
+func (c *ClientWithResponses) DeleteProjectFromGroupWithResponse(ctx context.Context, projectID ProjectID, body DeleteProjectFromGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectFromGroupResponse, error) {
+	rsp, err := c.DeleteProjectFromGroup(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachProjectToUserResponse(rsp)
+	return ParseDeleteProjectFromGroupResponse(rsp)
 }
 
-// PermitProjectUserWithBodyWithResponse Update user perms for project
+// ListProjectGroupsWithResponse Fetch all groups attached to project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/groups (the `ListProjectGroups` operationId).
+func (c *ClientWithResponses) ListProjectGroupsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectGroupsParams, reqEditors ...RequestEditorFn) (*ListProjectGroupsResponse, error) {
+	rsp, err := c.ListProjectGroups(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectGroupsResponse(rsp)
+}
+
+// AttachProjectToGroupWithBodyWithResponse Attach a group to project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
-func (c *ClientWithResponses) PermitProjectUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitProjectUserResponse, error) {
-	rsp, err := c.PermitProjectUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/groups (the `AttachProjectToGroup` operationId).
+func (c *ClientWithResponses) AttachProjectToGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachProjectToGroupResponse, error) {
+	rsp, err := c.AttachProjectToGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePermitProjectUserResponse(rsp)
+	return ParseAttachProjectToGroupResponse(rsp)
 }
 
-// PermitProjectUserWithResponse Update user perms for project
+// AttachProjectToGroupWithResponse Attach a group to project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
-func (c *ClientWithResponses) PermitProjectUserWithResponse(ctx context.Context, projectID ProjectID, body PermitProjectUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitProjectUserResponse, error) {
-	rsp, err := c.PermitProjectUser(ctx, projectID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/groups (the `AttachProjectToGroup` operationId).
+func (c *ClientWithResponses) AttachProjectToGroupWithResponse(ctx context.Context, projectID ProjectID, body AttachProjectToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachProjectToGroupResponse, error) {
+	rsp, err := c.AttachProjectToGroup(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePermitProjectUserResponse(rsp)
+	return ParseAttachProjectToGroupResponse(rsp)
 }
 
-// ListGlobalRunnersWithResponse Fetch all runners
+// PermitProjectGroupWithBodyWithResponse Update group perms for project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/groups (the `PermitProjectGroup` operationId).
+func (c *ClientWithResponses) PermitProjectGroupWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitProjectGroupResponse, error) {
+	rsp, err := c.PermitProjectGroupWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitProjectGroupResponse(rsp)
+}
+
+// PermitProjectGroupWithResponse Update group perms for project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/groups (the `PermitProjectGroup` operationId).
+func (c *ClientWithResponses) PermitProjectGroupWithResponse(ctx context.Context, projectID ProjectID, body PermitProjectGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitProjectGroupResponse, error) {
+	rsp, err := c.PermitProjectGroup(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitProjectGroupResponse(rsp)
+}
+
+// ListProjectInventoriesWithResponse Fetch all inventories for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /runners (the `ListGlobalRunners` operationId).
-func (c *ClientWithResponses) ListGlobalRunnersWithResponse(ctx context.Context, params *ListGlobalRunnersParams, reqEditors ...RequestEditorFn) (*ListGlobalRunnersResponse, error) {
-	rsp, err := c.ListGlobalRunners(ctx, params, reqEditors...)
+// Corresponds with GET /projects/{project_id}/inventories (the `ListProjectInventories` operationId).
+func (c *ClientWithResponses) ListProjectInventoriesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectInventoriesParams, reqEditors ...RequestEditorFn) (*ListProjectInventoriesResponse, error) {
+	rsp, err := c.ListProjectInventories(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListGlobalRunnersResponse(rsp)
+	return ParseListProjectInventoriesResponse(rsp)
 }
 
-// CreateGlobalRunnerWithBodyWithResponse Create a new global runner
+// CreateProjectInventoryWithBodyWithResponse Create a new inventory
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /runners (the `CreateGlobalRunner` operationId).
-func (c *ClientWithResponses) CreateGlobalRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGlobalRunnerResponse, error) {
-	rsp, err := c.CreateGlobalRunnerWithBody(ctx, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/inventories (the `CreateProjectInventory` operationId).
+func (c *ClientWithResponses) CreateProjectInventoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectInventoryResponse, error) {
+	rsp, err := c.CreateProjectInventoryWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateGlobalRunnerResponse(rsp)
+	return ParseCreateProjectInventoryResponse(rsp)
 }
 
-// CreateGlobalRunnerWithResponse Create a new global runner
+// CreateProjectInventoryWithResponse Create a new inventory
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /runners (the `CreateGlobalRunner` operationId).
-func (c *ClientWithResponses) CreateGlobalRunnerWithResponse(ctx context.Context, body CreateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGlobalRunnerResponse, error) {
-	rsp, err := c.CreateGlobalRunner(ctx, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/inventories (the `CreateProjectInventory` operationId).
+func (c *ClientWithResponses) CreateProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectInventoryResponse, error) {
+	rsp, err := c.CreateProjectInventory(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateGlobalRunnerResponse(rsp)
+	return ParseCreateProjectInventoryResponse(rsp)
 }
 
-// DeleteGlobalRunnerWithResponse Delete a specific runner
+// DeleteProjectInventoryWithResponse Delete a specific inventory for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /runners/{runner_id} (the `DeleteGlobalRunner` operationId).
-func (c *ClientWithResponses) DeleteGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*DeleteGlobalRunnerResponse, error) {
-	rsp, err := c.DeleteGlobalRunner(ctx, runnerID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/inventories/{inventory_id} (the `DeleteProjectInventory` operationId).
+func (c *ClientWithResponses) DeleteProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, reqEditors ...RequestEditorFn) (*DeleteProjectInventoryResponse, error) {
+	rsp, err := c.DeleteProjectInventory(ctx, projectID, inventoryID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteGlobalRunnerResponse(rsp)
+	return ParseDeleteProjectInventoryResponse(rsp)
 }
 
-// ShowGlobalRunnerWithResponse Fetch a specific runner
+// ShowProjectInventoryWithResponse Fetch a specific inventory for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /runners/{runner_id} (the `ShowGlobalRunner` operationId).
-func (c *ClientWithResponses) ShowGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*ShowGlobalRunnerResponse, error) {
-	rsp, err := c.ShowGlobalRunner(ctx, runnerID, reqEditors...)
+// Corresponds with GET /projects/{project_id}/inventories/{inventory_id} (the `ShowProjectInventory` operationId).
+func (c *ClientWithResponses) ShowProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, reqEditors ...RequestEditorFn) (*ShowProjectInventoryResponse, error) {
+	rsp, err := c.ShowProjectInventory(ctx, projectID, inventoryID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowGlobalRunnerResponse(rsp)
+	return ParseShowProjectInventoryResponse(rsp)
 }
 
-// UpdateGlobalRunnerWithBodyWithResponse Update a specific runner
+// UpdateProjectInventoryWithBodyWithResponse Update a specific inventory for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
-func (c *ClientWithResponses) UpdateGlobalRunnerWithBodyWithResponse(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGlobalRunnerResponse, error) {
-	rsp, err := c.UpdateGlobalRunnerWithBody(ctx, runnerID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
+func (c *ClientWithResponses) UpdateProjectInventoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectInventoryResponse, error) {
+	rsp, err := c.UpdateProjectInventoryWithBody(ctx, projectID, inventoryID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateGlobalRunnerResponse(rsp)
+	return ParseUpdateProjectInventoryResponse(rsp)
 }
 
-// UpdateGlobalRunnerWithResponse Update a specific runner
+// UpdateProjectInventoryWithResponse Update a specific inventory for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
-func (c *ClientWithResponses) UpdateGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGlobalRunnerResponse, error) {
-	rsp, err := c.UpdateGlobalRunner(ctx, runnerID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/inventories/{inventory_id} (the `UpdateProjectInventory` operationId).
+func (c *ClientWithResponses) UpdateProjectInventoryWithResponse(ctx context.Context, projectID ProjectID, inventoryID InventoryID, body UpdateProjectInventoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectInventoryResponse, error) {
+	rsp, err := c.UpdateProjectInventory(ctx, projectID, inventoryID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateGlobalRunnerResponse(rsp)
+	return ParseUpdateProjectInventoryResponse(rsp)
 }
 
-// ListUsersWithResponse Fetch all available users
+// ListProjectJoinsWithResponse Fetch all join tokens for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /users (the `ListUsers` operationId).
-func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
-	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+// Corresponds with GET /projects/{project_id}/joins (the `ListProjectJoins` operationId).
+func (c *ClientWithResponses) ListProjectJoinsWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectJoinsParams, reqEditors ...RequestEditorFn) (*ListProjectJoinsResponse, error) {
+	rsp, err := c.ListProjectJoins(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListUsersResponse(rsp)
+	return ParseListProjectJoinsResponse(rsp)
 }
 
-// CreateUserWithBodyWithResponse Create a new user
+// CreateProjectJoinWithBodyWithResponse Create a new join token
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /users (the `CreateUser` operationId).
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+func (c *ClientWithResponses) CreateProjectJoinWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectJoinResponse, error) {
+	rsp, err := c.CreateProjectJoinWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUserResponse(rsp)
+	return ParseCreateProjectJoinResponse(rsp)
 }
 
-// CreateUserWithResponse Create a new user
+// CreateProjectJoinWithResponse Create a new join token
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /users (the `CreateUser` operationId).
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/joins (the `CreateProjectJoin` operationId).
+func (c *ClientWithResponses) CreateProjectJoinWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectJoinResponse, error) {
+	rsp, err := c.CreateProjectJoin(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUserResponse(rsp)
+	return ParseCreateProjectJoinResponse(rsp)
 }
 
-// DeleteUserWithResponse Delete a specific user
+// DeleteProjectJoinWithResponse Delete a specific join token for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /users/{user_id} (the `DeleteUser` operationId).
-func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, userID UserID, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
-	rsp, err := c.DeleteUser(ctx, userID, reqEditors...)
+// Corresponds with DELETE /projects/{project_id}/joins/{join_id} (the `DeleteProjectJoin` operationId).
+func (c *ClientWithResponses) DeleteProjectJoinWithResponse(ctx context.Context, projectID ProjectID, joinID JoinID, reqEditors ...RequestEditorFn) (*DeleteProjectJoinResponse, error) {
+	rsp, err := c.DeleteProjectJoin(ctx, projectID, joinID, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserResponse(rsp)
+	return ParseDeleteProjectJoinResponse(rsp)
 }
 
-// ShowUserWithResponse Fetch a specific user
+// ListProjectRepositoriesWithResponse Fetch all repositories for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /users/{user_id} (the `ShowUser` operationId).
-func (c *ClientWithResponses) ShowUserWithResponse(ctx context.Context, userID UserID, reqEditors ...RequestEditorFn) (*ShowUserResponse, error) {
-	rsp, err := c.ShowUser(ctx, userID, reqEditors...)
+// Corresponds with GET /projects/{project_id}/repositories (the `ListProjectRepositories` operationId).
+func (c *ClientWithResponses) ListProjectRepositoriesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectRepositoriesParams, reqEditors ...RequestEditorFn) (*ListProjectRepositoriesResponse, error) {
+	rsp, err := c.ListProjectRepositories(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseShowUserResponse(rsp)
+	return ParseListProjectRepositoriesResponse(rsp)
 }
 
-// UpdateUserWithBodyWithResponse Update a specific user
+// CreateProjectRepositoryWithBodyWithResponse Create a new repository
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /users/{user_id} (the `UpdateUser` operationId).
-func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
-	rsp, err := c.UpdateUserWithBody(ctx, userID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/repositories (the `CreateProjectRepository` operationId).
+func (c *ClientWithResponses) CreateProjectRepositoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRepositoryResponse, error) {
+	rsp, err := c.CreateProjectRepositoryWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateUserResponse(rsp)
+	return ParseCreateProjectRepositoryResponse(rsp)
 }
 
-// UpdateUserWithResponse Update a specific user
+// CreateProjectRepositoryWithResponse Create a new repository
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with PUT /users/{user_id} (the `UpdateUser` operationId).
-func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, userID UserID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
-	rsp, err := c.UpdateUser(ctx, userID, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/repositories (the `CreateProjectRepository` operationId).
+func (c *ClientWithResponses) CreateProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRepositoryResponse, error) {
+	rsp, err := c.CreateProjectRepository(ctx, projectID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateUserResponse(rsp)
+	return ParseCreateProjectRepositoryResponse(rsp)
 }
 
-// DeleteUserFromGroupWithBodyWithResponse Unlink a group from user
+// DeleteProjectRepositoryWithResponse Delete a specific repository for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/repositories/{repository_id} (the `DeleteProjectRepository` operationId).
+func (c *ClientWithResponses) DeleteProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, reqEditors ...RequestEditorFn) (*DeleteProjectRepositoryResponse, error) {
+	rsp, err := c.DeleteProjectRepository(ctx, projectID, repositoryID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectRepositoryResponse(rsp)
+}
+
+// ShowProjectRepositoryWithResponse Fetch a specific repository for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/repositories/{repository_id} (the `ShowProjectRepository` operationId).
+func (c *ClientWithResponses) ShowProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, reqEditors ...RequestEditorFn) (*ShowProjectRepositoryResponse, error) {
+	rsp, err := c.ShowProjectRepository(ctx, projectID, repositoryID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowProjectRepositoryResponse(rsp)
+}
+
+// UpdateProjectRepositoryWithBodyWithResponse Update a specific repository for a project
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /users/{user_id}/groups (the `DeleteUserFromGroup` operationId).
-func (c *ClientWithResponses) DeleteUserFromGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserFromGroupResponse, error) {
-	rsp, err := c.DeleteUserFromGroupWithBody(ctx, userID, contentType, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/repositories/{repository_id} (the `UpdateProjectRepository` operationId).
+func (c *ClientWithResponses) UpdateProjectRepositoryWithBodyWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRepositoryResponse, error) {
+	rsp, err := c.UpdateProjectRepositoryWithBody(ctx, projectID, repositoryID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserFromGroupResponse(rsp)
+	return ParseUpdateProjectRepositoryResponse(rsp)
 }
 
-// DeleteUserFromGroupWithResponse Unlink a group from user
+// UpdateProjectRepositoryWithResponse Update a specific repository for a project
 //
 // Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with DELETE /users/{user_id}/groups (the `DeleteUserFromGroup` operationId).
-func (c *ClientWithResponses) DeleteUserFromGroupWithResponse(ctx context.Context, userID UserID, body DeleteUserFromGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserFromGroupResponse, error) {
-	rsp, err := c.DeleteUserFromGroup(ctx, userID, body, reqEditors...)
+// Corresponds with PUT /projects/{project_id}/repositories/{repository_id} (the `UpdateProjectRepository` operationId).
+func (c *ClientWithResponses) UpdateProjectRepositoryWithResponse(ctx context.Context, projectID ProjectID, repositoryID RepositoryID, body UpdateProjectRepositoryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRepositoryResponse, error) {
+	rsp, err := c.UpdateProjectRepository(ctx, projectID, repositoryID, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserFromGroupResponse(rsp)
+	return ParseUpdateProjectRepositoryResponse(rsp)
 }
 
-// ListUserGroupsWithResponse Fetch all groups attached to user
+// ListProjectRunnersWithResponse Fetch all runners for a project
 //
 // Returns a wrapper object for the known response body format(s).
 //
-// Corresponds with GET /users/{user_id}/groups (the `ListUserGroups` operationId).
-func (c *ClientWithResponses) ListUserGroupsWithResponse(ctx context.Context, userID UserID, params *ListUserGroupsParams, reqEditors ...RequestEditorFn) (*ListUserGroupsResponse, error) {
-	rsp, err := c.ListUserGroups(ctx, userID, params, reqEditors...)
+// Corresponds with GET /projects/{project_id}/runners (the `ListProjectRunners` operationId).
+func (c *ClientWithResponses) ListProjectRunnersWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectRunnersParams, reqEditors ...RequestEditorFn) (*ListProjectRunnersResponse, error) {
+	rsp, err := c.ListProjectRunners(ctx, projectID, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListUserGroupsResponse(rsp)
+	return ParseListProjectRunnersResponse(rsp)
 }
 
-// AttachUserToGroupWithBodyWithResponse Attach a group to user
+// CreateProjectRunnerWithBodyWithResponse Create a new runner
 //
 // Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
 //
-// Corresponds with POST /users/{user_id}/groups (the `AttachUserToGroup` operationId).
-func (c *ClientWithResponses) AttachUserToGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachUserToGroupResponse, error) {
-	rsp, err := c.AttachUserToGroupWithBody(ctx, userID, contentType, body, reqEditors...)
+// Corresponds with POST /projects/{project_id}/runners (the `CreateProjectRunner` operationId).
+func (c *ClientWithResponses) CreateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectRunnerResponse, error) {
+	rsp, err := c.CreateProjectRunnerWithBody(ctx, projectID, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachUserToGroupResponse(rsp)
+	return ParseCreateProjectRunnerResponse(rsp)
+}
+
+// CreateProjectRunnerWithResponse Create a new runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/runners (the `CreateProjectRunner` operationId).
+func (c *ClientWithResponses) CreateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectRunnerResponse, error) {
+	rsp, err := c.CreateProjectRunner(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectRunnerResponse(rsp)
+}
+
+// DeleteProjectRunnerWithResponse Delete a specific runner for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/runners/{runner_id} (the `DeleteProjectRunner` operationId).
+func (c *ClientWithResponses) DeleteProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*DeleteProjectRunnerResponse, error) {
+	rsp, err := c.DeleteProjectRunner(ctx, projectID, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectRunnerResponse(rsp)
+}
+
+// ShowProjectRunnerWithResponse Fetch a specific runner for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/runners/{runner_id} (the `ShowProjectRunner` operationId).
+func (c *ClientWithResponses) ShowProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*ShowProjectRunnerResponse, error) {
+	rsp, err := c.ShowProjectRunner(ctx, projectID, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowProjectRunnerResponse(rsp)
+}
+
+// UpdateProjectRunnerWithBodyWithResponse Update a specific runner for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
+func (c *ClientWithResponses) UpdateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectRunnerResponse, error) {
+	rsp, err := c.UpdateProjectRunnerWithBody(ctx, projectID, runnerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRunnerResponse(rsp)
+}
+
+// UpdateProjectRunnerWithResponse Update a specific runner for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/runners/{runner_id} (the `UpdateProjectRunner` operationId).
+func (c *ClientWithResponses) UpdateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, body UpdateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectRunnerResponse, error) {
+	rsp, err := c.UpdateProjectRunner(ctx, projectID, runnerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectRunnerResponse(rsp)
+}
+
+// RevokeProjectRunnerWithResponse Revoke the token of a specific runner for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/revoke (the `RevokeProjectRunner` operationId).
+func (c *ClientWithResponses) RevokeProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, reqEditors ...RequestEditorFn) (*RevokeProjectRunnerResponse, error) {
+	rsp, err := c.RevokeProjectRunner(ctx, projectID, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeProjectRunnerResponse(rsp)
+}
+
+// RotateProjectRunnerWithBodyWithResponse Rotate the token of a specific runner for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+func (c *ClientWithResponses) RotateProjectRunnerWithBodyWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RotateProjectRunnerResponse, error) {
+	rsp, err := c.RotateProjectRunnerWithBody(ctx, projectID, runnerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateProjectRunnerResponse(rsp)
 }
 
-// AttachUserToGroupWithResponse Attach a group to user
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /users/{user_id}/groups (the `AttachUserToGroup` operationId).
-func (c *ClientWithResponses) AttachUserToGroupWithResponse(ctx context.Context, userID UserID, body AttachUserToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachUserToGroupResponse, error) {
-	rsp, err := c.AttachUserToGroup(ctx, userID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseAttachUserToGroupResponse(rsp)
-}
+// RotateProjectRunnerWithResponse Rotate the token of a specific runner for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/runners/{runner_id}/rotate (the `RotateProjectRunner` operationId).
+func (c *ClientWithResponses) RotateProjectRunnerWithResponse(ctx context.Context, projectID ProjectID, runnerID RunnerID, body RotateProjectRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RotateProjectRunnerResponse, error) {
+	rsp, err := c.RotateProjectRunner(ctx, projectID, runnerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateProjectRunnerResponse(rsp)
+}
+
+// ListProjectSchedulesWithResponse Fetch all schedules for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/schedules (the `ListProjectSchedules` operationId).
+func (c *ClientWithResponses) ListProjectSchedulesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectSchedulesParams, reqEditors ...RequestEditorFn) (*ListProjectSchedulesResponse, error) {
+	rsp, err := c.ListProjectSchedules(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectSchedulesResponse(rsp)
+}
+
+// CreateProjectScheduleWithBodyWithResponse Create a new schedule
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/schedules (the `CreateProjectSchedule` operationId).
+func (c *ClientWithResponses) CreateProjectScheduleWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectScheduleResponse, error) {
+	rsp, err := c.CreateProjectScheduleWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectScheduleResponse(rsp)
+}
+
+// CreateProjectScheduleWithResponse Create a new schedule
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/schedules (the `CreateProjectSchedule` operationId).
+func (c *ClientWithResponses) CreateProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectScheduleResponse, error) {
+	rsp, err := c.CreateProjectSchedule(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectScheduleResponse(rsp)
+}
+
+// DeleteProjectScheduleWithResponse Delete a specific schedule for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/schedules/{schedule_id} (the `DeleteProjectSchedule` operationId).
+func (c *ClientWithResponses) DeleteProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, reqEditors ...RequestEditorFn) (*DeleteProjectScheduleResponse, error) {
+	rsp, err := c.DeleteProjectSchedule(ctx, projectID, scheduleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectScheduleResponse(rsp)
+}
+
+// ShowProjectScheduleWithResponse Fetch a specific schedule for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/schedules/{schedule_id} (the `ShowProjectSchedule` operationId).
+func (c *ClientWithResponses) ShowProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ShowProjectScheduleParams, reqEditors ...RequestEditorFn) (*ShowProjectScheduleResponse, error) {
+	rsp, err := c.ShowProjectSchedule(ctx, projectID, scheduleID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowProjectScheduleResponse(rsp)
+}
+
+// UpdateProjectScheduleWithBodyWithResponse Update a specific schedule for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
+func (c *ClientWithResponses) UpdateProjectScheduleWithBodyWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectScheduleResponse, error) {
+	rsp, err := c.UpdateProjectScheduleWithBody(ctx, projectID, scheduleID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectScheduleResponse(rsp)
+}
+
+// UpdateProjectScheduleWithResponse Update a specific schedule for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/schedules/{schedule_id} (the `UpdateProjectSchedule` operationId).
+func (c *ClientWithResponses) UpdateProjectScheduleWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, body UpdateProjectScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectScheduleResponse, error) {
+	rsp, err := c.UpdateProjectSchedule(ctx, projectID, scheduleID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectScheduleResponse(rsp)
+}
+
+// ListProjectScheduleRunsWithResponse Fetch the run history of a schedule for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/schedules/{schedule_id}/runs (the `ListProjectScheduleRuns` operationId).
+func (c *ClientWithResponses) ListProjectScheduleRunsWithResponse(ctx context.Context, projectID ProjectID, scheduleID ScheduleID, params *ListProjectScheduleRunsParams, reqEditors ...RequestEditorFn) (*ListProjectScheduleRunsResponse, error) {
+	rsp, err := c.ListProjectScheduleRuns(ctx, projectID, scheduleID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectScheduleRunsResponse(rsp)
+}
+
+// ListProjectTemplatesWithResponse Fetch all templates for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/templates (the `ListProjectTemplates` operationId).
+func (c *ClientWithResponses) ListProjectTemplatesWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectTemplatesParams, reqEditors ...RequestEditorFn) (*ListProjectTemplatesResponse, error) {
+	rsp, err := c.ListProjectTemplates(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectTemplatesResponse(rsp)
+}
+
+// CreateProjectTemplateWithBodyWithResponse Create a new template
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates (the `CreateProjectTemplate` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateResponse, error) {
+	rsp, err := c.CreateProjectTemplateWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateResponse(rsp)
+}
+
+// CreateProjectTemplateWithResponse Create a new template
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates (the `CreateProjectTemplate` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, body CreateProjectTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateResponse, error) {
+	rsp, err := c.CreateProjectTemplate(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateResponse(rsp)
+}
+
+// DeleteProjectTemplateWithResponse Delete a specific template for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/templates/{template_id} (the `DeleteProjectTemplate` operationId).
+func (c *ClientWithResponses) DeleteProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateResponse, error) {
+	rsp, err := c.DeleteProjectTemplate(ctx, projectID, templateID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectTemplateResponse(rsp)
+}
+
+// ShowProjectTemplateWithResponse Fetch a specific template for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/templates/{template_id} (the `ShowProjectTemplate` operationId).
+func (c *ClientWithResponses) ShowProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, reqEditors ...RequestEditorFn) (*ShowProjectTemplateResponse, error) {
+	rsp, err := c.ShowProjectTemplate(ctx, projectID, templateID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowProjectTemplateResponse(rsp)
+}
+
+// UpdateProjectTemplateWithBodyWithResponse Update a specific template for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id} (the `UpdateProjectTemplate` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateResponse, error) {
+	rsp, err := c.UpdateProjectTemplateWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateResponse(rsp)
+}
+
+// UpdateProjectTemplateWithResponse Update a specific template for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id} (the `UpdateProjectTemplate` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body UpdateProjectTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateResponse, error) {
+	rsp, err := c.UpdateProjectTemplate(ctx, projectID, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateResponse(rsp)
+}
+
+// CreateProjectTemplateSurveyWithBodyWithResponse Create a new survey on a template
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates/{template_id}/surveys (the `CreateProjectTemplateSurvey` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateSurveyWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateSurveyResponse, error) {
+	rsp, err := c.CreateProjectTemplateSurveyWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateSurveyResponse(rsp)
+}
+
+// CreateProjectTemplateSurveyWithResponse Create a new survey on a template
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates/{template_id}/surveys (the `CreateProjectTemplateSurvey` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateSurveyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateSurveyResponse, error) {
+	rsp, err := c.CreateProjectTemplateSurvey(ctx, projectID, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateSurveyResponse(rsp)
+}
+
+// DeleteProjectTemplateSurveyWithResponse Delete a survey on a template for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `DeleteProjectTemplateSurvey` operationId).
+func (c *ClientWithResponses) DeleteProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateSurveyResponse, error) {
+	rsp, err := c.DeleteProjectTemplateSurvey(ctx, projectID, templateID, surveyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectTemplateSurveyResponse(rsp)
+}
+
+// UpdateProjectTemplateSurveyWithBodyWithResponse Update a survey on a template for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `UpdateProjectTemplateSurvey` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateSurveyWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateSurveyResponse, error) {
+	rsp, err := c.UpdateProjectTemplateSurveyWithBody(ctx, projectID, templateID, surveyID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateSurveyResponse(rsp)
+}
+
+// UpdateProjectTemplateSurveyWithResponse Update a survey on a template for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id}/surveys/{survey_id} (the `UpdateProjectTemplateSurvey` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateSurveyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, surveyID SurveyID, body UpdateProjectTemplateSurveyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateSurveyResponse, error) {
+	rsp, err := c.UpdateProjectTemplateSurvey(ctx, projectID, templateID, surveyID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateSurveyResponse(rsp)
+}
+
+// CreateProjectTemplateVaultWithBodyWithResponse Create a new vault on a template
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates/{template_id}/vaults (the `CreateProjectTemplateVault` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateVaultWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateProjectTemplateVaultResponse, error) {
+	rsp, err := c.CreateProjectTemplateVaultWithBody(ctx, projectID, templateID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateVaultResponse(rsp)
+}
+
+// CreateProjectTemplateVaultWithResponse Create a new vault on a template
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/templates/{template_id}/vaults (the `CreateProjectTemplateVault` operationId).
+func (c *ClientWithResponses) CreateProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, body CreateProjectTemplateVaultJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateProjectTemplateVaultResponse, error) {
+	rsp, err := c.CreateProjectTemplateVault(ctx, projectID, templateID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateProjectTemplateVaultResponse(rsp)
+}
+
+// DeleteProjectTemplateVaultWithResponse Delete a vault on a template for a project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `DeleteProjectTemplateVault` operationId).
+func (c *ClientWithResponses) DeleteProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, reqEditors ...RequestEditorFn) (*DeleteProjectTemplateVaultResponse, error) {
+	rsp, err := c.DeleteProjectTemplateVault(ctx, projectID, templateID, vaultID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectTemplateVaultResponse(rsp)
+}
+
+// UpdateProjectTemplateVaultWithBodyWithResponse Update a vault on a template for a project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `UpdateProjectTemplateVault` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateVaultWithBodyWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateVaultResponse, error) {
+	rsp, err := c.UpdateProjectTemplateVaultWithBody(ctx, projectID, templateID, vaultID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateVaultResponse(rsp)
+}
+
+// UpdateProjectTemplateVaultWithResponse Update a vault on a template for a project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/templates/{template_id}/vaults/{vault_id} (the `UpdateProjectTemplateVault` operationId).
+func (c *ClientWithResponses) UpdateProjectTemplateVaultWithResponse(ctx context.Context, projectID ProjectID, templateID TemplateID, vaultID VaultID, body UpdateProjectTemplateVaultJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTemplateVaultResponse, error) {
+	rsp, err := c.UpdateProjectTemplateVault(ctx, projectID, templateID, vaultID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTemplateVaultResponse(rsp)
+}
+
+// DeleteProjectFromUserWithBodyWithResponse Unlink a user from project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/users (the `DeleteProjectFromUser` operationId).
+func (c *ClientWithResponses) DeleteProjectFromUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteProjectFromUserResponse, error) {
+	rsp, err := c.DeleteProjectFromUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectFromUserResponse(rsp)
+}
+
+// DeleteProjectFromUserWithResponse Unlink a user from project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /projects/{project_id}/users (the `DeleteProjectFromUser` operationId).
+func (c *ClientWithResponses) DeleteProjectFromUserWithResponse(ctx context.Context, projectID ProjectID, body DeleteProjectFromUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteProjectFromUserResponse, error) {
+	rsp, err := c.DeleteProjectFromUser(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProjectFromUserResponse(rsp)
+}
+
+// ListProjectUsersWithResponse Fetch all users attached to project
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /projects/{project_id}/users (the `ListProjectUsers` operationId).
+func (c *ClientWithResponses) ListProjectUsersWithResponse(ctx context.Context, projectID ProjectID, params *ListProjectUsersParams, reqEditors ...RequestEditorFn) (*ListProjectUsersResponse, error) {
+	rsp, err := c.ListProjectUsers(ctx, projectID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListProjectUsersResponse(rsp)
+}
+
+// AttachProjectToUserWithBodyWithResponse Attach a user to project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/users (the `AttachProjectToUser` operationId).
+func (c *ClientWithResponses) AttachProjectToUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachProjectToUserResponse, error) {
+	rsp, err := c.AttachProjectToUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachProjectToUserResponse(rsp)
+}
+
+// AttachProjectToUserWithResponse Attach a user to project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /projects/{project_id}/users (the `AttachProjectToUser` operationId).
+func (c *ClientWithResponses) AttachProjectToUserWithResponse(ctx context.Context, projectID ProjectID, body AttachProjectToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachProjectToUserResponse, error) {
+	rsp, err := c.AttachProjectToUser(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachProjectToUserResponse(rsp)
+}
+
+// PermitProjectUserWithBodyWithResponse Update user perms for project
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
+func (c *ClientWithResponses) PermitProjectUserWithBodyWithResponse(ctx context.Context, projectID ProjectID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitProjectUserResponse, error) {
+	rsp, err := c.PermitProjectUserWithBody(ctx, projectID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitProjectUserResponse(rsp)
+}
+
+// PermitProjectUserWithResponse Update user perms for project
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /projects/{project_id}/users (the `PermitProjectUser` operationId).
+func (c *ClientWithResponses) PermitProjectUserWithResponse(ctx context.Context, projectID ProjectID, body PermitProjectUserJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitProjectUserResponse, error) {
+	rsp, err := c.PermitProjectUser(ctx, projectID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitProjectUserResponse(rsp)
+}
+
+// ClaimRunnerExecutionWithResponse Claim the next waiting execution
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/executions (the `ClaimRunnerExecution` operationId).
+func (c *ClientWithResponses) ClaimRunnerExecutionWithResponse(ctx context.Context, params *ClaimRunnerExecutionParams, reqEditors ...RequestEditorFn) (*ClaimRunnerExecutionResponse, error) {
+	rsp, err := c.ClaimRunnerExecution(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimRunnerExecutionResponse(rsp)
+}
+
+// ShowRunnerExecutionWithResponse Fetch a specific claimed execution
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /runner/executions/{execution_id} (the `ShowRunnerExecution` operationId).
+func (c *ClientWithResponses) ShowRunnerExecutionWithResponse(ctx context.Context, executionID ExecutionID, reqEditors ...RequestEditorFn) (*ShowRunnerExecutionResponse, error) {
+	rsp, err := c.ShowRunnerExecution(ctx, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowRunnerExecutionResponse(rsp)
+}
+
+// UpdateRunnerExecutionWithBodyWithResponse Update the status of a claimed execution
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+func (c *ClientWithResponses) UpdateRunnerExecutionWithBodyWithResponse(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRunnerExecutionResponse, error) {
+	rsp, err := c.UpdateRunnerExecutionWithBody(ctx, executionID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRunnerExecutionResponse(rsp)
+}
+
+// UpdateRunnerExecutionWithResponse Update the status of a claimed execution
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /runner/executions/{execution_id} (the `UpdateRunnerExecution` operationId).
+func (c *ClientWithResponses) UpdateRunnerExecutionWithResponse(ctx context.Context, executionID ExecutionID, body UpdateRunnerExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRunnerExecutionResponse, error) {
+	rsp, err := c.UpdateRunnerExecution(ctx, executionID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRunnerExecutionResponse(rsp)
+}
+
+// CreateRunnerOutputWithBodyWithResponse Append output to a claimed execution
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+func (c *ClientWithResponses) CreateRunnerOutputWithBodyWithResponse(ctx context.Context, executionID ExecutionID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRunnerOutputResponse, error) {
+	rsp, err := c.CreateRunnerOutputWithBody(ctx, executionID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRunnerOutputResponse(rsp)
+}
+
+// CreateRunnerOutputWithResponse Append output to a claimed execution
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/executions/{execution_id}/output (the `CreateRunnerOutput` operationId).
+func (c *ClientWithResponses) CreateRunnerOutputWithResponse(ctx context.Context, executionID ExecutionID, body CreateRunnerOutputJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRunnerOutputResponse, error) {
+	rsp, err := c.CreateRunnerOutput(ctx, executionID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRunnerOutputResponse(rsp)
+}
+
+// HeartbeatRunnerWithResponse Send a heartbeat for the authenticated runner
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/heartbeat (the `HeartbeatRunner` operationId).
+func (c *ClientWithResponses) HeartbeatRunnerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HeartbeatRunnerResponse, error) {
+	rsp, err := c.HeartbeatRunner(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHeartbeatRunnerResponse(rsp)
+}
+
+// JoinRunnerWithBodyWithResponse Exchange a join token for a new runner
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+func (c *ClientWithResponses) JoinRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinRunnerResponse, error) {
+	rsp, err := c.JoinRunnerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinRunnerResponse(rsp)
+}
+
+// JoinRunnerWithResponse Exchange a join token for a new runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/join (the `JoinRunner` operationId).
+func (c *ClientWithResponses) JoinRunnerWithResponse(ctx context.Context, body JoinRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinRunnerResponse, error) {
+	rsp, err := c.JoinRunner(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinRunnerResponse(rsp)
+}
+
+// RegisterRunnerWithBodyWithResponse Register the authenticated runner
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+func (c *ClientWithResponses) RegisterRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterRunnerResponse, error) {
+	rsp, err := c.RegisterRunnerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterRunnerResponse(rsp)
+}
+
+// RegisterRunnerWithResponse Register the authenticated runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runner/register (the `RegisterRunner` operationId).
+func (c *ClientWithResponses) RegisterRunnerWithResponse(ctx context.Context, body RegisterRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterRunnerResponse, error) {
+	rsp, err := c.RegisterRunner(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterRunnerResponse(rsp)
+}
+
+// ListGlobalRunnersWithResponse Fetch all runners
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /runners (the `ListGlobalRunners` operationId).
+func (c *ClientWithResponses) ListGlobalRunnersWithResponse(ctx context.Context, params *ListGlobalRunnersParams, reqEditors ...RequestEditorFn) (*ListGlobalRunnersResponse, error) {
+	rsp, err := c.ListGlobalRunners(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGlobalRunnersResponse(rsp)
+}
+
+// CreateGlobalRunnerWithBodyWithResponse Create a new global runner
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runners (the `CreateGlobalRunner` operationId).
+func (c *ClientWithResponses) CreateGlobalRunnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateGlobalRunnerResponse, error) {
+	rsp, err := c.CreateGlobalRunnerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGlobalRunnerResponse(rsp)
+}
+
+// CreateGlobalRunnerWithResponse Create a new global runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runners (the `CreateGlobalRunner` operationId).
+func (c *ClientWithResponses) CreateGlobalRunnerWithResponse(ctx context.Context, body CreateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateGlobalRunnerResponse, error) {
+	rsp, err := c.CreateGlobalRunner(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateGlobalRunnerResponse(rsp)
+}
+
+// DeleteGlobalRunnerWithResponse Delete a specific runner
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /runners/{runner_id} (the `DeleteGlobalRunner` operationId).
+func (c *ClientWithResponses) DeleteGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*DeleteGlobalRunnerResponse, error) {
+	rsp, err := c.DeleteGlobalRunner(ctx, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteGlobalRunnerResponse(rsp)
+}
+
+// ShowGlobalRunnerWithResponse Fetch a specific runner
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /runners/{runner_id} (the `ShowGlobalRunner` operationId).
+func (c *ClientWithResponses) ShowGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*ShowGlobalRunnerResponse, error) {
+	rsp, err := c.ShowGlobalRunner(ctx, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowGlobalRunnerResponse(rsp)
+}
+
+// UpdateGlobalRunnerWithBodyWithResponse Update a specific runner
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
+func (c *ClientWithResponses) UpdateGlobalRunnerWithBodyWithResponse(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGlobalRunnerResponse, error) {
+	rsp, err := c.UpdateGlobalRunnerWithBody(ctx, runnerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGlobalRunnerResponse(rsp)
+}
+
+// UpdateGlobalRunnerWithResponse Update a specific runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /runners/{runner_id} (the `UpdateGlobalRunner` operationId).
+func (c *ClientWithResponses) UpdateGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, body UpdateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGlobalRunnerResponse, error) {
+	rsp, err := c.UpdateGlobalRunner(ctx, runnerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGlobalRunnerResponse(rsp)
+}
+
+// RevokeGlobalRunnerWithResponse Revoke the token of a specific runner
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runners/{runner_id}/revoke (the `RevokeGlobalRunner` operationId).
+func (c *ClientWithResponses) RevokeGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, reqEditors ...RequestEditorFn) (*RevokeGlobalRunnerResponse, error) {
+	rsp, err := c.RevokeGlobalRunner(ctx, runnerID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeGlobalRunnerResponse(rsp)
+}
+
+// RotateGlobalRunnerWithBodyWithResponse Rotate the token of a specific runner
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+func (c *ClientWithResponses) RotateGlobalRunnerWithBodyWithResponse(ctx context.Context, runnerID RunnerID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RotateGlobalRunnerResponse, error) {
+	rsp, err := c.RotateGlobalRunnerWithBody(ctx, runnerID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateGlobalRunnerResponse(rsp)
+}
+
+// RotateGlobalRunnerWithResponse Rotate the token of a specific runner
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /runners/{runner_id}/rotate (the `RotateGlobalRunner` operationId).
+func (c *ClientWithResponses) RotateGlobalRunnerWithResponse(ctx context.Context, runnerID RunnerID, body RotateGlobalRunnerJSONRequestBody, reqEditors ...RequestEditorFn) (*RotateGlobalRunnerResponse, error) {
+	rsp, err := c.RotateGlobalRunner(ctx, runnerID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRotateGlobalRunnerResponse(rsp)
+}
+
+// ListUsersWithResponse Fetch all available users
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /users (the `ListUsers` operationId).
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
+}
+
+// CreateUserWithBodyWithResponse Create a new user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users (the `CreateUser` operationId).
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// CreateUserWithResponse Create a new user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users (the `CreateUser` operationId).
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// DeleteUserWithResponse Delete a specific user
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /users/{user_id} (the `DeleteUser` operationId).
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, userID UserID, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+// ShowUserWithResponse Fetch a specific user
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /users/{user_id} (the `ShowUser` operationId).
+func (c *ClientWithResponses) ShowUserWithResponse(ctx context.Context, userID UserID, reqEditors ...RequestEditorFn) (*ShowUserResponse, error) {
+	rsp, err := c.ShowUser(ctx, userID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShowUserResponse(rsp)
+}
+
+// UpdateUserWithBodyWithResponse Update a specific user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id} (the `UpdateUser` operationId).
+func (c *ClientWithResponses) UpdateUserWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUserWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+// UpdateUserWithResponse Update a specific user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id} (the `UpdateUser` operationId).
+func (c *ClientWithResponses) UpdateUserWithResponse(ctx context.Context, userID UserID, body UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserResponse, error) {
+	rsp, err := c.UpdateUser(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserResponse(rsp)
+}
+
+// DeleteUserFromGroupWithBodyWithResponse Unlink a group from user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /users/{user_id}/groups (the `DeleteUserFromGroup` operationId).
+func (c *ClientWithResponses) DeleteUserFromGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserFromGroupResponse, error) {
+	rsp, err := c.DeleteUserFromGroupWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserFromGroupResponse(rsp)
+}
+
+// DeleteUserFromGroupWithResponse Unlink a group from user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /users/{user_id}/groups (the `DeleteUserFromGroup` operationId).
+func (c *ClientWithResponses) DeleteUserFromGroupWithResponse(ctx context.Context, userID UserID, body DeleteUserFromGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserFromGroupResponse, error) {
+	rsp, err := c.DeleteUserFromGroup(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserFromGroupResponse(rsp)
+}
+
+// ListUserGroupsWithResponse Fetch all groups attached to user
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /users/{user_id}/groups (the `ListUserGroups` operationId).
+func (c *ClientWithResponses) ListUserGroupsWithResponse(ctx context.Context, userID UserID, params *ListUserGroupsParams, reqEditors ...RequestEditorFn) (*ListUserGroupsResponse, error) {
+	rsp, err := c.ListUserGroups(ctx, userID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserGroupsResponse(rsp)
+}
+
+// AttachUserToGroupWithBodyWithResponse Attach a group to user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/{user_id}/groups (the `AttachUserToGroup` operationId).
+func (c *ClientWithResponses) AttachUserToGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachUserToGroupResponse, error) {
+	rsp, err := c.AttachUserToGroupWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachUserToGroupResponse(rsp)
+}
+
+// AttachUserToGroupWithResponse Attach a group to user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/{user_id}/groups (the `AttachUserToGroup` operationId).
+func (c *ClientWithResponses) AttachUserToGroupWithResponse(ctx context.Context, userID UserID, body AttachUserToGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachUserToGroupResponse, error) {
+	rsp, err := c.AttachUserToGroup(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachUserToGroupResponse(rsp)
+}
+
+// PermitUserGroupWithBodyWithResponse Update group perms for user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id}/groups (the `PermitUserGroup` operationId).
+func (c *ClientWithResponses) PermitUserGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitUserGroupResponse, error) {
+	rsp, err := c.PermitUserGroupWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitUserGroupResponse(rsp)
+}
+
+// PermitUserGroupWithResponse Update group perms for user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id}/groups (the `PermitUserGroup` operationId).
+func (c *ClientWithResponses) PermitUserGroupWithResponse(ctx context.Context, userID UserID, body PermitUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitUserGroupResponse, error) {
+	rsp, err := c.PermitUserGroup(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitUserGroupResponse(rsp)
+}
+
+// DeleteUserFromProjectWithBodyWithResponse Unlink a project from user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /users/{user_id}/projects (the `DeleteUserFromProject` operationId).
+func (c *ClientWithResponses) DeleteUserFromProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserFromProjectResponse, error) {
+	rsp, err := c.DeleteUserFromProjectWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserFromProjectResponse(rsp)
+}
+
+// DeleteUserFromProjectWithResponse Unlink a project from user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with DELETE /users/{user_id}/projects (the `DeleteUserFromProject` operationId).
+func (c *ClientWithResponses) DeleteUserFromProjectWithResponse(ctx context.Context, userID UserID, body DeleteUserFromProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserFromProjectResponse, error) {
+	rsp, err := c.DeleteUserFromProject(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserFromProjectResponse(rsp)
+}
+
+// ListUserProjectsWithResponse Fetch all projects attached to user
+//
+// Returns a wrapper object for the known response body format(s).
+//
+// Corresponds with GET /users/{user_id}/projects (the `ListUserProjects` operationId).
+func (c *ClientWithResponses) ListUserProjectsWithResponse(ctx context.Context, userID UserID, params *ListUserProjectsParams, reqEditors ...RequestEditorFn) (*ListUserProjectsResponse, error) {
+	rsp, err := c.ListUserProjects(ctx, userID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserProjectsResponse(rsp)
+}
+
+// AttachUserToProjectWithBodyWithResponse Attach a project to user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/{user_id}/projects (the `AttachUserToProject` operationId).
+func (c *ClientWithResponses) AttachUserToProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachUserToProjectResponse, error) {
+	rsp, err := c.AttachUserToProjectWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachUserToProjectResponse(rsp)
+}
+
+// AttachUserToProjectWithResponse Attach a project to user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with POST /users/{user_id}/projects (the `AttachUserToProject` operationId).
+func (c *ClientWithResponses) AttachUserToProjectWithResponse(ctx context.Context, userID UserID, body AttachUserToProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachUserToProjectResponse, error) {
+	rsp, err := c.AttachUserToProject(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachUserToProjectResponse(rsp)
+}
+
+// PermitUserProjectWithBodyWithResponse Update project perms for user
+//
+// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id}/projects (the `PermitUserProject` operationId).
+func (c *ClientWithResponses) PermitUserProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitUserProjectResponse, error) {
+	rsp, err := c.PermitUserProjectWithBody(ctx, userID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitUserProjectResponse(rsp)
+}
+
+// PermitUserProjectWithResponse Update project perms for user
+//
+// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
+//
+// Corresponds with PUT /users/{user_id}/projects (the `PermitUserProject` operationId).
+func (c *ClientWithResponses) PermitUserProjectWithResponse(ctx context.Context, userID UserID, body PermitUserProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitUserProjectResponse, error) {
+	rsp, err := c.PermitUserProject(ctx, userID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePermitUserProjectResponse(rsp)
+}
+
+// ParseLoginAuthResponse parses an HTTP response from a LoginAuthWithResponse call
+func ParseLoginAuthResponse(rsp *http.Response) (*LoginAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest BadCredentialsError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProvidersResponse parses an HTTP response from a ListProvidersWithResponse call
+func ParseListProvidersResponse(rsp *http.Response) (*ListProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProvidersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedirectAuthResponse parses an HTTP response from a RedirectAuthWithResponse call
+func ParseRedirectAuthResponse(rsp *http.Response) (*RedirectAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedirectAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest InvalidTokenError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshAuthResponse parses an HTTP response from a RefreshAuthWithResponse call
+func ParseRefreshAuthResponse(rsp *http.Response) (*RefreshAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest InvalidTokenError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyAuthResponse parses an HTTP response from a VerifyAuthWithResponse call
+func ParseVerifyAuthResponse(rsp *http.Response) (*VerifyAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest InvalidTokenError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCallbackProviderResponse parses an HTTP response from a CallbackProviderWithResponse call
+func ParseCallbackProviderResponse(rsp *http.Response) (*CallbackProviderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CallbackProviderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRequestProviderResponse parses an HTTP response from a RequestProviderWithResponse call
+func ParseRequestProviderResponse(rsp *http.Response) (*RequestProviderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestProviderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListGlobalEventsResponse parses an HTTP response from a ListGlobalEventsWithResponse call
+func ParseListGlobalEventsResponse(rsp *http.Response) (*ListGlobalEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGlobalEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GlobalEventsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGroupsResponse parses an HTTP response from a ListGroupsWithResponse call
+func ParseListGroupsResponse(rsp *http.Response) (*ListGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateGroupResponse parses an HTTP response from a CreateGroupWithResponse call
+func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteGroupResponse parses an HTTP response from a DeleteGroupWithResponse call
+func ParseDeleteGroupResponse(rsp *http.Response) (*DeleteGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseShowGroupResponse parses an HTTP response from a ShowGroupWithResponse call
+func ParseShowGroupResponse(rsp *http.Response) (*ShowGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ShowGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateGroupResponse parses an HTTP response from a UpdateGroupWithResponse call
+func ParseUpdateGroupResponse(rsp *http.Response) (*UpdateGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteGroupFromProjectResponse parses an HTTP response from a DeleteGroupFromProjectWithResponse call
+func ParseDeleteGroupFromProjectResponse(rsp *http.Response) (*DeleteGroupFromProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteGroupFromProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGroupProjectsResponse parses an HTTP response from a ListGroupProjectsWithResponse call
+func ParseListGroupProjectsResponse(rsp *http.Response) (*ListGroupProjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGroupProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupProjectsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAttachGroupToProjectResponse parses an HTTP response from a AttachGroupToProjectWithResponse call
+func ParseAttachGroupToProjectResponse(rsp *http.Response) (*AttachGroupToProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AttachGroupToProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest AlreadyAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePermitGroupProjectResponse parses an HTTP response from a PermitGroupProjectWithResponse call
+func ParsePermitGroupProjectResponse(rsp *http.Response) (*PermitGroupProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PermitGroupProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteGroupFromUserResponse parses an HTTP response from a DeleteGroupFromUserWithResponse call
+func ParseDeleteGroupFromUserResponse(rsp *http.Response) (*DeleteGroupFromUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteGroupFromUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// PermitUserGroupWithBodyWithResponse Update group perms for user
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /users/{user_id}/groups (the `PermitUserGroup` operationId).
-func (c *ClientWithResponses) PermitUserGroupWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitUserGroupResponse, error) {
-	rsp, err := c.PermitUserGroupWithBody(ctx, userID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePermitUserGroupResponse(rsp)
-}
 
-// PermitUserGroupWithResponse Update group perms for user
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /users/{user_id}/groups (the `PermitUserGroup` operationId).
-func (c *ClientWithResponses) PermitUserGroupWithResponse(ctx context.Context, userID UserID, body PermitUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitUserGroupResponse, error) {
-	rsp, err := c.PermitUserGroup(ctx, userID, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePermitUserGroupResponse(rsp)
+	return response, nil
 }
 
-// DeleteUserFromProjectWithBodyWithResponse Unlink a project from user
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /users/{user_id}/projects (the `DeleteUserFromProject` operationId).
-func (c *ClientWithResponses) DeleteUserFromProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteUserFromProjectResponse, error) {
-	rsp, err := c.DeleteUserFromProjectWithBody(ctx, userID, contentType, body, reqEditors...)
+// ParseListGroupUsersResponse parses an HTTP response from a ListGroupUsersWithResponse call
+func ParseListGroupUsersResponse(rsp *http.Response) (*ListGroupUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteUserFromProjectResponse(rsp)
-}
 
-// DeleteUserFromProjectWithResponse Unlink a project from user
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with DELETE /users/{user_id}/projects (the `DeleteUserFromProject` operationId).
-func (c *ClientWithResponses) DeleteUserFromProjectWithResponse(ctx context.Context, userID UserID, body DeleteUserFromProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteUserFromProjectResponse, error) {
-	rsp, err := c.DeleteUserFromProject(ctx, userID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListGroupUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteUserFromProjectResponse(rsp)
-}
 
-// ListUserProjectsWithResponse Fetch all projects attached to user
-//
-// Returns a wrapper object for the known response body format(s).
-//
-// Corresponds with GET /users/{user_id}/projects (the `ListUserProjects` operationId).
-func (c *ClientWithResponses) ListUserProjectsWithResponse(ctx context.Context, userID UserID, params *ListUserProjectsParams, reqEditors ...RequestEditorFn) (*ListUserProjectsResponse, error) {
-	rsp, err := c.ListUserProjects(ctx, userID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListUserProjectsResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// AttachUserToProjectWithBodyWithResponse Attach a project to user
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /users/{user_id}/projects (the `AttachUserToProject` operationId).
-func (c *ClientWithResponses) AttachUserToProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AttachUserToProjectResponse, error) {
-	rsp, err := c.AttachUserToProjectWithBody(ctx, userID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseAttachUserToProjectResponse(rsp)
+
+	return response, nil
 }
 
-// AttachUserToProjectWithResponse Attach a project to user
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with POST /users/{user_id}/projects (the `AttachUserToProject` operationId).
-func (c *ClientWithResponses) AttachUserToProjectWithResponse(ctx context.Context, userID UserID, body AttachUserToProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*AttachUserToProjectResponse, error) {
-	rsp, err := c.AttachUserToProject(ctx, userID, body, reqEditors...)
+// ParseAttachGroupToUserResponse parses an HTTP response from a AttachGroupToUserWithResponse call
+func ParseAttachGroupToUserResponse(rsp *http.Response) (*AttachGroupToUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAttachUserToProjectResponse(rsp)
-}
 
-// PermitUserProjectWithBodyWithResponse Update project perms for user
-//
-// Takes any type of body and a specified content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /users/{user_id}/projects (the `PermitUserProject` operationId).
-func (c *ClientWithResponses) PermitUserProjectWithBodyWithResponse(ctx context.Context, userID UserID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PermitUserProjectResponse, error) {
-	rsp, err := c.PermitUserProjectWithBody(ctx, userID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AttachGroupToUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePermitUserProjectResponse(rsp)
-}
 
-// PermitUserProjectWithResponse Update project perms for user
-//
-// Takes a body of the `application/json` content type, and returns a wrapper object for the known response body format(s).
-//
-// Corresponds with PUT /users/{user_id}/projects (the `PermitUserProject` operationId).
-func (c *ClientWithResponses) PermitUserProjectWithResponse(ctx context.Context, userID UserID, body PermitUserProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*PermitUserProjectResponse, error) {
-	rsp, err := c.PermitUserProject(ctx, userID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest AlreadyAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParsePermitUserProjectResponse(rsp)
+
+	return response, nil
 }
 
-// ParseLoginAuthResponse parses an HTTP response from a LoginAuthWithResponse call
-func ParseLoginAuthResponse(rsp *http.Response) (*LoginAuthResponse, error) {
+// ParsePermitGroupUserResponse parses an HTTP response from a PermitGroupUserWithResponse call
+func ParsePermitGroupUserResponse(rsp *http.Response) (*PermitGroupUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginAuthResponse{
+	response := &PermitGroupUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -23896,12 +29148,33 @@ func ParseLoginAuthResponse(rsp *http.Response) (*LoginAuthResponse, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest BadCredentialsError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -23915,48 +29188,62 @@ func ParseLoginAuthResponse(rsp *http.Response) (*LoginAuthResponse, error) {
 	return response, nil
 }
 
-// ParseListProvidersResponse parses an HTTP response from a ListProvidersWithResponse call
-func ParseListProvidersResponse(rsp *http.Response) (*ListProvidersResponse, error) {
+// ParseListGlobalJoinsResponse parses an HTTP response from a ListGlobalJoinsWithResponse call
+func ParseListGlobalJoinsResponse(rsp *http.Response) (*ListGlobalJoinsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProvidersResponse{
+	response := &ListGlobalJoinsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProvidersResponse
+		var dest GlobalJoinsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseRedirectAuthResponse parses an HTTP response from a RedirectAuthWithResponse call
-func ParseRedirectAuthResponse(rsp *http.Response) (*RedirectAuthResponse, error) {
+// ParseCreateGlobalJoinResponse parses an HTTP response from a CreateGlobalJoinWithResponse call
+func ParseCreateGlobalJoinResponse(rsp *http.Response) (*CreateGlobalJoinResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RedirectAuthResponse{
+	response := &CreateGlobalJoinResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokenResponse
+		var dest GlobalJoinResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -23969,12 +29256,19 @@ func ParseRedirectAuthResponse(rsp *http.Response) (*RedirectAuthResponse, error
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest InvalidTokenError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -23988,33 +29282,47 @@ func ParseRedirectAuthResponse(rsp *http.Response) (*RedirectAuthResponse, error
 	return response, nil
 }
 
-// ParseRefreshAuthResponse parses an HTTP response from a RefreshAuthWithResponse call
-func ParseRefreshAuthResponse(rsp *http.Response) (*RefreshAuthResponse, error) {
+// ParseDeleteGlobalJoinResponse parses an HTTP response from a DeleteGlobalJoinWithResponse call
+func ParseDeleteGlobalJoinResponse(rsp *http.Response) (*DeleteGlobalJoinResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RefreshAuthResponse{
+	response := &DeleteGlobalJoinResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RefreshResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest InvalidTokenError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -24028,33 +29336,33 @@ func ParseRefreshAuthResponse(rsp *http.Response) (*RefreshAuthResponse, error)
 	return response, nil
 }
 
-// ParseVerifyAuthResponse parses an HTTP response from a VerifyAuthWithResponse call
-func ParseVerifyAuthResponse(rsp *http.Response) (*VerifyAuthResponse, error) {
+// ParseShowProfileResponse parses an HTTP response from a ShowProfileWithResponse call
+func ParseShowProfileResponse(rsp *http.Response) (*ShowProfileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &VerifyAuthResponse{
+	response := &ShowProfileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest VerifyResponse
+		var dest ProfileResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest InvalidTokenError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -24068,54 +29376,116 @@ func ParseVerifyAuthResponse(rsp *http.Response) (*VerifyAuthResponse, error) {
 	return response, nil
 }
 
-// ParseCallbackProviderResponse parses an HTTP response from a CallbackProviderWithResponse call
-func ParseCallbackProviderResponse(rsp *http.Response) (*CallbackProviderResponse, error) {
+// ParseUpdateProfileResponse parses an HTTP response from a UpdateProfileWithResponse call
+func ParseUpdateProfileResponse(rsp *http.Response) (*UpdateProfileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CallbackProviderResponse{
+	response := &UpdateProfileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProfileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseRequestProviderResponse parses an HTTP response from a RequestProviderWithResponse call
-func ParseRequestProviderResponse(rsp *http.Response) (*RequestProviderResponse, error) {
+// ParseTokenProfileResponse parses an HTTP response from a TokenProfileWithResponse call
+func ParseTokenProfileResponse(rsp *http.Response) (*TokenProfileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RequestProviderResponse{
+	response := &TokenProfileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseListGlobalEventsResponse parses an HTTP response from a ListGlobalEventsWithResponse call
-func ParseListGlobalEventsResponse(rsp *http.Response) (*ListGlobalEventsResponse, error) {
+// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
+func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListGlobalEventsResponse{
+	response := &ListProjectsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GlobalEventsResponse
+		var dest ProjectsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24140,27 +29510,34 @@ func ParseListGlobalEventsResponse(rsp *http.Response) (*ListGlobalEventsRespons
 	return response, nil
 }
 
-// ParseListGroupsResponse parses an HTTP response from a ListGroupsWithResponse call
-func ParseListGroupsResponse(rsp *http.Response) (*ListGroupsResponse, error) {
+// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
+func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListGroupsResponse{
+	response := &CreateProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupsResponse
+		var dest ProjectResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24168,6 +29545,13 @@ func ParseListGroupsResponse(rsp *http.Response) (*ListGroupsResponse, error) {
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24180,29 +29564,29 @@ func ParseListGroupsResponse(rsp *http.Response) (*ListGroupsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateGroupResponse parses an HTTP response from a CreateGroupWithResponse call
-func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error) {
+// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
+func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateGroupResponse{
+	response := &DeleteProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24215,12 +29599,12 @@ func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error)
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -24234,29 +29618,76 @@ func ParseCreateGroupResponse(rsp *http.Response) (*CreateGroupResponse, error)
 	return response, nil
 }
 
-// ParseDeleteGroupResponse parses an HTTP response from a DeleteGroupWithResponse call
-func ParseDeleteGroupResponse(rsp *http.Response) (*DeleteGroupResponse, error) {
+// ParseShowProjectResponse parses an HTTP response from a ShowProjectWithResponse call
+func ParseShowProjectResponse(rsp *http.Response) (*ShowProjectResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteGroupResponse{
+	response := &ShowProjectResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24276,6 +29707,13 @@ func ParseDeleteGroupResponse(rsp *http.Response) (*DeleteGroupResponse, error)
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24288,22 +29726,22 @@ func ParseDeleteGroupResponse(rsp *http.Response) (*DeleteGroupResponse, error)
 	return response, nil
 }
 
-// ParseShowGroupResponse parses an HTTP response from a ShowGroupWithResponse call
-func ParseShowGroupResponse(rsp *http.Response) (*ShowGroupResponse, error) {
+// ParseListProjectCredentialsResponse parses an HTTP response from a ListProjectCredentialsWithResponse call
+func ParseListProjectCredentialsResponse(rsp *http.Response) (*ListProjectCredentialsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowGroupResponse{
+	response := &ListProjectCredentialsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupResponse
+		var dest ProjectCredentialsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24335,22 +29773,22 @@ func ParseShowGroupResponse(rsp *http.Response) (*ShowGroupResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateGroupResponse parses an HTTP response from a UpdateGroupWithResponse call
-func ParseUpdateGroupResponse(rsp *http.Response) (*UpdateGroupResponse, error) {
+// ParseCreateProjectCredentialResponse parses an HTTP response from a CreateProjectCredentialWithResponse call
+func ParseCreateProjectCredentialResponse(rsp *http.Response) (*CreateProjectCredentialResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateGroupResponse{
+	response := &CreateProjectCredentialResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupResponse
+		var dest ProjectCredentialResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24396,15 +29834,15 @@ func ParseUpdateGroupResponse(rsp *http.Response) (*UpdateGroupResponse, error)
 	return response, nil
 }
 
-// ParseDeleteGroupFromProjectResponse parses an HTTP response from a DeleteGroupFromProjectWithResponse call
-func ParseDeleteGroupFromProjectResponse(rsp *http.Response) (*DeleteGroupFromProjectResponse, error) {
+// ParseDeleteProjectCredentialResponse parses an HTTP response from a DeleteProjectCredentialWithResponse call
+func ParseDeleteProjectCredentialResponse(rsp *http.Response) (*DeleteProjectCredentialResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteGroupFromProjectResponse{
+	response := &DeleteProjectCredentialResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -24418,7 +29856,7 @@ func ParseDeleteGroupFromProjectResponse(rsp *http.Response) (*DeleteGroupFromPr
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24438,13 +29876,6 @@ func ParseDeleteGroupFromProjectResponse(rsp *http.Response) (*DeleteGroupFromPr
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24457,22 +29888,22 @@ func ParseDeleteGroupFromProjectResponse(rsp *http.Response) (*DeleteGroupFromPr
 	return response, nil
 }
 
-// ParseListGroupProjectsResponse parses an HTTP response from a ListGroupProjectsWithResponse call
-func ParseListGroupProjectsResponse(rsp *http.Response) (*ListGroupProjectsResponse, error) {
+// ParseShowProjectCredentialResponse parses an HTTP response from a ShowProjectCredentialWithResponse call
+func ParseShowProjectCredentialResponse(rsp *http.Response) (*ShowProjectCredentialResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListGroupProjectsResponse{
+	response := &ShowProjectCredentialResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupProjectsResponse
+		var dest ProjectCredentialResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24504,22 +29935,22 @@ func ParseListGroupProjectsResponse(rsp *http.Response) (*ListGroupProjectsRespo
 	return response, nil
 }
 
-// ParseAttachGroupToProjectResponse parses an HTTP response from a AttachGroupToProjectWithResponse call
-func ParseAttachGroupToProjectResponse(rsp *http.Response) (*AttachGroupToProjectResponse, error) {
+// ParseUpdateProjectCredentialResponse parses an HTTP response from a UpdateProjectCredentialWithResponse call
+func ParseUpdateProjectCredentialResponse(rsp *http.Response) (*UpdateProjectCredentialResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AttachGroupToProjectResponse{
+	response := &UpdateProjectCredentialResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectCredentialResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24546,19 +29977,59 @@ func ParseAttachGroupToProjectResponse(rsp *http.Response) (*AttachGroupToProjec
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest AlreadyAttachedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON422 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListProjectEnvironmentsResponse parses an HTTP response from a ListProjectEnvironmentsWithResponse call
+func ParseListProjectEnvironmentsResponse(rsp *http.Response) (*ListProjectEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListProjectEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectEnvironmentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -24572,22 +30043,22 @@ func ParseAttachGroupToProjectResponse(rsp *http.Response) (*AttachGroupToProjec
 	return response, nil
 }
 
-// ParsePermitGroupProjectResponse parses an HTTP response from a PermitGroupProjectWithResponse call
-func ParsePermitGroupProjectResponse(rsp *http.Response) (*PermitGroupProjectResponse, error) {
+// ParseCreateProjectEnvironmentResponse parses an HTTP response from a CreateProjectEnvironmentWithResponse call
+func ParseCreateProjectEnvironmentResponse(rsp *http.Response) (*CreateProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PermitGroupProjectResponse{
+	response := &CreateProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectEnvironmentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24614,13 +30085,6 @@ func ParsePermitGroupProjectResponse(rsp *http.Response) (*PermitGroupProjectRes
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24640,15 +30104,15 @@ func ParsePermitGroupProjectResponse(rsp *http.Response) (*PermitGroupProjectRes
 	return response, nil
 }
 
-// ParseDeleteGroupFromUserResponse parses an HTTP response from a DeleteGroupFromUserWithResponse call
-func ParseDeleteGroupFromUserResponse(rsp *http.Response) (*DeleteGroupFromUserResponse, error) {
+// ParseDeleteProjectEnvironmentResponse parses an HTTP response from a DeleteProjectEnvironmentWithResponse call
+func ParseDeleteProjectEnvironmentResponse(rsp *http.Response) (*DeleteProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteGroupFromUserResponse{
+	response := &DeleteProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -24662,7 +30126,7 @@ func ParseDeleteGroupFromUserResponse(rsp *http.Response) (*DeleteGroupFromUserR
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24682,13 +30146,6 @@ func ParseDeleteGroupFromUserResponse(rsp *http.Response) (*DeleteGroupFromUserR
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24701,22 +30158,22 @@ func ParseDeleteGroupFromUserResponse(rsp *http.Response) (*DeleteGroupFromUserR
 	return response, nil
 }
 
-// ParseListGroupUsersResponse parses an HTTP response from a ListGroupUsersWithResponse call
-func ParseListGroupUsersResponse(rsp *http.Response) (*ListGroupUsersResponse, error) {
+// ParseShowProjectEnvironmentResponse parses an HTTP response from a ShowProjectEnvironmentWithResponse call
+func ParseShowProjectEnvironmentResponse(rsp *http.Response) (*ShowProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListGroupUsersResponse{
+	response := &ShowProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GroupUsersResponse
+		var dest ProjectEnvironmentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24748,22 +30205,22 @@ func ParseListGroupUsersResponse(rsp *http.Response) (*ListGroupUsersResponse, e
 	return response, nil
 }
 
-// ParseAttachGroupToUserResponse parses an HTTP response from a AttachGroupToUserWithResponse call
-func ParseAttachGroupToUserResponse(rsp *http.Response) (*AttachGroupToUserResponse, error) {
+// ParseUpdateProjectEnvironmentResponse parses an HTTP response from a UpdateProjectEnvironmentWithResponse call
+func ParseUpdateProjectEnvironmentResponse(rsp *http.Response) (*UpdateProjectEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AttachGroupToUserResponse{
+	response := &UpdateProjectEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectEnvironmentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24790,12 +30247,66 @@ func ParseAttachGroupToUserResponse(rsp *http.Response) (*AttachGroupToUserRespo
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest AlreadyAttachedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectEnvironmentSecretResponse parses an HTTP response from a CreateProjectEnvironmentSecretWithResponse call
+func ParseCreateProjectEnvironmentSecretResponse(rsp *http.Response) (*CreateProjectEnvironmentSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectEnvironmentSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectEnvironmentSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
@@ -24816,15 +30327,15 @@ func ParseAttachGroupToUserResponse(rsp *http.Response) (*AttachGroupToUserRespo
 	return response, nil
 }
 
-// ParsePermitGroupUserResponse parses an HTTP response from a PermitGroupUserWithResponse call
-func ParsePermitGroupUserResponse(rsp *http.Response) (*PermitGroupUserResponse, error) {
+// ParseDeleteProjectEnvironmentSecretResponse parses an HTTP response from a DeleteProjectEnvironmentSecretWithResponse call
+func ParseDeleteProjectEnvironmentSecretResponse(rsp *http.Response) (*DeleteProjectEnvironmentSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PermitGroupUserResponse{
+	response := &DeleteProjectEnvironmentSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -24837,6 +30348,60 @@ func ParsePermitGroupUserResponse(rsp *http.Response) (*PermitGroupUserResponse,
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateProjectEnvironmentSecretResponse parses an HTTP response from a UpdateProjectEnvironmentSecretWithResponse call
+func ParseUpdateProjectEnvironmentSecretResponse(rsp *http.Response) (*UpdateProjectEnvironmentSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateProjectEnvironmentSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectEnvironmentSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24858,12 +30423,66 @@ func ParsePermitGroupUserResponse(rsp *http.Response) (*PermitGroupUserResponse,
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateProjectEnvironmentValueResponse parses an HTTP response from a CreateProjectEnvironmentValueWithResponse call
+func ParseCreateProjectEnvironmentValueResponse(rsp *http.Response) (*CreateProjectEnvironmentValueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateProjectEnvironmentValueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectEnvironmentValueResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
@@ -24884,27 +30503,34 @@ func ParsePermitGroupUserResponse(rsp *http.Response) (*PermitGroupUserResponse,
 	return response, nil
 }
 
-// ParseShowProfileResponse parses an HTTP response from a ShowProfileWithResponse call
-func ParseShowProfileResponse(rsp *http.Response) (*ShowProfileResponse, error) {
+// ParseDeleteProjectEnvironmentValueResponse parses an HTTP response from a DeleteProjectEnvironmentValueWithResponse call
+func ParseDeleteProjectEnvironmentValueResponse(rsp *http.Response) (*DeleteProjectEnvironmentValueResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProfileResponse{
+	response := &DeleteProjectEnvironmentValueResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProfileResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24912,6 +30538,13 @@ func ParseShowProfileResponse(rsp *http.Response) (*ShowProfileResponse, error)
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24924,22 +30557,22 @@ func ParseShowProfileResponse(rsp *http.Response) (*ShowProfileResponse, error)
 	return response, nil
 }
 
-// ParseUpdateProfileResponse parses an HTTP response from a UpdateProfileWithResponse call
-func ParseUpdateProfileResponse(rsp *http.Response) (*UpdateProfileResponse, error) {
+// ParseUpdateProjectEnvironmentValueResponse parses an HTTP response from a UpdateProjectEnvironmentValueWithResponse call
+func ParseUpdateProjectEnvironmentValueResponse(rsp *http.Response) (*UpdateProjectEnvironmentValueResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProfileResponse{
+	response := &UpdateProjectEnvironmentValueResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProfileResponse
+		var dest ProjectEnvironmentValueResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -24959,6 +30592,13 @@ func ParseUpdateProfileResponse(rsp *http.Response) (*UpdateProfileResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -24978,22 +30618,22 @@ func ParseUpdateProfileResponse(rsp *http.Response) (*UpdateProfileResponse, err
 	return response, nil
 }
 
-// ParseTokenProfileResponse parses an HTTP response from a TokenProfileWithResponse call
-func ParseTokenProfileResponse(rsp *http.Response) (*TokenProfileResponse, error) {
+// ParseListProjectEventsResponse parses an HTTP response from a ListProjectEventsWithResponse call
+func ParseListProjectEventsResponse(rsp *http.Response) (*ListProjectEventsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TokenProfileResponse{
+	response := &ListProjectEventsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokenResponse
+		var dest ProjectEventsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25006,6 +30646,13 @@ func ParseTokenProfileResponse(rsp *http.Response) (*TokenProfileResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25018,22 +30665,22 @@ func ParseTokenProfileResponse(rsp *http.Response) (*TokenProfileResponse, error
 	return response, nil
 }
 
-// ParseListProjectsResponse parses an HTTP response from a ListProjectsWithResponse call
-func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error) {
+// ParseListProjectExecutionsResponse parses an HTTP response from a ListProjectExecutionsWithResponse call
+func ParseListProjectExecutionsResponse(rsp *http.Response) (*ListProjectExecutionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectsResponse{
+	response := &ListProjectExecutionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectsResponse
+		var dest ProjectExecutionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25046,6 +30693,13 @@ func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25058,22 +30712,22 @@ func ParseListProjectsResponse(rsp *http.Response) (*ListProjectsResponse, error
 	return response, nil
 }
 
-// ParseCreateProjectResponse parses an HTTP response from a CreateProjectWithResponse call
-func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, error) {
+// ParseCreateProjectExecutionResponse parses an HTTP response from a CreateProjectExecutionWithResponse call
+func ParseCreateProjectExecutionResponse(rsp *http.Response) (*CreateProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectResponse{
+	response := &CreateProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectResponse
+		var dest ProjectExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25093,6 +30747,13 @@ func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, err
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25112,15 +30773,15 @@ func ParseCreateProjectResponse(rsp *http.Response) (*CreateProjectResponse, err
 	return response, nil
 }
 
-// ParseDeleteProjectResponse parses an HTTP response from a DeleteProjectWithResponse call
-func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, error) {
+// ParseDeleteProjectExecutionResponse parses an HTTP response from a DeleteProjectExecutionWithResponse call
+func ParseDeleteProjectExecutionResponse(rsp *http.Response) (*DeleteProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectResponse{
+	response := &DeleteProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -25166,22 +30827,22 @@ func ParseDeleteProjectResponse(rsp *http.Response) (*DeleteProjectResponse, err
 	return response, nil
 }
 
-// ParseShowProjectResponse parses an HTTP response from a ShowProjectWithResponse call
-func ParseShowProjectResponse(rsp *http.Response) (*ShowProjectResponse, error) {
+// ParseShowProjectExecutionResponse parses an HTTP response from a ShowProjectExecutionWithResponse call
+func ParseShowProjectExecutionResponse(rsp *http.Response) (*ShowProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProjectResponse{
+	response := &ShowProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectResponse
+		var dest ProjectExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25213,29 +30874,29 @@ func ParseShowProjectResponse(rsp *http.Response) (*ShowProjectResponse, error)
 	return response, nil
 }
 
-// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
-func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
+// ParseApproveProjectExecutionResponse parses an HTTP response from a ApproveProjectExecutionWithResponse call
+func ParseApproveProjectExecutionResponse(rsp *http.Response) (*ApproveProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectResponse{
+	response := &ApproveProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectResponse
+		var dest ProjectExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25255,13 +30916,6 @@ func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, err
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25274,22 +30928,22 @@ func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, err
 	return response, nil
 }
 
-// ParseListProjectCredentialsResponse parses an HTTP response from a ListProjectCredentialsWithResponse call
-func ParseListProjectCredentialsResponse(rsp *http.Response) (*ListProjectCredentialsResponse, error) {
+// ParseOutputProjectExecutionResponse parses an HTTP response from a OutputProjectExecutionWithResponse call
+func ParseOutputProjectExecutionResponse(rsp *http.Response) (*OutputProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectCredentialsResponse{
+	response := &OutputProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectCredentialsResponse
+		var dest ProjectOutputResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25321,29 +30975,29 @@ func ParseListProjectCredentialsResponse(rsp *http.Response) (*ListProjectCreden
 	return response, nil
 }
 
-// ParseCreateProjectCredentialResponse parses an HTTP response from a CreateProjectCredentialWithResponse call
-func ParseCreateProjectCredentialResponse(rsp *http.Response) (*CreateProjectCredentialResponse, error) {
+// ParsePurgeProjectExecutionResponse parses an HTTP response from a PurgeProjectExecutionWithResponse call
+func ParsePurgeProjectExecutionResponse(rsp *http.Response) (*PurgeProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectCredentialResponse{
+	response := &PurgeProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectCredentialResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25363,13 +31017,6 @@ func ParseCreateProjectCredentialResponse(rsp *http.Response) (*CreateProjectCre
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25382,22 +31029,22 @@ func ParseCreateProjectCredentialResponse(rsp *http.Response) (*CreateProjectCre
 	return response, nil
 }
 
-// ParseDeleteProjectCredentialResponse parses an HTTP response from a DeleteProjectCredentialWithResponse call
-func ParseDeleteProjectCredentialResponse(rsp *http.Response) (*DeleteProjectCredentialResponse, error) {
+// ParseRejectProjectExecutionResponse parses an HTTP response from a RejectProjectExecutionWithResponse call
+func ParseRejectProjectExecutionResponse(rsp *http.Response) (*RejectProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectCredentialResponse{
+	response := &RejectProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25436,22 +31083,22 @@ func ParseDeleteProjectCredentialResponse(rsp *http.Response) (*DeleteProjectCre
 	return response, nil
 }
 
-// ParseShowProjectCredentialResponse parses an HTTP response from a ShowProjectCredentialWithResponse call
-func ParseShowProjectCredentialResponse(rsp *http.Response) (*ShowProjectCredentialResponse, error) {
+// ParseRerunProjectExecutionResponse parses an HTTP response from a RerunProjectExecutionWithResponse call
+func ParseRerunProjectExecutionResponse(rsp *http.Response) (*RerunProjectExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProjectCredentialResponse{
+	response := &RerunProjectExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectCredentialResponse
+		var dest ProjectExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25471,6 +31118,67 @@ func ParseShowProjectCredentialResponse(rsp *http.Response) (*ShowProjectCredent
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopProjectExecutionResponse parses an HTTP response from a StopProjectExecutionWithResponse call
+func ParseStopProjectExecutionResponse(rsp *http.Response) (*StopProjectExecutionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopProjectExecutionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectExecutionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25483,22 +31191,22 @@ func ParseShowProjectCredentialResponse(rsp *http.Response) (*ShowProjectCredent
 	return response, nil
 }
 
-// ParseUpdateProjectCredentialResponse parses an HTTP response from a UpdateProjectCredentialWithResponse call
-func ParseUpdateProjectCredentialResponse(rsp *http.Response) (*UpdateProjectCredentialResponse, error) {
+// ParseDeleteProjectFromGroupResponse parses an HTTP response from a DeleteProjectFromGroupWithResponse call
+func ParseDeleteProjectFromGroupResponse(rsp *http.Response) (*DeleteProjectFromGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectCredentialResponse{
+	response := &DeleteProjectFromGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectCredentialResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25525,12 +31233,12 @@ func ParseUpdateProjectCredentialResponse(rsp *http.Response) (*UpdateProjectCre
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON412 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -25544,22 +31252,22 @@ func ParseUpdateProjectCredentialResponse(rsp *http.Response) (*UpdateProjectCre
 	return response, nil
 }
 
-// ParseListProjectEnvironmentsResponse parses an HTTP response from a ListProjectEnvironmentsWithResponse call
-func ParseListProjectEnvironmentsResponse(rsp *http.Response) (*ListProjectEnvironmentsResponse, error) {
+// ParseListProjectGroupsResponse parses an HTTP response from a ListProjectGroupsWithResponse call
+func ParseListProjectGroupsResponse(rsp *http.Response) (*ListProjectGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectEnvironmentsResponse{
+	response := &ListProjectGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentsResponse
+		var dest ProjectGroupsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25591,22 +31299,22 @@ func ParseListProjectEnvironmentsResponse(rsp *http.Response) (*ListProjectEnvir
 	return response, nil
 }
 
-// ParseCreateProjectEnvironmentResponse parses an HTTP response from a CreateProjectEnvironmentWithResponse call
-func ParseCreateProjectEnvironmentResponse(rsp *http.Response) (*CreateProjectEnvironmentResponse, error) {
+// ParseAttachProjectToGroupResponse parses an HTTP response from a AttachProjectToGroupWithResponse call
+func ParseAttachProjectToGroupResponse(rsp *http.Response) (*AttachProjectToGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectEnvironmentResponse{
+	response := &AttachProjectToGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25633,6 +31341,13 @@ func ParseCreateProjectEnvironmentResponse(rsp *http.Response) (*CreateProjectEn
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest AlreadyAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25652,15 +31367,15 @@ func ParseCreateProjectEnvironmentResponse(rsp *http.Response) (*CreateProjectEn
 	return response, nil
 }
 
-// ParseDeleteProjectEnvironmentResponse parses an HTTP response from a DeleteProjectEnvironmentWithResponse call
-func ParseDeleteProjectEnvironmentResponse(rsp *http.Response) (*DeleteProjectEnvironmentResponse, error) {
+// ParsePermitProjectGroupResponse parses an HTTP response from a PermitProjectGroupWithResponse call
+func ParsePermitProjectGroupResponse(rsp *http.Response) (*PermitProjectGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectEnvironmentResponse{
+	response := &PermitProjectGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -25674,7 +31389,7 @@ func ParseDeleteProjectEnvironmentResponse(rsp *http.Response) (*DeleteProjectEn
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25694,52 +31409,19 @@ func ParseDeleteProjectEnvironmentResponse(rsp *http.Response) (*DeleteProjectEn
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseShowProjectEnvironmentResponse parses an HTTP response from a ShowProjectEnvironmentWithResponse call
-func ParseShowProjectEnvironmentResponse(rsp *http.Response) (*ShowProjectEnvironmentResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ShowProjectEnvironmentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest NotAuthorizedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -25753,34 +31435,27 @@ func ParseShowProjectEnvironmentResponse(rsp *http.Response) (*ShowProjectEnviro
 	return response, nil
 }
 
-// ParseUpdateProjectEnvironmentResponse parses an HTTP response from a UpdateProjectEnvironmentWithResponse call
-func ParseUpdateProjectEnvironmentResponse(rsp *http.Response) (*UpdateProjectEnvironmentResponse, error) {
+// ParseListProjectInventoriesResponse parses an HTTP response from a ListProjectInventoriesWithResponse call
+func ParseListProjectInventoriesResponse(rsp *http.Response) (*ListProjectInventoriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectEnvironmentResponse{
+	response := &ListProjectInventoriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentResponse
+		var dest ProjectInventoriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25795,13 +31470,6 @@ func ParseUpdateProjectEnvironmentResponse(rsp *http.Response) (*UpdateProjectEn
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25814,22 +31482,22 @@ func ParseUpdateProjectEnvironmentResponse(rsp *http.Response) (*UpdateProjectEn
 	return response, nil
 }
 
-// ParseCreateProjectEnvironmentSecretResponse parses an HTTP response from a CreateProjectEnvironmentSecretWithResponse call
-func ParseCreateProjectEnvironmentSecretResponse(rsp *http.Response) (*CreateProjectEnvironmentSecretResponse, error) {
+// ParseCreateProjectInventoryResponse parses an HTTP response from a CreateProjectInventoryWithResponse call
+func ParseCreateProjectInventoryResponse(rsp *http.Response) (*CreateProjectInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectEnvironmentSecretResponse{
+	response := &CreateProjectInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentSecretResponse
+		var dest ProjectInventoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -25875,15 +31543,15 @@ func ParseCreateProjectEnvironmentSecretResponse(rsp *http.Response) (*CreatePro
 	return response, nil
 }
 
-// ParseDeleteProjectEnvironmentSecretResponse parses an HTTP response from a DeleteProjectEnvironmentSecretWithResponse call
-func ParseDeleteProjectEnvironmentSecretResponse(rsp *http.Response) (*DeleteProjectEnvironmentSecretResponse, error) {
+// ParseDeleteProjectInventoryResponse parses an HTTP response from a DeleteProjectInventoryWithResponse call
+func ParseDeleteProjectInventoryResponse(rsp *http.Response) (*DeleteProjectInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectEnvironmentSecretResponse{
+	response := &DeleteProjectInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -25929,34 +31597,27 @@ func ParseDeleteProjectEnvironmentSecretResponse(rsp *http.Response) (*DeletePro
 	return response, nil
 }
 
-// ParseUpdateProjectEnvironmentSecretResponse parses an HTTP response from a UpdateProjectEnvironmentSecretWithResponse call
-func ParseUpdateProjectEnvironmentSecretResponse(rsp *http.Response) (*UpdateProjectEnvironmentSecretResponse, error) {
+// ParseShowProjectInventoryResponse parses an HTTP response from a ShowProjectInventoryWithResponse call
+func ParseShowProjectInventoryResponse(rsp *http.Response) (*ShowProjectInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectEnvironmentSecretResponse{
+	response := &ShowProjectInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentSecretResponse
+		var dest ProjectInventoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25971,13 +31632,6 @@ func ParseUpdateProjectEnvironmentSecretResponse(rsp *http.Response) (*UpdatePro
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -25990,22 +31644,22 @@ func ParseUpdateProjectEnvironmentSecretResponse(rsp *http.Response) (*UpdatePro
 	return response, nil
 }
 
-// ParseCreateProjectEnvironmentValueResponse parses an HTTP response from a CreateProjectEnvironmentValueWithResponse call
-func ParseCreateProjectEnvironmentValueResponse(rsp *http.Response) (*CreateProjectEnvironmentValueResponse, error) {
+// ParseUpdateProjectInventoryResponse parses an HTTP response from a UpdateProjectInventoryWithResponse call
+func ParseUpdateProjectInventoryResponse(rsp *http.Response) (*UpdateProjectInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectEnvironmentValueResponse{
+	response := &UpdateProjectInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentValueResponse
+		var dest ProjectInventoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26051,34 +31705,27 @@ func ParseCreateProjectEnvironmentValueResponse(rsp *http.Response) (*CreateProj
 	return response, nil
 }
 
-// ParseDeleteProjectEnvironmentValueResponse parses an HTTP response from a DeleteProjectEnvironmentValueWithResponse call
-func ParseDeleteProjectEnvironmentValueResponse(rsp *http.Response) (*DeleteProjectEnvironmentValueResponse, error) {
+// ParseListProjectJoinsResponse parses an HTTP response from a ListProjectJoinsWithResponse call
+func ParseListProjectJoinsResponse(rsp *http.Response) (*ListProjectJoinsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectEnvironmentValueResponse{
+	response := &ListProjectJoinsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectJoinsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26105,22 +31752,22 @@ func ParseDeleteProjectEnvironmentValueResponse(rsp *http.Response) (*DeleteProj
 	return response, nil
 }
 
-// ParseUpdateProjectEnvironmentValueResponse parses an HTTP response from a UpdateProjectEnvironmentValueWithResponse call
-func ParseUpdateProjectEnvironmentValueResponse(rsp *http.Response) (*UpdateProjectEnvironmentValueResponse, error) {
+// ParseCreateProjectJoinResponse parses an HTTP response from a CreateProjectJoinWithResponse call
+func ParseCreateProjectJoinResponse(rsp *http.Response) (*CreateProjectJoinResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectEnvironmentValueResponse{
+	response := &CreateProjectJoinResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEnvironmentValueResponse
+		var dest ProjectJoinResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26166,27 +31813,34 @@ func ParseUpdateProjectEnvironmentValueResponse(rsp *http.Response) (*UpdateProj
 	return response, nil
 }
 
-// ParseListProjectEventsResponse parses an HTTP response from a ListProjectEventsWithResponse call
-func ParseListProjectEventsResponse(rsp *http.Response) (*ListProjectEventsResponse, error) {
+// ParseDeleteProjectJoinResponse parses an HTTP response from a DeleteProjectJoinWithResponse call
+func ParseDeleteProjectJoinResponse(rsp *http.Response) (*DeleteProjectJoinResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectEventsResponse{
+	response := &DeleteProjectJoinResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectEventsResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26213,22 +31867,22 @@ func ParseListProjectEventsResponse(rsp *http.Response) (*ListProjectEventsRespo
 	return response, nil
 }
 
-// ParseListProjectExecutionsResponse parses an HTTP response from a ListProjectExecutionsWithResponse call
-func ParseListProjectExecutionsResponse(rsp *http.Response) (*ListProjectExecutionsResponse, error) {
+// ParseListProjectRepositoriesResponse parses an HTTP response from a ListProjectRepositoriesWithResponse call
+func ParseListProjectRepositoriesResponse(rsp *http.Response) (*ListProjectRepositoriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectExecutionsResponse{
+	response := &ListProjectRepositoriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectExecutionsResponse
+		var dest ProjectRepositoriesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26260,22 +31914,22 @@ func ParseListProjectExecutionsResponse(rsp *http.Response) (*ListProjectExecuti
 	return response, nil
 }
 
-// ParseCreateProjectExecutionResponse parses an HTTP response from a CreateProjectExecutionWithResponse call
-func ParseCreateProjectExecutionResponse(rsp *http.Response) (*CreateProjectExecutionResponse, error) {
+// ParseCreateProjectRepositoryResponse parses an HTTP response from a CreateProjectRepositoryWithResponse call
+func ParseCreateProjectRepositoryResponse(rsp *http.Response) (*CreateProjectRepositoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectExecutionResponse{
+	response := &CreateProjectRepositoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectExecutionResponse
+		var dest ProjectRepositoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26321,15 +31975,15 @@ func ParseCreateProjectExecutionResponse(rsp *http.Response) (*CreateProjectExec
 	return response, nil
 }
 
-// ParseDeleteProjectExecutionResponse parses an HTTP response from a DeleteProjectExecutionWithResponse call
-func ParseDeleteProjectExecutionResponse(rsp *http.Response) (*DeleteProjectExecutionResponse, error) {
+// ParseDeleteProjectRepositoryResponse parses an HTTP response from a DeleteProjectRepositoryWithResponse call
+func ParseDeleteProjectRepositoryResponse(rsp *http.Response) (*DeleteProjectRepositoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectExecutionResponse{
+	response := &DeleteProjectRepositoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26375,69 +32029,22 @@ func ParseDeleteProjectExecutionResponse(rsp *http.Response) (*DeleteProjectExec
 	return response, nil
 }
 
-// ParseShowProjectExecutionResponse parses an HTTP response from a ShowProjectExecutionWithResponse call
-func ParseShowProjectExecutionResponse(rsp *http.Response) (*ShowProjectExecutionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ShowProjectExecutionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectExecutionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest NotAuthorizedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseOutputProjectExecutionResponse parses an HTTP response from a OutputProjectExecutionWithResponse call
-func ParseOutputProjectExecutionResponse(rsp *http.Response) (*OutputProjectExecutionResponse, error) {
+// ParseShowProjectRepositoryResponse parses an HTTP response from a ShowProjectRepositoryWithResponse call
+func ParseShowProjectRepositoryResponse(rsp *http.Response) (*ShowProjectRepositoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &OutputProjectExecutionResponse{
+	response := &ShowProjectRepositoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectOutputResponse
+		var dest ProjectRepositoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26469,29 +32076,29 @@ func ParseOutputProjectExecutionResponse(rsp *http.Response) (*OutputProjectExec
 	return response, nil
 }
 
-// ParsePurgeProjectExecutionResponse parses an HTTP response from a PurgeProjectExecutionWithResponse call
-func ParsePurgeProjectExecutionResponse(rsp *http.Response) (*PurgeProjectExecutionResponse, error) {
+// ParseUpdateProjectRepositoryResponse parses an HTTP response from a UpdateProjectRepositoryWithResponse call
+func ParseUpdateProjectRepositoryResponse(rsp *http.Response) (*UpdateProjectRepositoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PurgeProjectExecutionResponse{
+	response := &UpdateProjectRepositoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectRepositoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26511,6 +32118,13 @@ func ParsePurgeProjectExecutionResponse(rsp *http.Response) (*PurgeProjectExecut
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26523,34 +32137,27 @@ func ParsePurgeProjectExecutionResponse(rsp *http.Response) (*PurgeProjectExecut
 	return response, nil
 }
 
-// ParseDeleteProjectFromGroupResponse parses an HTTP response from a DeleteProjectFromGroupWithResponse call
-func ParseDeleteProjectFromGroupResponse(rsp *http.Response) (*DeleteProjectFromGroupResponse, error) {
+// ParseListProjectRunnersResponse parses an HTTP response from a ListProjectRunnersWithResponse call
+func ParseListProjectRunnersResponse(rsp *http.Response) (*ListProjectRunnersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectFromGroupResponse{
+	response := &ListProjectRunnersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectRunnersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26565,13 +32172,6 @@ func ParseDeleteProjectFromGroupResponse(rsp *http.Response) (*DeleteProjectFrom
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26584,27 +32184,34 @@ func ParseDeleteProjectFromGroupResponse(rsp *http.Response) (*DeleteProjectFrom
 	return response, nil
 }
 
-// ParseListProjectGroupsResponse parses an HTTP response from a ListProjectGroupsWithResponse call
-func ParseListProjectGroupsResponse(rsp *http.Response) (*ListProjectGroupsResponse, error) {
+// ParseCreateProjectRunnerResponse parses an HTTP response from a CreateProjectRunnerWithResponse call
+func ParseCreateProjectRunnerResponse(rsp *http.Response) (*CreateProjectRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectGroupsResponse{
+	response := &CreateProjectRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectGroupsResponse
+		var dest ProjectRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26619,6 +32226,13 @@ func ParseListProjectGroupsResponse(rsp *http.Response) (*ListProjectGroupsRespo
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26631,15 +32245,15 @@ func ParseListProjectGroupsResponse(rsp *http.Response) (*ListProjectGroupsRespo
 	return response, nil
 }
 
-// ParseAttachProjectToGroupResponse parses an HTTP response from a AttachProjectToGroupWithResponse call
-func ParseAttachProjectToGroupResponse(rsp *http.Response) (*AttachProjectToGroupResponse, error) {
+// ParseDeleteProjectRunnerResponse parses an HTTP response from a DeleteProjectRunnerWithResponse call
+func ParseDeleteProjectRunnerResponse(rsp *http.Response) (*DeleteProjectRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AttachProjectToGroupResponse{
+	response := &DeleteProjectRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -26653,7 +32267,7 @@ func ParseAttachProjectToGroupResponse(rsp *http.Response) (*AttachProjectToGrou
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26673,19 +32287,52 @@ func ParseAttachProjectToGroupResponse(rsp *http.Response) (*AttachProjectToGrou
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest AlreadyAttachedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
+	}
+
+	return response, nil
+}
+
+// ParseShowProjectRunnerResponse parses an HTTP response from a ShowProjectRunnerWithResponse call
+func ParseShowProjectRunnerResponse(rsp *http.Response) (*ShowProjectRunnerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ShowProjectRunnerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON422 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest NotAuthorizedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -26699,22 +32346,22 @@ func ParseAttachProjectToGroupResponse(rsp *http.Response) (*AttachProjectToGrou
 	return response, nil
 }
 
-// ParsePermitProjectGroupResponse parses an HTTP response from a PermitProjectGroupWithResponse call
-func ParsePermitProjectGroupResponse(rsp *http.Response) (*PermitProjectGroupResponse, error) {
+// ParseUpdateProjectRunnerResponse parses an HTTP response from a UpdateProjectRunnerWithResponse call
+func ParseUpdateProjectRunnerResponse(rsp *http.Response) (*UpdateProjectRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PermitProjectGroupResponse{
+	response := &UpdateProjectRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26741,13 +32388,6 @@ func ParsePermitProjectGroupResponse(rsp *http.Response) (*PermitProjectGroupRes
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26767,27 +32407,34 @@ func ParsePermitProjectGroupResponse(rsp *http.Response) (*PermitProjectGroupRes
 	return response, nil
 }
 
-// ParseListProjectInventoriesResponse parses an HTTP response from a ListProjectInventoriesWithResponse call
-func ParseListProjectInventoriesResponse(rsp *http.Response) (*ListProjectInventoriesResponse, error) {
+// ParseRevokeProjectRunnerResponse parses an HTTP response from a RevokeProjectRunnerWithResponse call
+func ParseRevokeProjectRunnerResponse(rsp *http.Response) (*RevokeProjectRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectInventoriesResponse{
+	response := &RevokeProjectRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectInventoriesResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26814,22 +32461,22 @@ func ParseListProjectInventoriesResponse(rsp *http.Response) (*ListProjectInvent
 	return response, nil
 }
 
-// ParseCreateProjectInventoryResponse parses an HTTP response from a CreateProjectInventoryWithResponse call
-func ParseCreateProjectInventoryResponse(rsp *http.Response) (*CreateProjectInventoryResponse, error) {
+// ParseRotateProjectRunnerResponse parses an HTTP response from a RotateProjectRunnerWithResponse call
+func ParseRotateProjectRunnerResponse(rsp *http.Response) (*RotateProjectRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectInventoryResponse{
+	response := &RotateProjectRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectInventoryResponse
+		var dest ProjectRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -26875,34 +32522,27 @@ func ParseCreateProjectInventoryResponse(rsp *http.Response) (*CreateProjectInve
 	return response, nil
 }
 
-// ParseDeleteProjectInventoryResponse parses an HTTP response from a DeleteProjectInventoryWithResponse call
-func ParseDeleteProjectInventoryResponse(rsp *http.Response) (*DeleteProjectInventoryResponse, error) {
+// ParseListProjectSchedulesResponse parses an HTTP response from a ListProjectSchedulesWithResponse call
+func ParseListProjectSchedulesResponse(rsp *http.Response) (*ListProjectSchedulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectInventoryResponse{
+	response := &ListProjectSchedulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectSchedulesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26929,27 +32569,34 @@ func ParseDeleteProjectInventoryResponse(rsp *http.Response) (*DeleteProjectInve
 	return response, nil
 }
 
-// ParseShowProjectInventoryResponse parses an HTTP response from a ShowProjectInventoryWithResponse call
-func ParseShowProjectInventoryResponse(rsp *http.Response) (*ShowProjectInventoryResponse, error) {
+// ParseCreateProjectScheduleResponse parses an HTTP response from a CreateProjectScheduleWithResponse call
+func ParseCreateProjectScheduleResponse(rsp *http.Response) (*CreateProjectScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProjectInventoryResponse{
+	response := &CreateProjectScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectInventoryResponse
+		var dest ProjectScheduleResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26964,6 +32611,13 @@ func ParseShowProjectInventoryResponse(rsp *http.Response) (*ShowProjectInventor
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -26976,29 +32630,29 @@ func ParseShowProjectInventoryResponse(rsp *http.Response) (*ShowProjectInventor
 	return response, nil
 }
 
-// ParseUpdateProjectInventoryResponse parses an HTTP response from a UpdateProjectInventoryWithResponse call
-func ParseUpdateProjectInventoryResponse(rsp *http.Response) (*UpdateProjectInventoryResponse, error) {
+// ParseDeleteProjectScheduleResponse parses an HTTP response from a DeleteProjectScheduleWithResponse call
+func ParseDeleteProjectScheduleResponse(rsp *http.Response) (*DeleteProjectScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectInventoryResponse{
+	response := &DeleteProjectScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectInventoryResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27018,13 +32672,6 @@ func ParseUpdateProjectInventoryResponse(rsp *http.Response) (*UpdateProjectInve
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27037,22 +32684,22 @@ func ParseUpdateProjectInventoryResponse(rsp *http.Response) (*UpdateProjectInve
 	return response, nil
 }
 
-// ParseListProjectRepositoriesResponse parses an HTTP response from a ListProjectRepositoriesWithResponse call
-func ParseListProjectRepositoriesResponse(rsp *http.Response) (*ListProjectRepositoriesResponse, error) {
+// ParseShowProjectScheduleResponse parses an HTTP response from a ShowProjectScheduleWithResponse call
+func ParseShowProjectScheduleResponse(rsp *http.Response) (*ShowProjectScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectRepositoriesResponse{
+	response := &ShowProjectScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRepositoriesResponse
+		var dest ProjectScheduleResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27084,22 +32731,22 @@ func ParseListProjectRepositoriesResponse(rsp *http.Response) (*ListProjectRepos
 	return response, nil
 }
 
-// ParseCreateProjectRepositoryResponse parses an HTTP response from a CreateProjectRepositoryWithResponse call
-func ParseCreateProjectRepositoryResponse(rsp *http.Response) (*CreateProjectRepositoryResponse, error) {
+// ParseUpdateProjectScheduleResponse parses an HTTP response from a UpdateProjectScheduleWithResponse call
+func ParseUpdateProjectScheduleResponse(rsp *http.Response) (*UpdateProjectScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectRepositoryResponse{
+	response := &UpdateProjectScheduleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRepositoryResponse
+		var dest ProjectScheduleResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27145,34 +32792,27 @@ func ParseCreateProjectRepositoryResponse(rsp *http.Response) (*CreateProjectRep
 	return response, nil
 }
 
-// ParseDeleteProjectRepositoryResponse parses an HTTP response from a DeleteProjectRepositoryWithResponse call
-func ParseDeleteProjectRepositoryResponse(rsp *http.Response) (*DeleteProjectRepositoryResponse, error) {
+// ParseListProjectScheduleRunsResponse parses an HTTP response from a ListProjectScheduleRunsWithResponse call
+func ParseListProjectScheduleRunsResponse(rsp *http.Response) (*ListProjectScheduleRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectRepositoryResponse{
+	response := &ListProjectScheduleRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectScheduleRunsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27199,22 +32839,22 @@ func ParseDeleteProjectRepositoryResponse(rsp *http.Response) (*DeleteProjectRep
 	return response, nil
 }
 
-// ParseShowProjectRepositoryResponse parses an HTTP response from a ShowProjectRepositoryWithResponse call
-func ParseShowProjectRepositoryResponse(rsp *http.Response) (*ShowProjectRepositoryResponse, error) {
+// ParseListProjectTemplatesResponse parses an HTTP response from a ListProjectTemplatesWithResponse call
+func ParseListProjectTemplatesResponse(rsp *http.Response) (*ListProjectTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProjectRepositoryResponse{
+	response := &ListProjectTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRepositoryResponse
+		var dest ProjectTemplatesResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27246,22 +32886,22 @@ func ParseShowProjectRepositoryResponse(rsp *http.Response) (*ShowProjectReposit
 	return response, nil
 }
 
-// ParseUpdateProjectRepositoryResponse parses an HTTP response from a UpdateProjectRepositoryWithResponse call
-func ParseUpdateProjectRepositoryResponse(rsp *http.Response) (*UpdateProjectRepositoryResponse, error) {
+// ParseCreateProjectTemplateResponse parses an HTTP response from a CreateProjectTemplateWithResponse call
+func ParseCreateProjectTemplateResponse(rsp *http.Response) (*CreateProjectTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectRepositoryResponse{
+	response := &CreateProjectTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRepositoryResponse
+		var dest ProjectTemplateResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27307,27 +32947,34 @@ func ParseUpdateProjectRepositoryResponse(rsp *http.Response) (*UpdateProjectRep
 	return response, nil
 }
 
-// ParseListProjectRunnersResponse parses an HTTP response from a ListProjectRunnersWithResponse call
-func ParseListProjectRunnersResponse(rsp *http.Response) (*ListProjectRunnersResponse, error) {
+// ParseDeleteProjectTemplateResponse parses an HTTP response from a DeleteProjectTemplateWithResponse call
+func ParseDeleteProjectTemplateResponse(rsp *http.Response) (*DeleteProjectTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectRunnersResponse{
+	response := &DeleteProjectTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRunnersResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27354,34 +33001,27 @@ func ParseListProjectRunnersResponse(rsp *http.Response) (*ListProjectRunnersRes
 	return response, nil
 }
 
-// ParseCreateProjectRunnerResponse parses an HTTP response from a CreateProjectRunnerWithResponse call
-func ParseCreateProjectRunnerResponse(rsp *http.Response) (*CreateProjectRunnerResponse, error) {
+// ParseShowProjectTemplateResponse parses an HTTP response from a ShowProjectTemplateWithResponse call
+func ParseShowProjectTemplateResponse(rsp *http.Response) (*ShowProjectTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectRunnerResponse{
+	response := &ShowProjectTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRunnerResponse
+		var dest ProjectTemplateResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27396,13 +33036,6 @@ func ParseCreateProjectRunnerResponse(rsp *http.Response) (*CreateProjectRunnerR
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27415,29 +33048,29 @@ func ParseCreateProjectRunnerResponse(rsp *http.Response) (*CreateProjectRunnerR
 	return response, nil
 }
 
-// ParseDeleteProjectRunnerResponse parses an HTTP response from a DeleteProjectRunnerWithResponse call
-func ParseDeleteProjectRunnerResponse(rsp *http.Response) (*DeleteProjectRunnerResponse, error) {
+// ParseUpdateProjectTemplateResponse parses an HTTP response from a UpdateProjectTemplateWithResponse call
+func ParseUpdateProjectTemplateResponse(rsp *http.Response) (*UpdateProjectTemplateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectRunnerResponse{
+	response := &UpdateProjectTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectTemplateResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27457,52 +33090,12 @@ func ParseDeleteProjectRunnerResponse(rsp *http.Response) (*DeleteProjectRunnerR
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseShowProjectRunnerResponse parses an HTTP response from a ShowProjectRunnerWithResponse call
-func ParseShowProjectRunnerResponse(rsp *http.Response) (*ShowProjectRunnerResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ShowProjectRunnerResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRunnerResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest NotAuthorizedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -27516,22 +33109,22 @@ func ParseShowProjectRunnerResponse(rsp *http.Response) (*ShowProjectRunnerRespo
 	return response, nil
 }
 
-// ParseUpdateProjectRunnerResponse parses an HTTP response from a UpdateProjectRunnerWithResponse call
-func ParseUpdateProjectRunnerResponse(rsp *http.Response) (*UpdateProjectRunnerResponse, error) {
+// ParseCreateProjectTemplateSurveyResponse parses an HTTP response from a CreateProjectTemplateSurveyWithResponse call
+func ParseCreateProjectTemplateSurveyResponse(rsp *http.Response) (*CreateProjectTemplateSurveyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectRunnerResponse{
+	response := &CreateProjectTemplateSurveyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectRunnerResponse
+		var dest ProjectTemplateSurveyResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27577,27 +33170,34 @@ func ParseUpdateProjectRunnerResponse(rsp *http.Response) (*UpdateProjectRunnerR
 	return response, nil
 }
 
-// ParseListProjectSchedulesResponse parses an HTTP response from a ListProjectSchedulesWithResponse call
-func ParseListProjectSchedulesResponse(rsp *http.Response) (*ListProjectSchedulesResponse, error) {
+// ParseDeleteProjectTemplateSurveyResponse parses an HTTP response from a DeleteProjectTemplateSurveyWithResponse call
+func ParseDeleteProjectTemplateSurveyResponse(rsp *http.Response) (*DeleteProjectTemplateSurveyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectSchedulesResponse{
+	response := &DeleteProjectTemplateSurveyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectSchedulesResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27624,22 +33224,22 @@ func ParseListProjectSchedulesResponse(rsp *http.Response) (*ListProjectSchedule
 	return response, nil
 }
 
-// ParseCreateProjectScheduleResponse parses an HTTP response from a CreateProjectScheduleWithResponse call
-func ParseCreateProjectScheduleResponse(rsp *http.Response) (*CreateProjectScheduleResponse, error) {
+// ParseUpdateProjectTemplateSurveyResponse parses an HTTP response from a UpdateProjectTemplateSurveyWithResponse call
+func ParseUpdateProjectTemplateSurveyResponse(rsp *http.Response) (*UpdateProjectTemplateSurveyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectScheduleResponse{
+	response := &UpdateProjectTemplateSurveyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectScheduleResponse
+		var dest ProjectTemplateSurveyResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27685,29 +33285,29 @@ func ParseCreateProjectScheduleResponse(rsp *http.Response) (*CreateProjectSched
 	return response, nil
 }
 
-// ParseDeleteProjectScheduleResponse parses an HTTP response from a DeleteProjectScheduleWithResponse call
-func ParseDeleteProjectScheduleResponse(rsp *http.Response) (*DeleteProjectScheduleResponse, error) {
+// ParseCreateProjectTemplateVaultResponse parses an HTTP response from a CreateProjectTemplateVaultWithResponse call
+func ParseCreateProjectTemplateVaultResponse(rsp *http.Response) (*CreateProjectTemplateVaultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectScheduleResponse{
+	response := &CreateProjectTemplateVaultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ProjectTemplateVaultResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27727,6 +33327,13 @@ func ParseDeleteProjectScheduleResponse(rsp *http.Response) (*DeleteProjectSched
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27739,27 +33346,34 @@ func ParseDeleteProjectScheduleResponse(rsp *http.Response) (*DeleteProjectSched
 	return response, nil
 }
 
-// ParseShowProjectScheduleResponse parses an HTTP response from a ShowProjectScheduleWithResponse call
-func ParseShowProjectScheduleResponse(rsp *http.Response) (*ShowProjectScheduleResponse, error) {
+// ParseDeleteProjectTemplateVaultResponse parses an HTTP response from a DeleteProjectTemplateVaultWithResponse call
+func ParseDeleteProjectTemplateVaultResponse(rsp *http.Response) (*DeleteProjectTemplateVaultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShowProjectScheduleResponse{
+	response := &DeleteProjectTemplateVaultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectScheduleResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ActionFailedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27786,22 +33400,22 @@ func ParseShowProjectScheduleResponse(rsp *http.Response) (*ShowProjectScheduleR
 	return response, nil
 }
 
-// ParseUpdateProjectScheduleResponse parses an HTTP response from a UpdateProjectScheduleWithResponse call
-func ParseUpdateProjectScheduleResponse(rsp *http.Response) (*UpdateProjectScheduleResponse, error) {
+// ParseUpdateProjectTemplateVaultResponse parses an HTTP response from a UpdateProjectTemplateVaultWithResponse call
+func ParseUpdateProjectTemplateVaultResponse(rsp *http.Response) (*UpdateProjectTemplateVaultResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectScheduleResponse{
+	response := &UpdateProjectTemplateVaultResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectScheduleResponse
+		var dest ProjectTemplateVaultResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27847,27 +33461,34 @@ func ParseUpdateProjectScheduleResponse(rsp *http.Response) (*UpdateProjectSched
 	return response, nil
 }
 
-// ParseListProjectTemplatesResponse parses an HTTP response from a ListProjectTemplatesWithResponse call
-func ParseListProjectTemplatesResponse(rsp *http.Response) (*ListProjectTemplatesResponse, error) {
+// ParseDeleteProjectFromUserResponse parses an HTTP response from a DeleteProjectFromUserWithResponse call
+func ParseDeleteProjectFromUserResponse(rsp *http.Response) (*DeleteProjectFromUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectTemplatesResponse{
+	response := &DeleteProjectFromUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplatesResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequestError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27882,6 +33503,13 @@ func ParseListProjectTemplatesResponse(rsp *http.Response) (*ListProjectTemplate
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27894,34 +33522,27 @@ func ParseListProjectTemplatesResponse(rsp *http.Response) (*ListProjectTemplate
 	return response, nil
 }
 
-// ParseCreateProjectTemplateResponse parses an HTTP response from a CreateProjectTemplateWithResponse call
-func ParseCreateProjectTemplateResponse(rsp *http.Response) (*CreateProjectTemplateResponse, error) {
+// ParseListProjectUsersResponse parses an HTTP response from a ListProjectUsersWithResponse call
+func ParseListProjectUsersResponse(rsp *http.Response) (*ListProjectUsersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectTemplateResponse{
+	response := &ListProjectUsersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateResponse
+		var dest ProjectUsersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27936,13 +33557,6 @@ func ParseCreateProjectTemplateResponse(rsp *http.Response) (*CreateProjectTempl
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -27955,15 +33569,15 @@ func ParseCreateProjectTemplateResponse(rsp *http.Response) (*CreateProjectTempl
 	return response, nil
 }
 
-// ParseDeleteProjectTemplateResponse parses an HTTP response from a DeleteProjectTemplateWithResponse call
-func ParseDeleteProjectTemplateResponse(rsp *http.Response) (*DeleteProjectTemplateResponse, error) {
+// ParseAttachProjectToUserResponse parses an HTTP response from a AttachProjectToUserWithResponse call
+func ParseAttachProjectToUserResponse(rsp *http.Response) (*AttachProjectToUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectTemplateResponse{
+	response := &AttachProjectToUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -27977,7 +33591,7 @@ func ParseDeleteProjectTemplateResponse(rsp *http.Response) (*DeleteProjectTempl
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
+		var dest BadRequestError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -27997,52 +33611,19 @@ func ParseDeleteProjectTemplateResponse(rsp *http.Response) (*DeleteProjectTempl
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseShowProjectTemplateResponse parses an HTTP response from a ShowProjectTemplateWithResponse call
-func ParseShowProjectTemplateResponse(rsp *http.Response) (*ShowProjectTemplateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ShowProjectTemplateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest NotAuthorizedError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest AlreadyAttachedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON403 = &dest
+		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON422 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -28056,22 +33637,22 @@ func ParseShowProjectTemplateResponse(rsp *http.Response) (*ShowProjectTemplateR
 	return response, nil
 }
 
-// ParseUpdateProjectTemplateResponse parses an HTTP response from a UpdateProjectTemplateWithResponse call
-func ParseUpdateProjectTemplateResponse(rsp *http.Response) (*UpdateProjectTemplateResponse, error) {
+// ParsePermitProjectUserResponse parses an HTTP response from a PermitProjectUserWithResponse call
+func ParsePermitProjectUserResponse(rsp *http.Response) (*PermitProjectUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectTemplateResponse{
+	response := &PermitProjectUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28098,6 +33679,13 @@ func ParseUpdateProjectTemplateResponse(rsp *http.Response) (*UpdateProjectTempl
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest NotAttachedError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28117,34 +33705,27 @@ func ParseUpdateProjectTemplateResponse(rsp *http.Response) (*UpdateProjectTempl
 	return response, nil
 }
 
-// ParseCreateProjectTemplateSurveyResponse parses an HTTP response from a CreateProjectTemplateSurveyWithResponse call
-func ParseCreateProjectTemplateSurveyResponse(rsp *http.Response) (*CreateProjectTemplateSurveyResponse, error) {
+// ParseClaimRunnerExecutionResponse parses an HTTP response from a ClaimRunnerExecutionWithResponse call
+func ParseClaimRunnerExecutionResponse(rsp *http.Response) (*ClaimRunnerExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectTemplateSurveyResponse{
+	response := &ClaimRunnerExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateSurveyResponse
+		var dest RunnerExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28159,13 +33740,6 @@ func ParseCreateProjectTemplateSurveyResponse(rsp *http.Response) (*CreateProjec
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28178,34 +33752,27 @@ func ParseCreateProjectTemplateSurveyResponse(rsp *http.Response) (*CreateProjec
 	return response, nil
 }
 
-// ParseDeleteProjectTemplateSurveyResponse parses an HTTP response from a DeleteProjectTemplateSurveyWithResponse call
-func ParseDeleteProjectTemplateSurveyResponse(rsp *http.Response) (*DeleteProjectTemplateSurveyResponse, error) {
+// ParseShowRunnerExecutionResponse parses an HTTP response from a ShowRunnerExecutionWithResponse call
+func ParseShowRunnerExecutionResponse(rsp *http.Response) (*ShowRunnerExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectTemplateSurveyResponse{
+	response := &ShowRunnerExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest RunnerExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28232,22 +33799,22 @@ func ParseDeleteProjectTemplateSurveyResponse(rsp *http.Response) (*DeleteProjec
 	return response, nil
 }
 
-// ParseUpdateProjectTemplateSurveyResponse parses an HTTP response from a UpdateProjectTemplateSurveyWithResponse call
-func ParseUpdateProjectTemplateSurveyResponse(rsp *http.Response) (*UpdateProjectTemplateSurveyResponse, error) {
+// ParseUpdateRunnerExecutionResponse parses an HTTP response from a UpdateRunnerExecutionWithResponse call
+func ParseUpdateRunnerExecutionResponse(rsp *http.Response) (*UpdateRunnerExecutionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectTemplateSurveyResponse{
+	response := &UpdateRunnerExecutionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateSurveyResponse
+		var dest RunnerExecutionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28274,6 +33841,13 @@ func ParseUpdateProjectTemplateSurveyResponse(rsp *http.Response) (*UpdateProjec
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28293,22 +33867,22 @@ func ParseUpdateProjectTemplateSurveyResponse(rsp *http.Response) (*UpdateProjec
 	return response, nil
 }
 
-// ParseCreateProjectTemplateVaultResponse parses an HTTP response from a CreateProjectTemplateVaultWithResponse call
-func ParseCreateProjectTemplateVaultResponse(rsp *http.Response) (*CreateProjectTemplateVaultResponse, error) {
+// ParseCreateRunnerOutputResponse parses an HTTP response from a CreateRunnerOutputWithResponse call
+func ParseCreateRunnerOutputResponse(rsp *http.Response) (*CreateRunnerOutputResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateProjectTemplateVaultResponse{
+	response := &CreateRunnerOutputResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateVaultResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28335,13 +33909,6 @@ func ParseCreateProjectTemplateVaultResponse(rsp *http.Response) (*CreateProject
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28354,34 +33921,27 @@ func ParseCreateProjectTemplateVaultResponse(rsp *http.Response) (*CreateProject
 	return response, nil
 }
 
-// ParseDeleteProjectTemplateVaultResponse parses an HTTP response from a DeleteProjectTemplateVaultWithResponse call
-func ParseDeleteProjectTemplateVaultResponse(rsp *http.Response) (*DeleteProjectTemplateVaultResponse, error) {
+// ParseHeartbeatRunnerResponse parses an HTTP response from a HeartbeatRunnerWithResponse call
+func ParseHeartbeatRunnerResponse(rsp *http.Response) (*HeartbeatRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectTemplateVaultResponse{
+	response := &HeartbeatRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest RunnerHeartbeatResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ActionFailedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest NotAuthorizedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28389,13 +33949,6 @@ func ParseDeleteProjectTemplateVaultResponse(rsp *http.Response) (*DeleteProject
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28408,22 +33961,22 @@ func ParseDeleteProjectTemplateVaultResponse(rsp *http.Response) (*DeleteProject
 	return response, nil
 }
 
-// ParseUpdateProjectTemplateVaultResponse parses an HTTP response from a UpdateProjectTemplateVaultWithResponse call
-func ParseUpdateProjectTemplateVaultResponse(rsp *http.Response) (*UpdateProjectTemplateVaultResponse, error) {
+// ParseJoinRunnerResponse parses an HTTP response from a JoinRunnerWithResponse call
+func ParseJoinRunnerResponse(rsp *http.Response) (*JoinRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProjectTemplateVaultResponse{
+	response := &JoinRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectTemplateVaultResponse
+		var dest JoinedRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28443,13 +33996,6 @@ func ParseUpdateProjectTemplateVaultResponse(rsp *http.Response) (*UpdateProject
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28469,22 +34015,22 @@ func ParseUpdateProjectTemplateVaultResponse(rsp *http.Response) (*UpdateProject
 	return response, nil
 }
 
-// ParseDeleteProjectFromUserResponse parses an HTTP response from a DeleteProjectFromUserWithResponse call
-func ParseDeleteProjectFromUserResponse(rsp *http.Response) (*DeleteProjectFromUserResponse, error) {
+// ParseRegisterRunnerResponse parses an HTTP response from a RegisterRunnerWithResponse call
+func ParseRegisterRunnerResponse(rsp *http.Response) (*RegisterRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProjectFromUserResponse{
+	response := &RegisterRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest CurrentRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28504,20 +34050,6 @@ func ParseDeleteProjectFromUserResponse(rsp *http.Response) (*DeleteProjectFromU
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28530,22 +34062,22 @@ func ParseDeleteProjectFromUserResponse(rsp *http.Response) (*DeleteProjectFromU
 	return response, nil
 }
 
-// ParseListProjectUsersResponse parses an HTTP response from a ListProjectUsersWithResponse call
-func ParseListProjectUsersResponse(rsp *http.Response) (*ListProjectUsersResponse, error) {
+// ParseListGlobalRunnersResponse parses an HTTP response from a ListGlobalRunnersWithResponse call
+func ParseListGlobalRunnersResponse(rsp *http.Response) (*ListGlobalRunnersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListProjectUsersResponse{
+	response := &ListGlobalRunnersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectUsersResponse
+		var dest GlobalRunnersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28558,13 +34090,6 @@ func ParseListProjectUsersResponse(rsp *http.Response) (*ListProjectUsersRespons
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28577,22 +34102,22 @@ func ParseListProjectUsersResponse(rsp *http.Response) (*ListProjectUsersRespons
 	return response, nil
 }
 
-// ParseAttachProjectToUserResponse parses an HTTP response from a AttachProjectToUserWithResponse call
-func ParseAttachProjectToUserResponse(rsp *http.Response) (*AttachProjectToUserResponse, error) {
+// ParseCreateGlobalRunnerResponse parses an HTTP response from a CreateGlobalRunnerWithResponse call
+func ParseCreateGlobalRunnerResponse(rsp *http.Response) (*CreateGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AttachProjectToUserResponse{
+	response := &CreateGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest GlobalRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28612,20 +34137,6 @@ func ParseAttachProjectToUserResponse(rsp *http.Response) (*AttachProjectToUserR
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest AlreadyAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28645,15 +34156,15 @@ func ParseAttachProjectToUserResponse(rsp *http.Response) (*AttachProjectToUserR
 	return response, nil
 }
 
-// ParsePermitProjectUserResponse parses an HTTP response from a PermitProjectUserWithResponse call
-func ParsePermitProjectUserResponse(rsp *http.Response) (*PermitProjectUserResponse, error) {
+// ParseDeleteGlobalRunnerResponse parses an HTTP response from a DeleteGlobalRunnerWithResponse call
+func ParseDeleteGlobalRunnerResponse(rsp *http.Response) (*DeleteGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PermitProjectUserResponse{
+	response := &DeleteGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -28667,7 +34178,7 @@ func ParsePermitProjectUserResponse(rsp *http.Response) (*PermitProjectUserRespo
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequestError
+		var dest ActionFailedError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28687,20 +34198,6 @@ func ParsePermitProjectUserResponse(rsp *http.Response) (*PermitProjectUserRespo
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest NotAttachedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest ValidationError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28713,22 +34210,22 @@ func ParsePermitProjectUserResponse(rsp *http.Response) (*PermitProjectUserRespo
 	return response, nil
 }
 
-// ParseListGlobalRunnersResponse parses an HTTP response from a ListGlobalRunnersWithResponse call
-func ParseListGlobalRunnersResponse(rsp *http.Response) (*ListGlobalRunnersResponse, error) {
+// ParseShowGlobalRunnerResponse parses an HTTP response from a ShowGlobalRunnerWithResponse call
+func ParseShowGlobalRunnerResponse(rsp *http.Response) (*ShowGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListGlobalRunnersResponse{
+	response := &ShowGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GlobalRunnersResponse
+		var dest GlobalRunnerResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -28741,6 +34238,13 @@ func ParseListGlobalRunnersResponse(rsp *http.Response) (*ListGlobalRunnersRespo
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28753,15 +34257,15 @@ func ParseListGlobalRunnersResponse(rsp *http.Response) (*ListGlobalRunnersRespo
 	return response, nil
 }
 
-// ParseCreateGlobalRunnerResponse parses an HTTP response from a CreateGlobalRunnerWithResponse call
-func ParseCreateGlobalRunnerResponse(rsp *http.Response) (*CreateGlobalRunnerResponse, error) {
+// ParseUpdateGlobalRunnerResponse parses an HTTP response from a UpdateGlobalRunnerWithResponse call
+func ParseUpdateGlobalRunnerResponse(rsp *http.Response) (*UpdateGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateGlobalRunnerResponse{
+	response := &UpdateGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -28788,6 +34292,13 @@ func ParseCreateGlobalRunnerResponse(rsp *http.Response) (*CreateGlobalRunnerRes
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFoundError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
 		var dest ValidationError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -28807,15 +34318,15 @@ func ParseCreateGlobalRunnerResponse(rsp *http.Response) (*CreateGlobalRunnerRes
 	return response, nil
 }
 
-// ParseDeleteGlobalRunnerResponse parses an HTTP response from a DeleteGlobalRunnerWithResponse call
-func ParseDeleteGlobalRunnerResponse(rsp *http.Response) (*DeleteGlobalRunnerResponse, error) {
+// ParseRevokeGlobalRunnerResponse parses an HTTP response from a RevokeGlobalRunnerWithResponse call
+func ParseRevokeGlobalRunnerResponse(rsp *http.Response) (*RevokeGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteGlobalRunnerResponse{
+	response := &RevokeGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -28861,62 +34372,15 @@ func ParseDeleteGlobalRunnerResponse(rsp *http.Response) (*DeleteGlobalRunnerRes
 	return response, nil
 }
 
-// ParseShowGlobalRunnerResponse parses an HTTP response from a ShowGlobalRunnerWithResponse call
-func ParseShowGlobalRunnerResponse(rsp *http.Response) (*ShowGlobalRunnerResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ShowGlobalRunnerResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GlobalRunnerResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest NotAuthorizedError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFoundError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseUpdateGlobalRunnerResponse parses an HTTP response from a UpdateGlobalRunnerWithResponse call
-func ParseUpdateGlobalRunnerResponse(rsp *http.Response) (*UpdateGlobalRunnerResponse, error) {
+// ParseRotateGlobalRunnerResponse parses an HTTP response from a RotateGlobalRunnerWithResponse call
+func ParseRotateGlobalRunnerResponse(rsp *http.Response) (*RotateGlobalRunnerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateGlobalRunnerResponse{
+	response := &RotateGlobalRunnerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -29778,6 +35242,15 @@ type ServerInterface interface {
 	// PermitGroupUser Update user perms for group
 	// (PUT /groups/{group_id}/users)
 	PermitGroupUser(w http.ResponseWriter, r *http.Request, groupID GroupID)
+	// ListGlobalJoins Fetch all join tokens
+	// (GET /joins)
+	ListGlobalJoins(w http.ResponseWriter, r *http.Request, params ListGlobalJoinsParams)
+	// CreateGlobalJoin Create a new global join token
+	// (POST /joins)
+	CreateGlobalJoin(w http.ResponseWriter, r *http.Request)
+	// DeleteGlobalJoin Delete a specific join token
+	// (DELETE /joins/{join_id})
+	DeleteGlobalJoin(w http.ResponseWriter, r *http.Request, joinID JoinID)
 	// ShowProfile Fetch profile details of the personal account
 	// (GET /profile/self)
 	ShowProfile(w http.ResponseWriter, r *http.Request)
@@ -29865,12 +35338,24 @@ type ServerInterface interface {
 	// ShowProjectExecution Fetch a specific execution for a project
 	// (GET /projects/{project_id}/executions/{execution_id})
 	ShowProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
+	// ApproveProjectExecution Approve a specific execution awaiting confirmation
+	// (POST /projects/{project_id}/executions/{execution_id}/approve)
+	ApproveProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
 	// OutputProjectExecution Output a specific execution for a project
 	// (GET /projects/{project_id}/executions/{execution_id}/output)
 	OutputProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
 	// PurgeProjectExecution Purge a specific execution for a project
 	// (GET /projects/{project_id}/executions/{execution_id}/purge)
 	PurgeProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
+	// RejectProjectExecution Reject a specific execution awaiting confirmation
+	// (POST /projects/{project_id}/executions/{execution_id}/reject)
+	RejectProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
+	// RerunProjectExecution Rerun a specific execution with the same parameters
+	// (POST /projects/{project_id}/executions/{execution_id}/rerun)
+	RerunProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
+	// StopProjectExecution Stop a specific execution for a project
+	// (POST /projects/{project_id}/executions/{execution_id}/stop)
+	StopProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID)
 	// DeleteProjectFromGroup Unlink a group from project
 	// (DELETE /projects/{project_id}/groups)
 	DeleteProjectFromGroup(w http.ResponseWriter, r *http.Request, projectID ProjectID)
@@ -29898,6 +35383,15 @@ type ServerInterface interface {
 	// UpdateProjectInventory Update a specific inventory for a project
 	// (PUT /projects/{project_id}/inventories/{inventory_id})
 	UpdateProjectInventory(w http.ResponseWriter, r *http.Request, projectID ProjectID, inventoryID InventoryID)
+	// ListProjectJoins Fetch all join tokens for a project
+	// (GET /projects/{project_id}/joins)
+	ListProjectJoins(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectJoinsParams)
+	// CreateProjectJoin Create a new join token
+	// (POST /projects/{project_id}/joins)
+	CreateProjectJoin(w http.ResponseWriter, r *http.Request, projectID ProjectID)
+	// DeleteProjectJoin Delete a specific join token for a project
+	// (DELETE /projects/{project_id}/joins/{join_id})
+	DeleteProjectJoin(w http.ResponseWriter, r *http.Request, projectID ProjectID, joinID JoinID)
 	// ListProjectRepositories Fetch all repositories for a project
 	// (GET /projects/{project_id}/repositories)
 	ListProjectRepositories(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectRepositoriesParams)
@@ -29928,6 +35422,12 @@ type ServerInterface interface {
 	// UpdateProjectRunner Update a specific runner for a project
 	// (PUT /projects/{project_id}/runners/{runner_id})
 	UpdateProjectRunner(w http.ResponseWriter, r *http.Request, projectID ProjectID, runnerID RunnerID)
+	// RevokeProjectRunner Revoke the token of a specific runner for a project
+	// (POST /projects/{project_id}/runners/{runner_id}/revoke)
+	RevokeProjectRunner(w http.ResponseWriter, r *http.Request, projectID ProjectID, runnerID RunnerID)
+	// RotateProjectRunner Rotate the token of a specific runner for a project
+	// (POST /projects/{project_id}/runners/{runner_id}/rotate)
+	RotateProjectRunner(w http.ResponseWriter, r *http.Request, projectID ProjectID, runnerID RunnerID)
 	// ListProjectSchedules Fetch all schedules for a project
 	// (GET /projects/{project_id}/schedules)
 	ListProjectSchedules(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectSchedulesParams)
@@ -29939,10 +35439,13 @@ type ServerInterface interface {
 	DeleteProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID)
 	// ShowProjectSchedule Fetch a specific schedule for a project
 	// (GET /projects/{project_id}/schedules/{schedule_id})
-	ShowProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID)
+	ShowProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID, params ShowProjectScheduleParams)
 	// UpdateProjectSchedule Update a specific schedule for a project
 	// (PUT /projects/{project_id}/schedules/{schedule_id})
 	UpdateProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID)
+	// ListProjectScheduleRuns Fetch the run history of a schedule for a project
+	// (GET /projects/{project_id}/schedules/{schedule_id}/runs)
+	ListProjectScheduleRuns(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID, params ListProjectScheduleRunsParams)
 	// ListProjectTemplates Fetch all templates for a project
 	// (GET /projects/{project_id}/templates)
 	ListProjectTemplates(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectTemplatesParams)
@@ -29988,6 +35491,27 @@ type ServerInterface interface {
 	// PermitProjectUser Update user perms for project
 	// (PUT /projects/{project_id}/users)
 	PermitProjectUser(w http.ResponseWriter, r *http.Request, projectID ProjectID)
+	// ClaimRunnerExecution Claim the next waiting execution
+	// (POST /runner/executions)
+	ClaimRunnerExecution(w http.ResponseWriter, r *http.Request, params ClaimRunnerExecutionParams)
+	// ShowRunnerExecution Fetch a specific claimed execution
+	// (GET /runner/executions/{execution_id})
+	ShowRunnerExecution(w http.ResponseWriter, r *http.Request, executionID ExecutionID)
+	// UpdateRunnerExecution Update the status of a claimed execution
+	// (PUT /runner/executions/{execution_id})
+	UpdateRunnerExecution(w http.ResponseWriter, r *http.Request, executionID ExecutionID)
+	// CreateRunnerOutput Append output to a claimed execution
+	// (POST /runner/executions/{execution_id}/output)
+	CreateRunnerOutput(w http.ResponseWriter, r *http.Request, executionID ExecutionID)
+	// HeartbeatRunner Send a heartbeat for the authenticated runner
+	// (POST /runner/heartbeat)
+	HeartbeatRunner(w http.ResponseWriter, r *http.Request)
+	// JoinRunner Exchange a join token for a new runner
+	// (POST /runner/join)
+	JoinRunner(w http.ResponseWriter, r *http.Request)
+	// RegisterRunner Register the authenticated runner
+	// (POST /runner/register)
+	RegisterRunner(w http.ResponseWriter, r *http.Request)
 	// ListGlobalRunners Fetch all runners
 	// (GET /runners)
 	ListGlobalRunners(w http.ResponseWriter, r *http.Request, params ListGlobalRunnersParams)
@@ -30003,6 +35527,12 @@ type ServerInterface interface {
 	// UpdateGlobalRunner Update a specific runner
 	// (PUT /runners/{runner_id})
 	UpdateGlobalRunner(w http.ResponseWriter, r *http.Request, runnerID RunnerID)
+	// RevokeGlobalRunner Revoke the token of a specific runner
+	// (POST /runners/{runner_id}/revoke)
+	RevokeGlobalRunner(w http.ResponseWriter, r *http.Request, runnerID RunnerID)
+	// RotateGlobalRunner Rotate the token of a specific runner
+	// (POST /runners/{runner_id}/rotate)
+	RotateGlobalRunner(w http.ResponseWriter, r *http.Request, runnerID RunnerID)
 	// ListUsers Fetch all available users
 	// (GET /users)
 	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
@@ -30174,6 +35704,24 @@ func (_ Unimplemented) PermitGroupUser(w http.ResponseWriter, r *http.Request, g
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ListGlobalJoins Fetch all join tokens
+// (GET /joins)
+func (_ Unimplemented) ListGlobalJoins(w http.ResponseWriter, r *http.Request, params ListGlobalJoinsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreateGlobalJoin Create a new global join token
+// (POST /joins)
+func (_ Unimplemented) CreateGlobalJoin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteGlobalJoin Delete a specific join token
+// (DELETE /joins/{join_id})
+func (_ Unimplemented) DeleteGlobalJoin(w http.ResponseWriter, r *http.Request, joinID JoinID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ShowProfile Fetch profile details of the personal account
 // (GET /profile/self)
 func (_ Unimplemented) ShowProfile(w http.ResponseWriter, r *http.Request) {
@@ -30348,6 +35896,12 @@ func (_ Unimplemented) ShowProjectExecution(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ApproveProjectExecution Approve a specific execution awaiting confirmation
+// (POST /projects/{project_id}/executions/{execution_id}/approve)
+func (_ Unimplemented) ApproveProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // OutputProjectExecution Output a specific execution for a project
 // (GET /projects/{project_id}/executions/{execution_id}/output)
 func (_ Unimplemented) OutputProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID) {
@@ -30360,6 +35914,24 @@ func (_ Unimplemented) PurgeProjectExecution(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// RejectProjectExecution Reject a specific execution awaiting confirmation
+// (POST /projects/{project_id}/executions/{execution_id}/reject)
+func (_ Unimplemented) RejectProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// RerunProjectExecution Rerun a specific execution with the same parameters
+// (POST /projects/{project_id}/executions/{execution_id}/rerun)
+func (_ Unimplemented) RerunProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// StopProjectExecution Stop a specific execution for a project
+// (POST /projects/{project_id}/executions/{execution_id}/stop)
+func (_ Unimplemented) StopProjectExecution(w http.ResponseWriter, r *http.Request, projectID ProjectID, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // DeleteProjectFromGroup Unlink a group from project
 // (DELETE /projects/{project_id}/groups)
 func (_ Unimplemented) DeleteProjectFromGroup(w http.ResponseWriter, r *http.Request, projectID ProjectID) {
@@ -30414,6 +35986,24 @@ func (_ Unimplemented) UpdateProjectInventory(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ListProjectJoins Fetch all join tokens for a project
+// (GET /projects/{project_id}/joins)
+func (_ Unimplemented) ListProjectJoins(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectJoinsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreateProjectJoin Create a new join token
+// (POST /projects/{project_id}/joins)
+func (_ Unimplemented) CreateProjectJoin(w http.ResponseWriter, r *http.Request, projectID ProjectID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteProjectJoin Delete a specific join token for a project
+// (DELETE /projects/{project_id}/joins/{join_id})
+func (_ Unimplemented) DeleteProjectJoin(w http.ResponseWriter, r *http.Request, projectID ProjectID, joinID JoinID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListProjectRepositories Fetch all repositories for a project
 // (GET /projects/{project_id}/repositories)
 func (_ Unimplemented) ListProjectRepositories(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectRepositoriesParams) {
@@ -30474,6 +36064,18 @@ func (_ Unimplemented) UpdateProjectRunner(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// RevokeProjectRunner Revoke the token of a specific runner for a project
+// (POST /projects/{project_id}/runners/{runner_id}/revoke)
+func (_ Unimplemented) RevokeProjectRunner(w http.ResponseWriter, r *http.Request, projectID ProjectID, runnerID RunnerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// RotateProjectRunner Rotate the token of a specific runner for a project
+// (POST /projects/{project_id}/runners/{runner_id}/rotate)
+func (_ Unimplemented) RotateProjectRunner(w http.ResponseWriter, r *http.Request, projectID ProjectID, runnerID RunnerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListProjectSchedules Fetch all schedules for a project
 // (GET /projects/{project_id}/schedules)
 func (_ Unimplemented) ListProjectSchedules(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectSchedulesParams) {
@@ -30494,7 +36096,7 @@ func (_ Unimplemented) DeleteProjectSchedule(w http.ResponseWriter, r *http.Requ
 
 // ShowProjectSchedule Fetch a specific schedule for a project
 // (GET /projects/{project_id}/schedules/{schedule_id})
-func (_ Unimplemented) ShowProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID) {
+func (_ Unimplemented) ShowProjectSchedule(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID, params ShowProjectScheduleParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -30504,6 +36106,12 @@ func (_ Unimplemented) UpdateProjectSchedule(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ListProjectScheduleRuns Fetch the run history of a schedule for a project
+// (GET /projects/{project_id}/schedules/{schedule_id}/runs)
+func (_ Unimplemented) ListProjectScheduleRuns(w http.ResponseWriter, r *http.Request, projectID ProjectID, scheduleID ScheduleID, params ListProjectScheduleRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListProjectTemplates Fetch all templates for a project
 // (GET /projects/{project_id}/templates)
 func (_ Unimplemented) ListProjectTemplates(w http.ResponseWriter, r *http.Request, projectID ProjectID, params ListProjectTemplatesParams) {
@@ -30594,6 +36202,48 @@ func (_ Unimplemented) PermitProjectUser(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// ClaimRunnerExecution Claim the next waiting execution
+// (POST /runner/executions)
+func (_ Unimplemented) ClaimRunnerExecution(w http.ResponseWriter, r *http.Request, params ClaimRunnerExecutionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ShowRunnerExecution Fetch a specific claimed execution
+// (GET /runner/executions/{execution_id})
+func (_ Unimplemented) ShowRunnerExecution(w http.ResponseWriter, r *http.Request, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdateRunnerExecution Update the status of a claimed execution
+// (PUT /runner/executions/{execution_id})
+func (_ Unimplemented) UpdateRunnerExecution(w http.ResponseWriter, r *http.Request, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreateRunnerOutput Append output to a claimed execution
+// (POST /runner/executions/{execution_id}/output)
+func (_ Unimplemented) CreateRunnerOutput(w http.ResponseWriter, r *http.Request, executionID ExecutionID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// HeartbeatRunner Send a heartbeat for the authenticated runner
+// (POST /runner/heartbeat)
+func (_ Unimplemented) HeartbeatRunner(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// JoinRunner Exchange a join token for a new runner
+// (POST /runner/join)
+func (_ Unimplemented) JoinRunner(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// RegisterRunner Register the authenticated runner
+// (POST /runner/register)
+func (_ Unimplemented) RegisterRunner(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListGlobalRunners Fetch all runners
 // (GET /runners)
 func (_ Unimplemented) ListGlobalRunners(w http.ResponseWriter, r *http.Request, params ListGlobalRunnersParams) {
@@ -30624,6 +36274,18 @@ func (_ Unimplemented) UpdateGlobalRunner(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// RevokeGlobalRunner Revoke the token of a specific runner
+// (POST /runners/{runner_id}/revoke)
+func (_ Unimplemented) RevokeGlobalRunner(w http.ResponseWriter, r *http.Request, runnerID RunnerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// RotateGlobalRunner Rotate the token of a specific runner
+// (POST /runners/{runner_id}/rotate)
+func (_ Unimplemented) RotateGlobalRunner(w http.ResponseWriter, r *http.Request, runnerID RunnerID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ListUsers Fetch all available users
 // (GET /users)
 func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams) {
@@ -31442,6 +37104,105 @@ func (siw *ServerInterfaceWrapper) PermitGroupUser(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// ListGlobalJoins operation middleware
+func (siw *ServerInterfaceWrapper) ListGlobalJoins(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListGlobalJoinsParams
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", r.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "search"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", r.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGlobalJoins(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGlobalJoin operation middleware
+func (siw *ServerInterfaceWrapper) CreateGlobalJoin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGlobalJoin(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGlobalJoin operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGlobalJoin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "join_id" -------------
+	var joinID JoinID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "join_id", chi.URLParam(r, "join_id"), &joinID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "join_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGlobalJoin(w, r, joinID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ShowProfile operation middleware
 func (siw *ServerInterfaceWrapper) ShowProfile(w http.ResponseWriter, r *http.Request) {
 
@@ -32615,6 +38376,41 @@ func (siw *ServerInterfaceWrapper) ShowProjectExecution(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// ApproveProjectExecution operation middleware
+func (siw *ServerInterfaceWrapper) ApproveProjectExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveProjectExecution(w, r, projectID, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // OutputProjectExecution operation middleware
 func (siw *ServerInterfaceWrapper) OutputProjectExecution(w http.ResponseWriter, r *http.Request) {
 
@@ -32685,6 +38481,111 @@ func (siw *ServerInterfaceWrapper) PurgeProjectExecution(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// RejectProjectExecution operation middleware
+func (siw *ServerInterfaceWrapper) RejectProjectExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectProjectExecution(w, r, projectID, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RerunProjectExecution operation middleware
+func (siw *ServerInterfaceWrapper) RerunProjectExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RerunProjectExecution(w, r, projectID, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopProjectExecution operation middleware
+func (siw *ServerInterfaceWrapper) StopProjectExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopProjectExecution(w, r, projectID, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteProjectFromGroup operation middleware
 func (siw *ServerInterfaceWrapper) DeleteProjectFromGroup(w http.ResponseWriter, r *http.Request) {
 
@@ -33082,6 +38983,135 @@ func (siw *ServerInterfaceWrapper) UpdateProjectInventory(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// ListProjectJoins operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectJoins(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectJoinsParams
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", r.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "search"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", r.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectJoins(w, r, projectID, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectJoin operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectJoin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectJoin(w, r, projectID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectJoin operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectJoin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "join_id" -------------
+	var joinID JoinID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "join_id", chi.URLParam(r, "join_id"), &joinID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "join_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectJoin(w, r, projectID, joinID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListProjectRepositories operation middleware
 func (siw *ServerInterfaceWrapper) ListProjectRepositories(w http.ResponseWriter, r *http.Request) {
 
@@ -33532,6 +39562,76 @@ func (siw *ServerInterfaceWrapper) UpdateProjectRunner(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// RevokeProjectRunner operation middleware
+func (siw *ServerInterfaceWrapper) RevokeProjectRunner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runner_id" -------------
+	var runnerID RunnerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runner_id", chi.URLParam(r, "runner_id"), &runnerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runner_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeProjectRunner(w, r, projectID, runnerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateProjectRunner operation middleware
+func (siw *ServerInterfaceWrapper) RotateProjectRunner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "runner_id" -------------
+	var runnerID RunnerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runner_id", chi.URLParam(r, "runner_id"), &runnerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runner_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateProjectRunner(w, r, projectID, runnerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListProjectSchedules operation middleware
 func (siw *ServerInterfaceWrapper) ListProjectSchedules(w http.ResponseWriter, r *http.Request) {
 
@@ -33677,7 +39777,93 @@ func (siw *ServerInterfaceWrapper) DeleteProjectSchedule(w http.ResponseWriter,
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProjectSchedule(w, r, projectID, scheduleID)
+		siw.Handler.DeleteProjectSchedule(w, r, projectID, scheduleID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ShowProjectSchedule operation middleware
+func (siw *ServerInterfaceWrapper) ShowProjectSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schedule_id" -------------
+	var scheduleID ScheduleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schedule_id", chi.URLParam(r, "schedule_id"), &scheduleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ShowProjectScheduleParams
+
+	// ------------- Optional query parameter "next" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "next", r.URL.Query(), &params.Next, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "next"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "next", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShowProjectSchedule(w, r, projectID, scheduleID, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "project_id" -------------
+	var projectID ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "schedule_id" -------------
+	var scheduleID ScheduleID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schedule_id", chi.URLParam(r, "schedule_id"), &scheduleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectSchedule(w, r, projectID, scheduleID)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -33687,8 +39873,8 @@ func (siw *ServerInterfaceWrapper) DeleteProjectSchedule(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ShowProjectSchedule operation middleware
-func (siw *ServerInterfaceWrapper) ShowProjectSchedule(w http.ResponseWriter, r *http.Request) {
+// ListProjectScheduleRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectScheduleRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
@@ -33711,43 +39897,37 @@ func (siw *ServerInterfaceWrapper) ShowProjectSchedule(w http.ResponseWriter, r
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ShowProjectSchedule(w, r, projectID, scheduleID)
-	}))
-
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateProjectSchedule operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProjectSchedule(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-	_ = err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectScheduleRunsParams
 
-	// ------------- Path parameter "project_id" -------------
-	var projectID ProjectID
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "project_id", chi.URLParam(r, "project_id"), &projectID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
 		return
 	}
 
-	// ------------- Path parameter "schedule_id" -------------
-	var scheduleID ScheduleID
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "schedule_id", chi.URLParam(r, "schedule_id"), &scheduleID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", r.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProjectSchedule(w, r, projectID, scheduleID)
+		siw.Handler.ListProjectScheduleRuns(w, r, projectID, scheduleID, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -34400,6 +40580,159 @@ func (siw *ServerInterfaceWrapper) PermitProjectUser(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// ClaimRunnerExecution operation middleware
+func (siw *ServerInterfaceWrapper) ClaimRunnerExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ClaimRunnerExecutionParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "wait", r.URL.Query(), &params.Wait, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "wait"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClaimRunnerExecution(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ShowRunnerExecution operation middleware
+func (siw *ServerInterfaceWrapper) ShowRunnerExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShowRunnerExecution(w, r, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRunnerExecution operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRunnerExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRunnerExecution(w, r, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateRunnerOutput operation middleware
+func (siw *ServerInterfaceWrapper) CreateRunnerOutput(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "execution_id" -------------
+	var executionID ExecutionID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "execution_id", chi.URLParam(r, "execution_id"), &executionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRunnerOutput(w, r, executionID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HeartbeatRunner operation middleware
+func (siw *ServerInterfaceWrapper) HeartbeatRunner(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeartbeatRunner(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// JoinRunner operation middleware
+func (siw *ServerInterfaceWrapper) JoinRunner(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.JoinRunner(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterRunner operation middleware
+func (siw *ServerInterfaceWrapper) RegisterRunner(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterRunner(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListGlobalRunners operation middleware
 func (siw *ServerInterfaceWrapper) ListGlobalRunners(w http.ResponseWriter, r *http.Request) {
 
@@ -34577,6 +40910,58 @@ func (siw *ServerInterfaceWrapper) UpdateGlobalRunner(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// RevokeGlobalRunner operation middleware
+func (siw *ServerInterfaceWrapper) RevokeGlobalRunner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "runner_id" -------------
+	var runnerID RunnerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runner_id", chi.URLParam(r, "runner_id"), &runnerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runner_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeGlobalRunner(w, r, runnerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateGlobalRunner operation middleware
+func (siw *ServerInterfaceWrapper) RotateGlobalRunner(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "runner_id" -------------
+	var runnerID RunnerID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runner_id", chi.URLParam(r, "runner_id"), &runnerID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: r.URL.RawPath == ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runner_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateGlobalRunner(w, r, runnerID)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListUsers operation middleware
 func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
 
@@ -35298,6 +41683,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/projects/{project_id}/runners/{runner_id}", wrapper.UpdateProjectRunner)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/runners/{runner_id}/rotate", wrapper.RotateProjectRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/runners/{runner_id}/revoke", wrapper.RevokeProjectRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{project_id}/joins", wrapper.ListProjectJoins)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/joins", wrapper.CreateProjectJoin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{project_id}/joins/{join_id}", wrapper.DeleteProjectJoin)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{project_id}/credentials", wrapper.ListProjectCredentials)
 	})
@@ -35424,6 +41824,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/projects/{project_id}/schedules/{schedule_id}", wrapper.UpdateProjectSchedule)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{project_id}/schedules/{schedule_id}/runs", wrapper.ListProjectScheduleRuns)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{project_id}/executions", wrapper.ListProjectExecutions)
 	})
@@ -35442,6 +41845,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{project_id}/executions/{execution_id}/output", wrapper.OutputProjectExecution)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/executions/{execution_id}/approve", wrapper.ApproveProjectExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/executions/{execution_id}/reject", wrapper.RejectProjectExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/executions/{execution_id}/rerun", wrapper.RerunProjectExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{project_id}/executions/{execution_id}/stop", wrapper.StopProjectExecution)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/events", wrapper.ListGlobalEvents)
 	})
@@ -35460,6 +41875,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/runners/{runner_id}", wrapper.UpdateGlobalRunner)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runners/{runner_id}/rotate", wrapper.RotateGlobalRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runners/{runner_id}/revoke", wrapper.RevokeGlobalRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/joins", wrapper.ListGlobalJoins)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/joins", wrapper.CreateGlobalJoin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/joins/{join_id}", wrapper.DeleteGlobalJoin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runner/join", wrapper.JoinRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runner/register", wrapper.RegisterRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runner/heartbeat", wrapper.HeartbeatRunner)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runner/executions", wrapper.ClaimRunnerExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/runner/executions/{execution_id}", wrapper.ShowRunnerExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/runner/executions/{execution_id}", wrapper.UpdateRunnerExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/runner/executions/{execution_id}/output", wrapper.CreateRunnerOutput)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/groups", wrapper.ListGroups)
 	})
@@ -35547,135 +41998,170 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7F1fc9s4kv8qLN49KlGSzV1d+emcTCab2szEa8dTVzXlSsEUJHFCkVoQlON1+btf4S9B8R8IQCKl4Cmx",
-	"CIKN7h8a3ehG4ymMss02S2GK8/DiKdwCBDYQQ0T/uizw+n22gFfkV/LDAuYRirc4ztLwgj4OomwBw1kY",
-	"kx/+VUD0GM7CFGxgeBHyR3m0hhtAXsePW/J7jlGcrsLn5xnt4gplu3gBUdtX0iBewBTHyxiiYJmhAK9h",
-	"AMi3t/xN8f0twOvy88pTBP9VxAguwguMCthB0iz88QL+AJttQn5dxXhd3IeczhsMcCcrctKghRfiWRcz",
-	"3iNIBwqStq8EkWyiMiVDQZ4Uq2Y2lK98ixfmvCi7efE6JI9W2Qv+hZLuT7+Q1z6kuxhl6QamuHUgsGyj",
-	"PRLlHauhKP3UxqLQzgfzA0YFIbt9KKKF/kDEG3bDEL3UByGesCF8RFmxbSV/RZ5qk05bW5FNe6iRTGlk",
-	"5H5KdzDFGXpsJTkWLbTJlm9YkS57qZEvaWZDuAKrOF19jjdx2wxgLYKENGlRGeJZSdwCLkGR4PDi9atX",
-	"M0FqnGK4gmiP1tevXkk6viyXOewhJKNtWiiRDxtI6SOEkoGyv2DUrgy27Lm2NHl7K1nyPmqS5LQyOV7D",
-	"bZbHnVhEsok2+eUrViMou6kNoqSbj6NI0/YFNkD0sT79tLkd7bSLOt30Z0bzTbSGiyJpXXGDnDfQplu8",
-	"YEW56KRGu6CXUw8Bitb/JPOoZQCsRSCmWqPRQJv0WA03MEKwfW7l9LE+j2hzOw7RLur8oT9z7mQIv8+S",
-	"YtO2qpIGRC1FtFEbezKE+5iTIfwFtRuX4jsZUszIfe3HnzUovxDkUTgLYVpswos/+V/kC+HdrJtJtBEh",
-	"sEA72K5dcvpYX3q0uZ30aBd16dGfmfS+ws026bCFA8wbaNMtXrCiXHRSo13Qy6i/zTs0YZEP0IOksRXF",
-	"fy0yuEcroY7R+QdIinYW78hTbUppaytSaQ81zlIaBblFgjvILRI8gNwiwZbkFgluILdIqAZ6Zh3DHL/L",
-	"FjGkzu57BAGGH5PsHiRsHXqXLR7JkyhLMUwx+S/YbpM4AmRc879yMrgnhaQtyrYQYd4h++g+oYSitEgS",
-	"cE8IZYP68SLbxAS++JH99DxTbZzGPposFt2+KeeNKcPZd5iavv4s9WJ2T6gOn8lPVbh8XUNhkiwABgHO",
-	"iN/L/OcqHpjTTMRG3IdR5WXDU12mMFdNlyccF5ZcWcBNpozrPssSCFJttkyfqcL5GMjWctPDksHf43Rh",
-	"zqEkW8W0+/9EcBlehP8xLzf05uyT+byk9TNtfhzhZTuIULyAFujJ1zBJ9Ed3Q5tPCVzKft1AfCkbUePq",
-	"NWq2047Io7xPGgrdzOIPS14BhMDjkVYparAYkU2NGkOqdXGhbn+aA4Mx2BIeymtmnLZTYDboNOE294fN",
-	"mU7h4Xk+hOfMWRnKcrGJbW3A3Ff0yNA1SPVNeyxxxdF0zEwZYhjIRLkvbcnEexhlGx0WvKMNB/gi1lOh",
-	"Gt/qIa8Sqpr+ZJvt7RX3jK6y7TslM6gM2AzEbzkiWwAjkEbrMVA2qhM0CwuU9JF7e/3ZtfdeBkWGCnz8",
-	"nZfz2x3hvBURCkvuggjHOxuvLkJZOpY+tRTuBGwBGfYaKH1BkK30kyR7+ObAtwdoVWxE3pGZOGyVeoW5",
-	"pp3sZaX0wKKaX6L9DWr/ZcicykoCRA+NajKD9j4UTVZwPKmfZywwcKbW04wH2/R3KcQcZtE4450VGh0Z",
-	"/lUaPjnwxogMHRpqN8YZa5fRgV4Y12sQvDJ3e2OcwKNtv5UQO/zemy3E6DSw3QA6a6eVcfzQRvBgOd7m",
-	"1p5FafvKHBA2DFMjaLFh4RvZ3RIkuXl/cAPixFxwyyJJLMPWIM8fMkSht8zQBmAa3ec/zkyd2ByiY2xa",
-	"0vSPfjixhFqmFX5BmW3gmUcgmzOYyo//KRveDYoX7wc4izSJ0+9947qCaGM7Log2zUbd0AHPWF924yZd",
-	"0ITa5nET7eBAmARC/QOjrYYNp4LNHhnSHKcDCnDAII1EVxlru9xoNPuywGvbcQ5SWp36qIkHtKWiGu8G",
-	"B49zwgdQ4DX5M2rRSnziUgA4ADKVRP8AWbO7gckW1UyWdjSrY3IA6LYxzdqg3jjYgZBuHnM7qvmYp6uP",
-	"xIA0NZIyntPUSY3jbZffNVzECEbYgWJq2UzeHxRrdqe9Oc/oC+hrOprldrvwqZFT3fwvqHA6xPYTpkb2",
-	"8eQKZcvYOhDinS3bxEsihCFCc5DPenoJqZp88QmpPiHVKCFVE18+IfVnS0gdDgyfkHrAhNQ2pvuE1IMl",
-	"pFZY7nMpfS7lJHMpNfX0GeRSnkKO2FQSLnVR4RMu3e+5+ITLc0641JS+T7g83YTLk0yX1N829DmVP3VO",
-	"5UAF5nMqfU7lgSHmcyonmlPZJ0enOZW2SZQ+aXL0pMkOvOQQTTo3iY5DNzFJjuZUs5IaRtue0kJzd6af",
-	"70rHpJ/uqozqlLNdG0fdJkv6yXybpTmj+jIiPf0K4gQuPiDEjphpc6DL/Pg9w/GSv9pEOvsmoZWdboMB",
-	"oLTQEt8I5lmBImq2XCYIgsXjJcaAeOLHpfKaExLEeQAYIQHglBDi3oFFaUrkx6XtNgUFXmco/jdcBA8x",
-	"XgcPKEtXat4oJ/GaFbsbS8BbgHIY8Ip7tCI1TSX7QPzm/JrD0SYRZye2NfRCq6R5zSBWvXK5CMcp/u+3",
-	"YVOJY14TWa8xzjBItNrWMvvIizNZC1pWYuZD1tERl0GUJQlkkytbBvxVKQa2A2wkhi4us27blNYCYhAn",
-	"OZ3sgG+v7pPkAhoHEykjWR9013KI+6hzDw5Bmwk6xLt7x0FcyELaOV18oh896GTkS6W+6FQ+HEeAkkQT",
-	"CVZOv5SCdD7BuaR05vdKCFWeTjkjPOmLn/lk+sCTTkYddbpIYh80hxF7X0jOmdQGTr4TX6z5kI2lkLNr",
-	"MTDx55MbiHYQHdeUu8k2MIg5AUFOKQggJYFd2AGSePE1+w7TsUzMFUwhAhgGGQooNeT/LDQsDoo5V4CX",
-	"BV5/ZZ+ok/aRE7TgxyyofQ5/bGNEN9eoZ/N7hsf3atIMVzwaQpR0K47s0NDq65wkSQMn6tesSMdiEyFo",
-	"Sb4fsvNMyziBzuHE+23N3C0Qgiku0+bZChuWJ6xKR9Q5bWXXegu+kmacLQMgrJFGYl0sKqrLq7uyqGM6",
-	"4vKibAf1oEHam84XJJVdJquSejKVCbwuYCXw7hyOSt96eFSTP1sAWUtjPiTVIhNdg/a0KVu4fww06nfI",
-	"IfCw4uARsNzb/gE42RhSujPJvP/pFEOFYUabS0oH7arhp9n4m4RMbbcK2+UoyhK71zOiZz31LlP02tSK",
-	"6M4J6GRn+sArh/Ozga9klgkAhWDbMTjqvkTHpuBZS9ViQ6NSDUP1XkQaZQxdSDMue9MWqUzk/OnkqXLL",
-	"RKjK+60TVXLX+WKhyE1nsSgP0LSsFl8KvC3MzH8tnLH+G/Z0G4lPshW9oZU7MvImgBbiZcatm3k0Idgj",
-	"ZWT6YTeZgHyk0JtKpFH8TemgdS6Vo3IftlUYphW6LU8etSLS/UZZVz5Mlb4GeqYS725l2PSj3waz97zj",
-	"5a1oE2fAnONNdOzGQxK9nRnqpP+gjbuSrcdAXknfYbwjkV/vHH2iYz30ybTyFvRVT5ocjFhxxGcQyfwK",
-	"5h7K6QGGgxHOTwkNoptdudtD9plNdzH44YdkjjPdS/pMprt8u3W6u8qsOUln1yS7pnUD5/D5NWpVSXUf",
-	"5BTkp8/k00mbUxPmrlC2ixdu5tJW9DWEZ/SNgzCtJMeESaDA66DsglY7XSKYr4+bXMM/2plcc1NEEczz",
-	"32CegxU8Ws7IVQLiNMjZx4MN//rzLKSDGTcHKYU0b4vwiZ9NkRmGI+ygd2Q3TihbU2cUR9k4Lw9s5WH1",
-	"8NJ5rRidy/LY8rNaYdSDWlKGzjUCH5BOWgblD6dj0iA6rBE4hvUnrb4/WLJqnI2WOivTZZUDWn9AFC8f",
-	"D7Jasa6bSPoNYkDPLxJfZw2ZscFzePlezgawU4ty1WuIzhMrIP8GqtgiA3yB4w07mgwWX9Lkce/uHtO6",
-	"U93d6dZqn4UKc5ryG8k6fvhRqYfGhw1Mvtk0NiXXcr8WSPhbtoAJASKCWwRzmGIlv5DpXJeceJ6FGjV9",
-	"6L29ot4DTIsNGSJjlagCLGod3zV8YYQqyN0Fjg3L5o9T8pjgkNYRsBFzWW9FRV8nNj8LqQ0AaJBw2bVf",
-	"KTPw+pg61YHARwftN0JMQ2gXQB5A+xbFO4Dht+/w0c3YBGBqY1PzMHXGpaQejqo12u8yNZyAzgteH3bi",
-	"uS91rSLnQ0XKXaDhgx8IHZ5hXUdQW21di/VkBxDLuW1cQ/pnkTLY4EaQ3cUSxt6hHKHiPDmG/MGprvNj",
-	"p61Rdk26hJWpUMmWBaRl9ZsFTCCGjaMAGCO1BGNJmAslxXr7tojzbQKaNTRv0i+jL/elEuIvseaVkZcr",
-	"a1UBy0wlse3xraxvwv7mdzGJ8jUyL6v0s7/J0jb8b3F9k+ypTD+RcXwlrKqEXLi3Jnukf5T97F2AVVfa",
-	"rRydwq1FdDDWFNJe1iBd2BSpo530j5H43YMqtYoZvmtT/BJuWjNbAadecWtH83MB7ysLr2Kfw6q9Y6xU",
-	"W2qLDr9l3dZkaewxxwAXeeMjOVV1Y7aHrSLs2gv5oGCuBt+PopZBL3QVlTgpA/dI1dVdSuQjZ2WzNK7K",
-	"WLWeUAKlgJdz4QwodqHLS7YK90ud9spEL4qcyeuqxYWKwiDIHtgKzH9mpRk71lXd+PEhV1lXsKrV5CNU",
-	"VEi628NeUA6vhsEyq10HgHvGU+26kQGHobWLgQ++x6TrihJH62tU2Wh0PGLzCrMWPhFZMOMonIW0OMAA",
-	"v8h4Mig2tX6y+OHLhx9tu/CTMpdq07ISV6nNTBpxj6NA1FikkTZaKCUPQLoQsRZ6Hm5/orJm2rsmZeCo",
-	"aX9nU6Y91BncsCjvW2UioNZjjle40cAtftRFR4NlrGnHNoPO5LdWIFD1I/TOdGrXGRZv6FxbINoS4CsM",
-	"/yK4VGO1KB+iw2teQ6Rxa2PXFftR3BVZdVmjaYHXw0KylwVeM1XU2Pew0vxOYDF2jWib5BonXNRdvw5T",
-	"zHpbottq+8U4D8UJD11srrso682VyZVUA03aRNv5OaTbYx10OY7J0GHHy2xOHVZW8itrDFV2+Po3mlC8",
-	"Yx/t53GUpVoN9RMDFL6IodQYc10xM3tZU9npPeqe3en7FM5DkoeMHmpeoKfCrOKH1IHGwgJaIBMRhOmG",
-	"jyeyY9IKgZb7+Fxr3GshqZq45Qk+HYErkSLNW0mcKZUstdrmP9qcPvNt+RslVrgPpa/K0HuhpEQaB97g",
-	"1305X8e9e86CUh13c9UjU7rF045xoZ90q1nqrPFsitXdVr1iI4e+S3AaobwT3Awc6QZAN5lTo9wiyFWh",
-	"osFbVSFn0hCFyI9Q192ZHrVjvmPOm6bF5r6MTsmw9KA99I5r/9pv9HN9WV+TkIIbwdVWWemneymnxpty",
-	"vaxtkp2gpH0dluNqTdyqon3gsMgrs9aqwZNy7FzvyO3PD2Uzjt8qOGhG2N9EWJc4k05N4re5pt9W5oVN",
-	"6WJBv+9tv+994tvOE9vxvc2bfWaJPd3JRncqD7JPslX2SxtW4+XhtxboNQSXbHyNnNJP1ioP7fqkII2k",
-	"IFezRevU52EyWpuOxbE8IMnZuz2otaahqSEobbQdMhrj079OEHfN+WcUeR1hKyW9pDnLBSRBjlER4QJB",
-	"AsN8nT0o+S0866UGw2UMk+aTZa0pK6puVsiqn7qmHl6BYvx4QxjOPvgO5HEkjyhTM47+Il9fY0yj5O8g",
-	"QEyEZUv2U63p3yHgKxSxD8M1+1PYzOH/vbi8+vTiH6pzBrbxP0TpsThdZiK1BbA5wM2scAV/wOh/H+D9",
-	"Ot5uY/hyActeP5JnIY+PUFLyi/mcvvESFmH9yPnVp2ABl3Eay2tVaRezALDrkuJIKSdLLHECfNruMs3j",
-	"+wTOv2xh+jVbFvOvECFAHtNT8BHkh8I5ZZdbEK3hizcvX1XIu5jPHx4eXgL69GWGVnP+aj7//On9h99v",
-	"PpBXXq7xJgnVrFxCVEA+fXn1KZyFO4hyNqLXL1+9fPUCJNs1eE0PpWxhCrZxeBH+jTwJ2e4Xlfqc2Cdz",
-	"eQx3m+WUzwSJFD2fFuEFuxaKL/T88Lu4BLhpFpdNYjIE8TJ9Zf9e3TevXrV3w9vNq9dSPc/Ctzpv7d/n",
-	"St97rfVe7ara51n4XzrfbLp8TJ1v4cWfd7MwLzYbgB4JIgq8Jh+KiHvHK00E94+Vq2lnIQarnOgqakve",
-	"kf6Y2Colk1awSXJxjmWRptCE9/UST13j+RXiaM2KEuxATFX3fi2k9uEguIgRX+yagXjNW5hiUX3fHI7V",
-	"CkWHhmP91rhDgfEaYhTDHQwQBAkvhQSWGKJASqZLeLTUVCsSeSkqKbmhTN+vnzUi+xSGUZrIxC1rcAT3",
-	"cJkhGMSYV9rqgvxO1rFoZBorc2HMs73qJFNgGSOJ2T8xfhQVdkCpB8kiy2Lj7Wx7EurkeR6BJLkH0fdW",
-	"Hr7nDZQUmy1AYAMxVZx/No+pbEKLsYiXr8jP4fNM66UbDDAc9Mb7bCFeuNsT+N9e/c/+aWL4A8+pUVCp",
-	"K7NvE3ZUOxMVzsoLIN++euvoK4Jj1TsB375+46j/si4PNdxAEv8blhlhJWAdfOpT84WeHZpUYK681B3T",
-	"qlLLOIULNW9NA+Ci2lC7YqXPXcL7cNgTCzBhDGGJKrBDY29MQHAh0TEjhQd6kCiv72o18th98OzCsMEQ",
-	"uIEARet/FhA9aqurK7CK09XneBPjge98oVXBWqCmta6pg62ubn/rf7nh3lZ3yxuzf0GS8CvBFHmyIglM",
-	"oGWSertAWZNjiPImQ/h9lhSbdNArX9CQBXFUvFQrd04MKaWntBIyF5hh+9Z3z7MWf+g93asU+6KD3SHl",
-	"dXNvqHppvpU3ZCyTt2/eaFjDexUE3cmS8TEAQQofZBRjX4blvJ8/iS3uZ7ZnSGug1IT7C/1dCHeYGmAH",
-	"sy2mzF5VYF2xXtKaL8w2cyJYZhP0vqpceu1OrEwAAQjyLYziZRy1ynbWrMdv1tnDSPJrmJWnKQSuJ3Vk",
-	"wE9QVmVwS0MWjqQwUL8q3z5x/WqEgJG1MuO+DnQaVfNcPQmnoaN/RdmmjBMdF2hqHY5fUGaBNkO9Pwm4",
-	"vX6j90GMQbR2b9Hdpkmcfi/vGwmWKNsMXTKk6S9qllthaeb9BWOVWysaf/qLaJLIsuoB4LMgwFnXktro",
-	"drAZRLn0NZuCzruCaON1XqtRniAIFo81vTfy+szoUfRlNxKbjDsi97iiLz0MT2rpnYaJKAC4hWjDLn0Y",
-	"ZinKqxQ0zUSe3jQCUMmXvYE4toFIszysrEN6E4k3DUcyDav3wJyFXUhVmDujcFwN59fhUzYHqXa0sgU9",
-	"+rwVONwKZKcCtExAftxpnsNk2RrFvVlnD2XlKKPMS/LuFMOWfPzynjR+EdUWojxLQRKAKMqKVE0XFAfE",
-	"evbpVX4ZbbbzDsznXyPXf7aAJp8Rj1mBguwhlfKOU3bmgx8rqMlWnRuyvk3j5KDJhTazoyEJd+y5IfNn",
-	"QRoUKa1JARfq7WwdLJORhq40brMNYW/HN09zl7u7B0wK2ZZyV9BD97r6EkOulOs/jFJDeAdW2pR04NND",
-	"ZHpIeeywLk1VF9AUUH4eTSNNxHT7U+xc+lQRx6kiXXKedRqMY8mxca6eSdJItzS6DVJX8jC1Z89CA59H",
-	"AomR8p6rh/k0LLz3lbN/NrDze7w2ilCRw3nt9Sp4ZOevOnBdqeqraW9WikAdW222UGKtQMuuvCq1NIEr",
-	"lyH2AE5Lq86fKkXE9M1lZ0jtV07lp7yt7drWVm5qHqzP+izxk4NIj746E4PeRuT95v7oQrfxFfyiN1n/",
-	"wRy17eugUhVay734oLb3/sV4/oUqiPNyMFRIagBdLZuu6WJUy6eP6mMopFjrW6Uvr3AtvYzqLet9qNPT",
-	"r/Onag1+fU/DHWD79ZTyLe9ruPY1FAAYqLY+b+MEYdKnus7E4bCTe7/LMQHJ2zgdfhWcsNthg13DhXHO",
-	"7pDI22v5tRlRN+zyidOYA92DcDkTWI9+PlhahQyWQZYGID2OiShmwvyJ/cfUbBxtXuh4+YQ0b2u6sjVb",
-	"MHpou+OkEObIWPGKeoqGi6sJYKqyy0uphtku4kqmUzZd6BhcTgjaoZ8PloYLheRR7RY2CeZP9F9Tq2Ws",
-	"GdH/DqXM2yyObJYWeB7aZDkheDkyWLx6npy54gr7HYp6px3d3U02rjuFkOvuDIOtu84wq1p2uAVc4m4b",
-	"PYCVrX3ywIhIlmI4MzTLcemoT9FYO21AvjB20oAgxH4lFz35Jdw2YUABRzfWdHTp/En+f5jv5AiiGlat",
-	"+JJ3gpwnCcjr4oYqsd4EgdOCR7eSOpfUAENpG6iReVZg7iA3IuULfXzSWGFDOAegsJGMhZRtgdgloY1A",
-	"uSJP/ZJzqtCi4jsssso7arTsll9RtjGrsm9vWvMe6Od9dcuxq1tS4LDylgZFEJStBsMrkPw2g7ttBqc3",
-	"J01ki4Eptkqty57yEB31LjmfvmZT0H2+8uAp171kerMfje3FL1UseCj6IpgG0SwGwrIKplnFkzjdwRRn",
-	"KIZaMYVPSnO/2o+32ityOK8lX8Gjhn8kWj/qhhU+yRdGDitIQqzDCrInH1awDCvECji6saalT+dP8pVB",
-	"cQVXGO1XSvJLfpPHdVxBin6wFuuLK5wYPLq11JnEFcyl3Z9DN7K8bZLh/CI30WOGpnhtX/cQ3GZ5rO9I",
-	"XKvtvScxniehCuK8XAkVkho4l821nYnr8o2RvYmSEgflZ0VXXtVa+hNIxUcP4PQ06/ypfGmQT+EMqv36",
-	"qfyU9ypcexWl9IcrtD6/4uQg0qOwzsS1sBF5v3MxutBt3Au/6k3WwTBHbcdCWKQpv72117vgTb1jMaJj",
-	"wWRwZj4FG5QOqGlLbVeCtR7bjaBU2CtT2o1XpLbug8BEB7h6teX8if1nmLfgAo0aFgH9jPcSnHsJlK/D",
-	"dFSvd3AqkOjQQefiERiIV8MTGEvAVh6AX7AmZ/kPRmf7GpZHa7goEr2Iwo1s7K3+8ax+KYXzsvslEjWA",
-	"Ldrq2v6CZWNb/4IOa3UqOvIK1bYEZomMTphpaND5k/jvIE/AETY1lB3/kPcGXHsDQu5DNVefR3BS0OjU",
-	"TGfiF5gKut83GFXUNv6BX9Im6SOYIbV9lcNws00A1vMTvsrG3k8Yz0+QUjgvP0EiUQPaoq2unyBYNraf",
-	"IOiwVqqiI69ULf0EXCKjE2YaGnT+JP47yE9whM1+NSQ+5P0E136CkPtQzdXnJ5wUNDo105n4CaaC7vcT",
-	"RhW1jZ/gl7RJ+glmSB28ys3zAu3go+4tAkLGN/StU8B6B/nOEM+687i33fKlbKQlqg9n1gnAz5/Yf4xM",
-	"vXHgr+EOU7q8fejKPmwA5OEsh9MBlQtzw6vgyZke1mgfrox3oEjwUOPjD/LSydoelHpnuKe9edhbX1tU",
-	"JPjQhgfD+vyJ/mtkdowCfJ1LZIrE36vo7o6iGhYPZ3OcCqJcWBxe8U7tQiJLoLer4CLnh6W0iwLf5qMc",
-	"QeE9kK/7ksBjlwQmqHFREfg29+fvxo2wUwmcV3SdqjTX5YDH13q+AuspFwOmGtNBLWCPQ18J2MyMpAjU",
-	"LwSsc47+Y5Ldg8T0GL1flhvEWWGpo3X5UOfbFexonmNXRxcabxOqvZhrIrWXsb3TCW3wrShb6sfKKwfx",
-	"jI6N7wl/mLLwp8APeQpckXO+BgguNI99jyzS9il8Voe4e6TTsYfpWD5Ge4vnp63P6Qh2m4aX+4Ktxp/Z",
-	"3o03+hrE53ITxrmxB3YgTsB9wjwI1egjf/eafNxhNTT1yNvmSoO87U07adoVeWW+c/HJ2T5/Iv/o2XJG",
-	"2xB098BbcI4tuGapdhhto8iuPhXPxERrY3+HVeZEAEa22Omr0/OwvbQ1sfatrUQ25le22oIrh8hf1jq1",
-	"y1oHLQzCoje8o1UBkI/HGi+QP8ntrK1LZkcglrDH9FJWV9rNR77O4jrWQRYbC75KCHjs+air1f2r+paf",
-	"yNfTt/2uZEj36Bjln/b239j2H0eNhQV4JXDnbcAxbEDB/vOyAoUyc2QHjq/p/Hp8yrag0JLm1qBHoLcI",
-	"zSxCgb1em5C8DKMCxfiRIuvvECwgCi/+vCNL0jsIkPIXyOOI/nFH3iIkMDgWKAkvwjXG2/xiPsfo8eUK",
-	"/oDRS1jMwTae716Hz3fP/x8AAP//",
+	"7H3vc9s28+e/wuHdSyVK0vbmzq8uSdM0z7dP46+d9LmZjscDk5DEhiJYEJTjevy/3+AXCYq/QAAUJZmv",
+	"mloAuNj9YLG72AUe/QBtU5TAhGT+xaOfAgy2kEDM/u9tTjbvUQgv6V/pH0KYBThKSYQS/4L97AUohP7C",
+	"j+gf/s4hfvAXfgK20L/wxU9ZsIFbQLuTh5T+PSM4Stb+09OCDXGJ0S4KIW77SuJFIUxItIog9lYIe2QD",
+	"PUC/nYqe8vspIJvy88qvGP6dRxiG/gXBOewgaeF/fwG/g20a07+uI7LJ73xB5zUBpJMVGW3Qwgv5Wxcz",
+	"3mPIJgritq94QdFEZQrCXhbn62Y2lF1uo9CcF+UwL1779Kc1eiG+UNL96Wfa7UOyizBKtjAhrROBZRvt",
+	"mSh9rKaijFObi0K7mMx3GOSU7PapyBb6E5E97KYhR6lPQv7Cp/ARozxtJX9Nf9UmnbW2IpuNUCOZ0cjJ",
+	"/ZTsYEIQfmglOZIttMkueliRXoxSI7+gmU/hXyhqx8tfKEo8gr5BFTDNZNOmdqs2e5OSVfZT+Grz7U32",
+	"JkO7N+s90imtnOpLsI6S9W/RNmpbt7yFF9MmLYpO/lYSGMIVyGPiX7x+9WohyY0SAtcQ79H7+tWrgo7P",
+	"q1UGewhBrE0LJcWPDaT0EcLIwOgvGLSrsJT/ro1B0d5KnmKMGv4ErVyOVzBFWdS5gnDRRJv8sovVDMph",
+	"apMo6RbzyJOk3SzwMPtZn37W3I52NkSdbvZnleb/gNYldA0DlISZR5B3DyLCDBrA/kkBXej0FkzTdmaI",
+	"/oFC+jrYwDCP4e/wext9v+fbO8rKlZenAdpSonCeZC30JPB7Cz0/9dDzk0JOq4gz0UBbyLKDlZjlIDVB",
+	"S3q5qK8hwMHmvylDWmVNW3iSZ412IWvSYxhewwDDdkWUsZ/1ecSa23GIDVHnD/uz4A7C5D2K823bRkgb",
+	"UHQFrFEbexAmfcxBmHzG7f6D/A7Ciqewv1WI3xpw7IMs8Bc+TPKtf/Gn+D/6Bf9m0c0k1ogSmOMdbFfF",
+	"GftZX3qsuZ302BB16bE/c+l9gds07nB3PCIaaNMtO1hRLgep0S7p5dR/zTq2jTwbsGnQxlYU/xUiuEcr",
+	"pY7T+QeI83YW7+iv2pSy1lakshFqnGU0SnLzmHSQm8dkALl5TCzJzWPSQG4eMw30xAeGGXmHwgiyeMZ7",
+	"DAGBH2N0B2Jq9r5D4QP9e4ASAhNC/wnSNI4CQGe1/CujU3tUCEoxSiEmYjj4PY0wzG4B67lCeEv/5YcU",
+	"oCTaQn9fQVBSkzyOwV0M5Wy/v0DbiOKaPPA/PS38GNzBmH0ChGFEaQHxZeXTNc6IP6A7agZqf4gzrWE4",
+	"xVat/dxmeep+NM8qc5DmgV732lSf6J+qWPyygaqTFQICqLkVMOnXAPe0qOCCG3CWyJhYgLq9x5QyW/rG",
+	"lDHJmXbXxYhwILTxQUMUlsCwk5cNT3WZwsNBujwRuLDkSgi3SJnXHUIxBMmBFsEhmCpDBQPZWgZWLRn8",
+	"LUpCcw7FaB2x4f8nhiv/wv8fy/LQYMk/mS1LWn9jzQ8jPLSDGEchtEBPtoFxrD+7a9b8mMClnAkMxJcS",
+	"7J5WrzG/kQ1Ef8r6pKHQzV1OZQMFGIOHA+1SzGI2IptZ1YZU6+JCPWIxBwZnsCU8lG5mnLZTYDboNOG2",
+	"CMiYM53BY+b5EJ5zb3koy2W41ZLXIMnuIR5m8O+fcrMRivNtERVCK/Z/Mu7hm/oJAK/zrTzk3/uy/Iny",
+	"LQVZ5kVJRiAI9z/ulYOYOrZ3GCTBpk7DO/Z3JrgNDL6hnLQSIYYwpSCEd/nawlpQIFefxr+uP//ucclQ",
+	"uuF3gsEeSHFEv2DOQX68VvsyO7Sj/KOofWhlnjycM/s2i+A0HMURJjmcJ63fFbEfw8/iCOGIPDR8Wvzi",
+	"3UdkQ/39DaTB9hwuPBG/ZaD+6RVbVluQ5CAuj1gUIQyLQUiDRRMAYjdwiwM1nNrjuyuxUcfqt0h8GKh2",
+	"i9NyS7V7BwO01WHBO9ZwQPTCevOsZt30kFdJoDn+7XmxdxbcM7vKse4xOU5lGslA/M6x467Y8bHGd4Xw",
+	"Sjjaap/CmDm0ipg05rXwcxz3kfv16jfHAlcyVoYK/BlF9M8v6i6EKFMvbB21gEQ7m2hhgFFizmCYhO41",
+	"/jbKMjidKZAn7qdkB+OMAEzcEzWmyb3wKWn/oGT0mEmRUjVwAcop2S7AOEb3tw7C9pWIhm00wjSWoDDX",
+	"XCVUktp7gFVNT9f+BnPUEDanMtqCtYWSqKRf90xRTaU+OqO3CL+4VLVKaOVY3TEMCX64BYT92dy6lwPd",
+	"geAbWq2sxwlQuHf+Us85NTsXgn/n3Ny2OVm028VYBFj/dEkqaJ7GZzxzllY1/Kss72rkA60inGi4dXHO",
+	"WOcqOFD608ZuJK+MgU0iEsODHZuWEBv/zNQWYmwZ2B7cnXXokHN8bCt3sBx5kOBzTtJ8tMPup8rq+7No",
+	"eDM43o4YneK8ByZh+7xosrEzn7lIiufiMbXcw22UVIZbgTgzHw9uQRSbA3KVx7FlGiXIsnuEw4q/WfzR",
+	"1N3MM4gPcYhOv6OxTHgRKdd2P2NkmwgpMuL6F4lseDMof3E/4S5P4ij51jevS4i3tvOCeNuVUa0/4QUf",
+	"y27edAh24ts8b6odHAiTQqh/YqzVsOlUsNkjQ1b0MaIAB0zSSHSVubbLjZ49OYlo2+m8llDxPjt4s5vh",
+	"hzoEefB7sAHJulEbsRxTeumCrbQHqe5OrdyEBNZS2SBuBqd0sgQGetkF/d+gRTcL9cWWgYPlzPDYP0He",
+	"7GZgCnQ1v7x9TatzcrCs2+a0aFvwjZMduLCb59y+tsWcj1crywlp6mVlPqepmRvn2y6/KxhGGAbEgWIa",
+	"Rb9iQV+pY/s0yxVcRxmB2MmOwyqdzY/LQQoCkQZmGDTcoIxY1htkfQ7552v9OAjEmUUYaeiBKyQg4vsJ",
+	"FlJtlDgihTtsrXNBADtuQGALDO4ilGcCkRkBDxlN5Y1C0+Q8ba7QebLcNcEXmXkr2MVXFi0mTsO5OPDZ",
+	"FwfmDAVNC0bg4xkWB/bx5BKjVWSdsjGHd2xLD6kQhgjNQUXn6ZVkavJlLsmcSzKNSjI18TWXZD63kszh",
+	"wJhLMkcsyWxj+lySOVpJZoXlc23QXBt0lLVBmnr6DMpLTqFU4VhqUHRRMdegnHBwZ65BmWtQ5hqUEWtQ",
+	"NBfgXINyujUocwXJiBUkQ2/wmMtM5jKTucxEN3d84O40l5nMZSYjQ2wuMznSMpM+OfI4gKtr5wK03Ubk",
+	"NtuAXvmxlte/vvVZOXBE2J7WvJNlBJA86889E+2GV6/wjjrsclq9YluuMpenTF6e0oGXDOKjzn9m89BN",
+	"fi5mc6qZzw2zbU+bZfnBx19ZxOakX1ikzOqU64oaZ90mS/bJLEWJuALsbUBH+gVEMQw/YIzwIA50WWu/",
+	"I/rKCO/aRDr/Ji8jofsO9ACjhaV5YpihHAfMynsbYwjCh7eEgGBzaCqvBCFelHmAE+IBQQkl7h0IS8sr",
+	"OyxtXxOaHI5w9A8M2QWb3j1G9L2mkiBB4hV/Z2UqAacAZ9ATj71Qkt6jZBVHAZlIlhuQeXcQJh4vXwq9",
+	"LQTJ/SaKGd7e5xjDRBwIXYnF4oxIPmzbWt5PdVaz/0NxKMIeDGXJzh9oFC0zIrGqu+BOxkj1cnJo85oD",
+	"pobOCismSsj/+tFvemlOPP6o15ggAmKttrXyC9pxUTx6Kb66kFPWUbJv6QtoMeTaiV4ay7sWYmAFf65x",
+	"QgfVQQlQavKqJLkABh1bHxec5pOGBZ+wCSpKMSjQmFyJgJrS4H1dgGM0sXKS9XF3VUxxH3nuASJpM4GI",
+	"7LtX1O5CFoUP0cUn9tFRF6QwQ/VFp/LhMAIsSDSRYKWGvxSk8wUuJKWzvtdSqEWN/RnhSV/8PN6hD7zC",
+	"ga+jThdJ/IPmMOL9peScSW3g4jvxDVtM2VgKGX/QnkCcgPga4h3Eh/VKrtEWepEgwMsYBR5kJPCn9mmt",
+	"5RdqV0zlvq1hAjEgkL4Xyqih/y4MTmr0wfAovKW/GCmKxcOqapwTRYvHv/Dp1+n6KJgVioJZ5pfzu+Rp",
+	"cIZ5mL8jMn00I0GkEsmgRBXhhAMHMtiDv4KkggZB1C8oT6ZiEyVoRb/v87sSaJ2ecziJcVsrlXg4oiwT",
+	"5KD3y9sbygCUc9rKofWMEaWsCq08IC2lRmJdbHhqqEt311PndMCtTwkD96ChsIWdb5Yqu0x2TKW/EHhd",
+	"wErymXM4KmPr4VEtdmkBZK1sa0yqZeWdBu1JU3VU/xxYcsSYUxDZF4NnwGuN+ifgJJ6pDGdSafjsFEOF",
+	"YUYxUWWAdtXwbOLVRyFT2wh3uxxlooh7PSNH1lPvRZp6m1qRwzkBXTGYPvDK6Tw38JXMMgGgFGw7BieN",
+	"mXQELM9aqhbBlspNe6r3ImsBIuhCmlE5mrZIJQUPz06eKrdMhKr0b12oBXedbxaK3HQ2i7JguGW3OJpz",
+	"3C4Cn8Gp7jGsDCfnwK1rgt9ibyRJLZHx8RtONhphGKP1OkrWglrlDvtmFBZ1Q2409hHBCCsz0z98lp0e",
+	"DnQArRJpdAqtDNCK0HJW7mP6CsO0EhiK9u2IdB+S7cq4rNLXQM+xZH20Muz4c0AMVu95Z420ok1eeuAc",
+	"b3JgN754QWaenB/w9FGnsKEJepKL+rIZCa7GWN3HQwMEzkz+cqbDQXAY5VPSN04oRtY8OldAcmA9BVSU",
+	"+rUooGr172jEyrLrQSTzGu8+yllR6WiEi8rtQXSzIvE+ss9sucvJDy9cPsxyL+kzWe5F79bl7irF8CTj",
+	"ByZphq3R4vETDdXnMdSg6ynIT5/Jp5M/rGYOX2K0i0I3aymVYw3hGesxCtNKckyYRFPAvHII9sjJCsNs",
+	"c9hMPvHRzky+vdsCJj4PTZTgXRCDaAtD7+6hUgPCCf4VAkzuICAOoCfG1na2M4LSppt6atc2KXC0u3BQ",
+	"RaigVpChW2K7kfzSLtC7zoMAZtm/YZaB9TD22mQuXsYgSryMf9zbiq8/LXyG8mkzYRPIMpvpAhKCLnLw",
+	"JzjH7cj/P6J6Bp1ZHOT4trwuIPOrpfPnZUp02mtTy8/K9FCvCShk6FwjiAnp7FWMP4KOowbRuN7BFG5B",
+	"4Q78wcs5IjRZcUlRUKJcD/AHxNHqYZTdig/dRNK/IQHs9gy0KnZ2WeUignxbwO/MKHa9hhyxNMIw67pW",
+	"FkMQfk7ih703uk0vYe4eTvc1woWvMKcpy57u4+PPSr2yaNjEip5Nc1My/mtv3f0bhTDmT+6lGGYwIUqW",
+	"u79wzYmnha9xPerPvnI5G0zyLZ0iZ5V8e0e+MHTT8IUJ3h7qflbI8LG6aR4aojhMQ0sxl5cjqujrxOZv",
+	"UmoDAOrFQnbtjyYPfCC5TrUn8dFB+7UU0xDaJZAH0J7iaEcv1/4GH9zMTQKmNje1GkBnXkoC/KRao3V5",
+	"mi5A589Mjbvw3D8wpSLnQ0XKXaARkx8IHVHnU0dQ24s2FvvJDmBe+dG4h/SvImWy3rUku4slnL1DOcLE",
+	"eXIM+UNQXefHTluj7Jp0Cb8kTSW7eLapuHsxhDFk/wApDaPyVSOulmOv6vK/7NA3/g+cJ764SZuSjKP1",
+	"uvLkeMkEQAhWg3blvFzoOD7abRhlaQyaFbxo0i/iz3elDhOdePMK48qNuaq/iziqjJrclpfz8f8Xj5Wz",
+	"/1EyqR9E7ugtt7RLtSsbyv8X/cthyzS3Il9IObtXzvWE51eMyP6nHGfvufj6BtDK3mN4d5hNxppCNsoG",
+	"JGEMLQfpnyP14bUnqGqLXdsmUmBPS0soSN3TFEl2D7HNiwndD2hwdUBn1+GuKaGRtoc02INt6jXJ/b5k",
+	"y7XJLnRQCO/ydbMLA6smYa1r5dbmfn6soiTKNpbk6u51LY9adBmMCSK3d3CFMDQnLwW4fLJkiFwvWUdO",
+	"e/ObGNwbQDgiD823ZBsavH/nMIe3TBeLNdgvykFnYAPeTEq0NBAfV5jrYr8wYLlMSlPt/ka2s5eTLHHb",
+	"eoW5ss/pZtWM+zST63DAB0Vh19TtR3ntVq/eV+yJo/I0D/S4oEuJfBSsbJbGZZlNpCcUT7nH2blwBtzL",
+	"pstLbs/2S52NKjSyuOs6hCuWNHjhC3NWmtbonms68Wd+Q3+HUaqb4TOmieoKVrWr2SkVFZJu9rDnldOr",
+	"YbAsctQBoOqGNL22O+BuHO330QY/49v1Qq8jKy6oRPwdz9j8XRaL4ATdMKPAp2Yj8wbvEf6WpSCAQ4IV",
+	"xgtDcU71q7vGf7XsYDH8T8q6qi1RViCrszqVm5rH2Bs0Dh/N/Qfb1/c0QHkkm4Du0aqbaHUuXmXtdzLy",
+	"DDa+bKfAlCGxgfOV4/iLx6ZErSjw5MMQLEEDYoxw5oEklEf07DKPfdzyZtrB9jLfoOlYYFtmy9Wl1fiC",
+	"K8mbWNITealwo4FbonpaZ0Uj3rQjOq2zVTlY+UrISC+fVHcRFUPrvDsq29KFpDD8s+RSjdXy7kMdXosL",
+	"EP1F6/tRLXxSQjjFU1EaTXOyGZbJQ1Mo+GbZOPaw5xedwGLqh61scjKdcFF3exvnBa60RLdVpN04fdEJ",
+	"Dx3tctZvkQllclmogSZtou2qj+mkW5/VH8ao7fA6i+oQHVZW6jVqDFUOc/qD7zjaQazVNAqQnpGmn0+m",
+	"8EVOpcaYq4oj1MuayqHenkPecRwye8BjZLKMmXSS47j3oPDqtwrMKp5yHWjFkUI/yOS5wp5dhDm8+qEC",
+	"UhCIY5R+N8RNFBME8NY6WfZp4W9QRjRX+GGd3Rhk5DaDMLGaXnuyZTb0eOfz9fG52jwZxfIkqW1Zt5SN",
+	"ucoygziLUDJsZylOAGtwuVbu/ehd8EpSiOZTus42FdTMUZiEI4WdMnK774IOQf1vICMVt1SMiXO7hcmX",
+	"dxGA6G2/jbIMVgO636LUX/goCegHQRwPC+Qm8DubRdU30ON+p1tgsZq7eaq/ctkB86Chju/MmFL6D0rg",
+	"+Gb8dakNWtUKvW1oiGbxeErgGDFqw3CS03yZIRrk0897ockBuQ29TUWupWsoXOWNgc0vyirphYKSbri3",
+	"ycQxur/trvboThsb2fWpTK1xs6rkcem+CaAdMS376IC8bP3p53KFIFzvOGDfjLaNcfQnNVFV+3Zd3YkX",
+	"Q2tMuxjdocU9PMWtI7PszI5JWXL3rcjXzJoT5nibOxB8Q6tVV5MAhXsFFvWWtZIP+HdexiPqGqPdGGBX",
+	"Yw2/bEnewzVh1JPdjjWccnERlxHhymag2DqtO4Fg0pD9QNxVVo/z9Whd88QH0TTJt3dlklGRoTjIai5z",
+	"c5owKDj3aF1TVEqyr6BItvSuJVdbZaVfPqNcz9ZUO2MdgNtJStotkWJerYUwVbQPnBbtsmh9C+yoIp6u",
+	"j6r214dySsX5N2xFiD725zKKxLl0ahL/mmkGNMvaGJ3Yhi6Di7Nf0/7zgbD1gfCJn8ce2VHo16w5mFhg",
+	"T3exsSO8Ufz9VDlIJPXdeDV+eIQyw3vL59fIKf2c+/ISpDm3WyO329Vq0VG1I1X1NV0zcsvSuQvO3uxB",
+	"rbWaQM3N0EbbmGkKcxb/CeKuuYyAIa8jn0PJu2xO/6TXcRCcByTHkMIw26B7JfFTpIPWYLiKYNx8U0dr",
+	"LqeqmxWyakTzGrSc1vfRsOaWf/AdyKKguPKJmXHsL0X3DSEsfewdBBjiakv+p1rTXyEQOxS1D/0N/19p",
+	"M/v/78Xby08v/kt1zkAa/RePLZTH9C19eYMXX0TW994ATyxstkIyaRTwRSTsNH9Ng4H/9x7ebaI0jeDL",
+	"EJZDf6S/+SLzgM0lu1guWY+XMPfrd4BdfvJCSIPoTKA0x5cNsfAAf+E9CpTrQ6kpT1cOa/c2yaK7GC4/",
+	"pzD5glb58gvEGNCf2bVkARS3dAnK3qb0UfEXb16+qpB3sVze39+/BOzXlwivl6Jrtvzt0/sPv19/oF1e",
+	"bsg29tXqLEqURz/99vKTrxy8+q9fvnr56gWI0w14TXugFCYgjfwL/wf6i8/jeww2S2rgLIt7kVKUMT5T",
+	"KDP4fQrpWSH9WVgK4jaydyhsDeWVTSI6BdmZdWHLl2dTs++/efWqfRjRbll9rf5p4f+o0+sdCK84JfwK",
+	"N9bvtVa/0k/Nir4/6XzzU0IgTkB8DfEOYtFXWbD+xZ83NHK33QIaCmWXi8lbUuXVf/RmWvU96oVPwDqj",
+	"yo7Kyr+h43GxVS43XsMmyUUZKa5T9k14X7+MuWs+v0ASbPgtcTsQMd2/f2tx+3QwDCMsdstmIF6JFqZY",
+	"VPubw7F6ZezYcPyUsN2GfXRcMF5BgiO4gx6GIBZ304IVgdgrJNMlPHYpdCsSxaXRheSGMn3/pusJ2acw",
+	"jNFEF255KaLHrwbwIiLuxO6C/K64WLCRafzeQWOe7V0XeQws4yRxAyoiD/LKU+W2aLrJylKwNrY9SnXy",
+	"tAxAHNOTmVYevhcNlOTVFGCwhYQpzj+b51Q2Ybdjys6X9M/+00Kr0zUBBA7q8R6FssPNnsB/ePW/9693",
+	"gt/JkhkFlYs+943Kjuun5ZXTgt8MHz86+orkmJcg4q1QnoRs/NdvHI1fXpTKDDcQR//AYpdRAOvgUxLV",
+	"XsZg7cES1y2aVGKOkpcCnEG2JTIjE4ZqRrgGwMUO1qFY2e8u4T0e9uQGTBlDWaIKbGzsTQkIISQ2Z6zw",
+	"QA8S5av+rUbexxjdgfgDbzgUAteQpkT/dw7xg7a6ugT0adTfaGrBwD6f2TXNLVDT2tfUyVZ3tx/6O/+O",
+	"mPmHcPQPDF1vb9z+BXHsQSkKKU/2ByHQsvyrXaC8ySFEeY0weY/ifJsM6vIZD9kQJ8VL9SmFI0NK6Smt",
+	"pcwlZtgf/JunRYs/9J4FO2VgdbA7pHQ394ZYdzfekLFMfnzzRsMa3rvS3Z0sOR894CXwvjgG2Zdhue6X",
+	"jzJG/sSDjjEksC7cn9nfpXCHqQHWy2bJ7D3ToivWt+wSTm6bORHsqx+1uv5CN3nXYuUCoC92pjCghfGt",
+	"sl006/HrDbqfSH4Nq/I0hSD0pI4M0rxBBl/ZmYcjKQzUr8q3T1y/GiFgYq3Mua8DnUbVvFRrzDV09C8Y",
+	"bcuDpsMCTb2P7WeMLNBmqPePAm6v3+h9kBB60OJcU31N4ij5Vr4M6q0w2g7dMgrT/1KizwZLi9lfMFa5",
+	"tVe8Tn8TjWOJzcwDYhXQKETHltrodvAVxLj0BR2DzruEeDvrvFajPMYQhA81vTfx/szpUfQlQUONOyr3",
+	"qKIvZxie1NZ7HCaiBGAK8Za/wjfMUizettM0E0V+1ARApV+eDcSpDUSW5WFlHX7NeCrFbBpOYRpWH+Y8",
+	"C7uQqTB3RuG0Gm7eh0/ZHGTa0coWnNE3W4HDrUBeVqBlAtL7qnXO4//F2j2P43g212M9Yy0vGFePV8X9",
+	"cL3nq8X8LA5ZizEsTgKKMebj1vK4lTGleoN8Tb7Fml0+0v9onryqYh+2gmmn+fTV9elrv4xFHesyg/Gq",
+	"VT3TU9nyrlyjjHja9xhVnZi/F0ICojiTL7anEGeI5quBIEB5oqZxix5956cqv4wOQcUA5sqvkevPTfMJ",
+	"S+UB5dhD90khb1ophbdFvVhNturaKG5/bFwcLOnbZnU0FEdMvTaKugZaYJOw25BgqGTsd7GsOAHuKq8x",
+	"O6ib4yvNy9zlqduIyXppKXcFPfRPvQblpfK2rZE1KQaw0qZ0gNmOLOzIsp68Lk1VFywfxb/0jEjTYynR",
+	"bzYiXRuRXXJedBqMU8mxca2eSTJftzS6DVJX8jC1Z89CA59HYp+R8l6qRdYaFt77Sk22DezmszcbRajI",
+	"4bzO4BQ88rrYDlxX3jHRtDcrt/sdWm22UGKtQMuhZlVqaQIHKj56AKelVZePldsh9c1lZ0jtV07lp2Zb",
+	"27WtXUp/uD7rs8RPDiI9+upMDHobkfeb+5ML3cZXmDe9o/UfzFHbvg8qrx1ouRcf1PazfzGdf6EK4rwc",
+	"DBWSGkBXmuu6GNVnQSb1MRRSrPWtMtascC29DFiBSB/q9PTr8rH6toy+p+EOsP16SvnW7Gu49jUUABio",
+	"tj5v4wRh0qe6zsThsJN7v8txBJK3cTrmXfCI3Q4b7BpujEv+OFDWfsdqmxF1zTqeyBronoTLlcBHnNeD",
+	"pVXIYemhhCZpHcRElCth+cj/YWo2TrYudLx8Stpsa7qyNVswOrbdcVIIc2SszIr6GA0XVwvAVGWXrw0O",
+	"s13kW3unbLqwObhcEGzAeT1YGi4Mkge1W/giWD6y/5paLVOtiP4+jLLZZnFks7TAc2yT5YTg5chgmdXz",
+	"0ZkrrrDfoah32qe7u6M91z2GI9fdGR627jqPWdXr4FvAJd8c0wNY2XpOHpgQyYUYzgzNxbx01KdsrJ02",
+	"UHSYOmlAEmK/k8uR5i3cNmFAAUc31nR06fKx+Pcw38kRRDWsWvml2QlyniRQPOM5VIn1JgicFjy6ldS5",
+	"pAYYSttAjSxBSp9wgu2hybe8wTnC5bnpFSHKZqiBexAR+qZegJJV1HgphyPIoZyImEyjcvrMfj5pvPEp",
+	"nINu4jOZSjmlOV7DVqBc0l9nK+dUocXENxWyMPyr58FmBop50zt5mHFJTr3nYYjzpAtuOE9mi/zU3X8m",
+	"xmao3UeEvy+fgS30FLmOgbaMoLQdbNcEpbNmO33NRuU47v5ZPveqFWqiT0SYPVhnHw0VI7DPzw9FTP1Q",
+	"BAMOfynC4N4q5XTI8DXh+WTI3cmQ00eIj+RUiCu2yrMR3TjtejpC8OkLOgbdN1/if8pPSHC92Y/G9nck",
+	"VCzMUJzfkzBIQOIgLB+UMLukLkp2MCEIR1ArDeST0nze7afb7RU5nNeWr+BRwz+SrR90M0E+FR0mzgQp",
+	"CLHOBClGmjNBLDNBIgUc3VjT0qfLx6LLoFQQVxjtV0rFl+ZDEtepIIXoB2uxvlSQE4NHt5Y6k1QQc2n3",
+	"lz1MLG+b+oV5kzvSmyFM8dq+7/U/WSckafZm3TMpVHD5yN2RGPTK03gaYNN8Mk9h19SGvN2be8ogs2az",
+	"NN8b329rgFaPEhvyhp87HC7mR/8mfvRviHZqhxCGKcoi/YDaldp+jqhNt/WqgjivHViFpA7IZXPtoNpV",
+	"2WPizbikxMHLOXKoeWO23Jixio8ewOlp1uVj2WnQTu0Mqv36qfzUvGu73rVL6Q9XaH3xtZODSI/COpMQ",
+	"m43I+4NskwvdJsw273pHG2gzR23HRsi8ED3vQjSdHYsJHQsugzPzKfiknEf0OLMmdyMYFfbKlA0zK1Jb",
+	"90Fiwiwgw5pQj4H9Y5i34AKNGhYB+8zsJTj3Ehhfh+moXu/gVCDRoYPOxSMwEK+GJzCVgK08gHnDOjrL",
+	"fzA6B+1hSwx36BvsqlWlv88b2SmWQlPJsSpUfjCFVgcFFiKAdAGL/X5i2pITPavJqSuvmRgOAu2Mlmzk",
+	"sd4p7HXReI6UTBcpKaRwXrGSAokawJZtdeMlkmVTR0wkHda6VQ40a1fbF49KZHTCTEODLh/lPwdFTxxh",
+	"U0PZiQ/NhqfrCIqU+1DN1RdFmQoa+h1+h99dbmlnGYAxRUd/EGZS1WETiJn3waMMxpghdfDWSP3pQf7G",
+	"VX6IWoihOvCIXAHKoPPRndTvpVePbaKMpQRw79cxNgncpjEgeo7vl6Lx7PhOh/ZCCufl+BZI1IC2bKvr",
+	"+EqWTe34SjqsN3w50LzhWzq+pERGJ8w0NOjyUf5zkOPrCJv9akh+aHZ8XTu+Uu5DNVef43tS0OjUTGfi",
+	"w5oKut+HnVTUNj7svKUdpQ9rhtTBu9wyy/EOPui+gixlfM16nQLWO8h3hng+3Ix72zMMxkb2xOZ4Zp0E",
+	"/PKR/8PI1JsG/hruMKNrtg9d2YcNgBzPcjgdULkwN2YVfHSmhzXahyvjHchjMtT4+IN2Olnbg1HvDPds",
+	"tBn2lpYHg+HYhgfH+vKR/dfI7JgE+DqP4OcxmY0OR0ZHAxbHszlOBVEuLI5Z8R6XvWEN9HYVnGeiYlr7",
+	"hZyv2SR1qGIE+vX5fZyp38ehqHHxPM7XbC7Cn/aEnUngvE7XmUpz/TbO9Fpvfo7klF/GYRrTwcM4Mw7n",
+	"Z3HMzEiGQP1XcXglmfJIZ0foKQbRltf+mT/Iyfv/B0RWu9oeFSe8scEgxxF5YKzjs/Iv/rx5ulElyxjP",
+	"kicT+J148jVgqAhBCrdSGFiT7d4DrK0JkjRhw1bQTl5SfV5yriVnBFTwMOyWdGd0YxQpGsUe9igx31o6",
+	"MXEqe8yr/9Pf6z1KVnEUkIk3GB3kir2HqqiMAJJnPLVbC786mmqJciJQ3nUswsn7zNtOAfU6Gc/ShBof",
+	"cG/TFCahx2FBDe7hYNtAgMkdBB2o+lU2Ka55MFVX5UhutrDxOXxN+Qu8gkvMmqQLHORkAxMSBYDAsH45",
+	"WROr6SXz7Vxmjz+UDB645Mre5kuNjgHD47jWYmIdX8HAh+/BBiRrGpuuvRPQeDNdk/AxXEcZgbjrqh7e",
+	"whwE1RHMgfA+xxgmx3HByfhLXHLNcFl3F1h9jNEdiE0vYJ1juQ3CrrD0KDaS9ptRWzyVDuNNnZ1vbHup",
+	"o5jrAXWUZ7khNGeFrBlb9JTDsAtH94RvElOa8y3GuT9UkXO2ARiGmheGTizS9iV8Vtd/9kinIzTkWD5G",
+	"QaHz09bndHnnAA2veR3nrOZP43bNQZLXui9zWm3j5vrLWc8c4PbLNugVGWytHufXbPY0HXmaLtOFnHuY",
+	"YAeiGNzF/Kxb9TTzTJo9HX6mSK0w9C9pb3MNQnvP/mThT+ZZZb3nWXW1Lx/zjG8y/Q6kUcIM7TTbE67d",
+	"xmapdniKk8iuvhTPxC9sY3+HK+hEAEYO4Omr0/Nw+LQ18XKNUZ5megqZ1hF8pO0PDq4MYvbhuYZg6hoC",
+	"hhdeRDBoY5AW/UeONwsAzZUDxhskZ/551Q1wBVYpHGjdMjtKBih7vqBptduco33KtQJcMxI0zGLjZQIF",
+	"BGbszfUBg4w+jrqyQEDf8pOVpfq232VRfHBwjIpPz/bf1PafQI2FBXgpcTfbgFPYgJL952UFSmXmyA6c",
+	"XtPN+/Ep24JSS5pbgzMCZ4vQzCKU2Ou1Cffzin+FIBR5xYtH/x0EWPk/kEVBkXLMSOBwzHHsX/gbQtLs",
+	"Yrkk+OHlmlZsvIT5EqTRcvfaf7p5+v8DAA==",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
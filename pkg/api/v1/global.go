@@ -2,12 +2,16 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gexec/gexec/pkg/middleware/current"
 	"github.com/gexec/gexec/pkg/model"
 	"github.com/gexec/gexec/pkg/secret"
+	"github.com/gexec/gexec/pkg/store"
 	"github.com/gexec/gexec/pkg/validate"
 	"github.com/go-chi/render"
 )
@@ -183,6 +187,10 @@ func (a *API) CreateGlobalRunner(w http.ResponseWriter, r *http.Request) {
 		incoming.Token = FromPtr(body.Token)
 	}
 
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if incoming.Token == "" {
 		incoming.Token = secret.Generate(32)
 	}
@@ -306,6 +314,10 @@ func (a *API) UpdateGlobalRunner(w http.ResponseWriter, r *http.Request, _ Runne
 		incoming.Token = FromPtr(body.Token)
 	}
 
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if incoming.Token == "" {
 		incoming.Token = secret.Generate(32)
 	}
@@ -410,6 +422,373 @@ func (a *API) DeleteGlobalRunner(w http.ResponseWriter, r *http.Request, _ Runne
 	})
 }
 
+// ListGlobalJoins implements the v1.ServerInterface.
+func (a *API) ListGlobalJoins(w http.ResponseWriter, r *http.Request, params ListGlobalJoinsParams) {
+	ctx := r.Context()
+	_, limit, offset, search := toPageParams(
+		nil,
+		params.Limit,
+		params.Offset,
+		params.Search,
+	)
+
+	records, count, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.List(
+		ctx,
+		"",
+		model.ListParams{
+			Limit:  limit,
+			Offset: offset,
+			Search: search,
+		},
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to load join tokens",
+			slog.Any("error", err),
+			slog.String("action", "ListGlobalJoins"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to load join tokens"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	payload := make([]Join, len(records))
+	for id, record := range records {
+		payload[id] = a.convertJoin(record)
+	}
+
+	render.JSON(w, r, GlobalJoinsResponse{
+		Total:  count,
+		Limit:  limit,
+		Offset: offset,
+		Joins:  payload,
+	})
+}
+
+// CreateGlobalJoin implements the v1.ServerInterface.
+func (a *API) CreateGlobalJoin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body := &CreateGlobalJoinBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("action", "CreateGlobalJoin"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	project := &model.Project{}
+
+	if FromPtr(body.ProjectID) != "" {
+		record, err := a.storage.Projects.Show(
+			ctx,
+			FromPtr(body.ProjectID),
+		)
+
+		if err != nil {
+			if errors.Is(err, store.ErrProjectNotFound) {
+				a.RenderNotify(w, r, Notification{
+					Message: ToPtr("Failed to validate join token"),
+					Status:  ToPtr(http.StatusUnprocessableEntity),
+					Errors: ToPtr([]Validation{
+						{
+							Field:   ToPtr("project_id"),
+							Message: ToPtr("does not exist"),
+						},
+					}),
+				})
+
+				return
+			}
+
+			slog.Error(
+				"Failed to load project",
+				slog.Any("error", err),
+				slog.String("action", "CreateGlobalJoin"),
+			)
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to load project"),
+				Status:  ToPtr(http.StatusInternalServerError),
+			})
+
+			return
+		}
+
+		project = record
+	}
+
+	incoming := &model.JoinToken{
+		ProjectID: project.ID,
+		Name:      FromPtr(body.Name),
+		Uses:      FromPtr(body.Uses),
+		Labels:    model.Labels(FromPtr(body.Labels)),
+		ExpiresAt: time.Now().Add(joinTokenExpire),
+	}
+
+	if body.ExpiresAt != nil {
+		incoming.ExpiresAt = FromPtr(body.ExpiresAt)
+	}
+
+	token := secret.Generate(32)
+	incoming.Token = model.HashJoinToken(token)
+
+	record, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.Create(
+		ctx,
+		project,
+		incoming,
+	)
+
+	if err != nil {
+		if v, ok := err.(validate.Errors); ok {
+			errors := make([]Validation, 0)
+
+			for _, verr := range v.Errors {
+				errors = append(
+					errors,
+					Validation{
+						Field:   ToPtr(verr.Field),
+						Message: ToPtr(verr.Error.Error()),
+					},
+				)
+			}
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to validate join token"),
+				Status:  ToPtr(http.StatusUnprocessableEntity),
+				Errors:  ToPtr(errors),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to create join token",
+			slog.Any("error", err),
+			slog.String("action", "CreateGlobalJoin"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to create join token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	// The plain token is only returned once, afterwards only the hash is known.
+	result := a.convertJoin(record)
+	result.Token = ToPtr(token)
+
+	render.JSON(w, r, GlobalJoinResponse(
+		result,
+	))
+}
+
+// DeleteGlobalJoin implements the v1.ServerInterface.
+func (a *API) DeleteGlobalJoin(w http.ResponseWriter, r *http.Request, _ JoinID) {
+	ctx := r.Context()
+	record := a.GlobalJoinFromContext(ctx)
+
+	if err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.Delete(
+		ctx,
+		&model.Project{},
+		record.ID,
+	); err != nil {
+		slog.Error(
+			"Failed to delete join token",
+			slog.Any("error", err),
+			slog.String("action", "DeleteGlobalJoin"),
+			slog.String("join", record.ID),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to delete join token"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	a.RenderNotify(w, r, Notification{
+		Message: ToPtr("Successfully deleted join token"),
+		Status:  ToPtr(http.StatusOK),
+	})
+}
+
+// RotateGlobalRunner implements the v1.ServerInterface.
+func (a *API) RotateGlobalRunner(w http.ResponseWriter, r *http.Request, _ RunnerID) {
+	ctx := r.Context()
+	record := a.GlobalRunnerFromContext(ctx)
+	body := &RotateRunnerBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	grace := runnerRotateGrace
+
+	if body.Grace != nil {
+		grace = time.Duration(FromPtr(body.Grace)) * time.Second
+	}
+
+	if grace < 0 {
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to validate runner"),
+			Status:  ToPtr(http.StatusUnprocessableEntity),
+			Errors: ToPtr([]Validation{
+				{
+					Field:   ToPtr("grace"),
+					Message: ToPtr("must be no less than 0"),
+				},
+			}),
+		})
+
+		return
+	}
+
+	if err := a.rotateRunnerToken(record, grace); err != nil {
+		slog.Error(
+			"Failed to rotate token",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to rotate token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Runners.Rotate(
+		ctx,
+		&model.Project{},
+		record,
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to rotate token",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to rotate token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, GlobalRunnerResponse(
+		a.convertGlobalRunner(result),
+	))
+}
+
+// RevokeGlobalRunner implements the v1.ServerInterface.
+func (a *API) RevokeGlobalRunner(w http.ResponseWriter, r *http.Request, _ RunnerID) {
+	ctx := r.Context()
+	record := a.GlobalRunnerFromContext(ctx)
+	record.Token = secret.Generate(32)
+
+	if err := record.SerializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to encrypt secrets",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RevokeGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to encrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if _, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Runners.Revoke(
+		ctx,
+		&model.Project{},
+		record,
+	); err != nil {
+		slog.Error(
+			"Failed to revoke token",
+			slog.Any("error", err),
+			slog.String("runner", record.ID),
+			slog.String("action", "RevokeGlobalRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to revoke token"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	a.RenderNotify(w, r, Notification{
+		Message: ToPtr("Successfully revoked token"),
+		Status:  ToPtr(http.StatusOK),
+	})
+}
+
 func (a *API) convertGlobalEvent(record *model.Event) Event {
 	result := Event{
 		UserID:         ToPtr(record.UserID),
@@ -437,10 +816,28 @@ func (a *API) convertGlobalRunner(record *model.Runner) Runner {
 		Slug:      ToPtr(record.Slug),
 		Name:      ToPtr(record.Name),
 		Token:     ToPtr(record.Token),
+		Labels:    ToPtr(map[string]string(record.Labels)),
+		Capacity:  ToPtr(record.Capacity),
+		Version:   ToPtr(record.Version),
+		Hostname:  ToPtr(record.Hostname),
+		OS:        ToPtr(record.OS),
+		Arch:      ToPtr(record.Arch),
 		CreatedAt: ToPtr(record.CreatedAt),
 		UpdatedAt: ToPtr(record.UpdatedAt),
 	}
 
+	if !record.LastSeenAt.IsZero() {
+		result.LastSeenAt = ToPtr(record.LastSeenAt)
+	}
+
+	if !record.RotatedAt.IsZero() {
+		result.RotatedAt = ToPtr(record.RotatedAt)
+	}
+
+	if record.GraceActive() {
+		result.GraceExpiresAt = ToPtr(record.GraceExpiresAt)
+	}
+
 	if record.ProjectID != "" {
 		result.ProjectID = ToPtr(record.ProjectID)
 
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gexec/gexec/pkg/middleware/current"
+	"github.com/gexec/gexec/pkg/model"
+	"github.com/gexec/gexec/pkg/secret"
+	"github.com/gexec/gexec/pkg/validate"
+	"github.com/go-chi/render"
+)
+
+const (
+	// joinTokenExpire defines the default lifetime of join tokens.
+	joinTokenExpire = time.Hour
+)
+
+// ListProjectJoins implements the v1.ServerInterface.
+func (a *API) ListProjectJoins(w http.ResponseWriter, r *http.Request, _ ProjectID, params ListProjectJoinsParams) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	_, limit, offset, search := toPageParams(
+		nil,
+		params.Limit,
+		params.Offset,
+		params.Search,
+	)
+
+	records, count, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.List(
+		ctx,
+		project.ID,
+		model.ListParams{
+			Limit:  limit,
+			Offset: offset,
+			Search: search,
+		},
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to load join tokens",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("action", "ListProjectJoins"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to load join tokens"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	payload := make([]Join, len(records))
+	for id, record := range records {
+		payload[id] = a.convertJoin(record)
+	}
+
+	render.JSON(w, r, ProjectJoinsResponse{
+		Total:   count,
+		Limit:   limit,
+		Offset:  offset,
+		Project: ToPtr(a.convertProject(project)),
+		Joins:   payload,
+	})
+}
+
+// CreateProjectJoin implements the v1.ServerInterface.
+func (a *API) CreateProjectJoin(w http.ResponseWriter, r *http.Request, _ ProjectID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	body := &CreateProjectJoinBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("action", "CreateProjectJoin"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	incoming := &model.JoinToken{
+		ProjectID: project.ID,
+		Name:      FromPtr(body.Name),
+		Uses:      FromPtr(body.Uses),
+		Labels:    model.Labels(FromPtr(body.Labels)),
+		ExpiresAt: time.Now().Add(joinTokenExpire),
+	}
+
+	if body.ExpiresAt != nil {
+		incoming.ExpiresAt = FromPtr(body.ExpiresAt)
+	}
+
+	token := secret.Generate(32)
+	incoming.Token = model.HashJoinToken(token)
+
+	record, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.Create(
+		ctx,
+		project,
+		incoming,
+	)
+
+	if err != nil {
+		if v, ok := err.(validate.Errors); ok {
+			errors := make([]Validation, 0)
+
+			for _, verr := range v.Errors {
+				errors = append(
+					errors,
+					Validation{
+						Field:   ToPtr(verr.Field),
+						Message: ToPtr(verr.Error.Error()),
+					},
+				)
+			}
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to validate join token"),
+				Status:  ToPtr(http.StatusUnprocessableEntity),
+				Errors:  ToPtr(errors),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to create join token",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("action", "CreateProjectJoin"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to create join token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	// The plain token is only returned once, afterwards only the hash is known.
+	result := a.convertJoin(record)
+	result.Token = ToPtr(token)
+
+	render.JSON(w, r, ProjectJoinResponse(
+		result,
+	))
+}
+
+// DeleteProjectJoin implements the v1.ServerInterface.
+func (a *API) DeleteProjectJoin(w http.ResponseWriter, r *http.Request, _ ProjectID, _ JoinID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectJoinFromContext(ctx)
+
+	if err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).JoinTokens.Delete(
+		ctx,
+		project,
+		record.ID,
+	); err != nil {
+		slog.Error(
+			"Failed to delete join token",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("join", record.ID),
+			slog.String("action", "DeleteProjectJoin"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Status:  ToPtr(http.StatusBadRequest),
+			Message: ToPtr("Failed to delete join token"),
+		})
+
+		return
+	}
+
+	a.RenderNotify(w, r, Notification{
+		Message: ToPtr("Successfully deleted join token"),
+		Status:  ToPtr(http.StatusOK),
+	})
+}
+
+func (a *API) convertJoin(record *model.JoinToken) Join {
+	result := Join{
+		ID:        ToPtr(record.ID),
+		Name:      ToPtr(record.Name),
+		Labels:    ToPtr(map[string]string(record.Labels)),
+		Uses:      ToPtr(record.Uses),
+		Used:      ToPtr(record.Used),
+		ExpiresAt: ToPtr(record.ExpiresAt),
+		CreatedAt: ToPtr(record.CreatedAt),
+		UpdatedAt: ToPtr(record.UpdatedAt),
+	}
+
+	if record.ProjectID != "" {
+		result.ProjectID = ToPtr(record.ProjectID)
+	}
+
+	if record.Project != nil {
+		result.Project = ToPtr(a.convertProject(record.Project))
+	}
+
+	return result
+}
@@ -0,0 +1,576 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/gexec/gexec/pkg/middleware/current"
+	"github.com/gexec/gexec/pkg/model"
+	"github.com/gexec/gexec/pkg/secret"
+	"github.com/gexec/gexec/pkg/store"
+	"github.com/gexec/gexec/pkg/validate"
+	"github.com/go-chi/render"
+)
+
+const (
+	// runnerPollInterval defines the interval to check for waiting executions.
+	runnerPollInterval = time.Second
+
+	// runnerMaxWait defines the maximum duration to hold a long-poll request.
+	runnerMaxWait = 30 * time.Second
+
+	// runnerRotateGrace defines the default duration the previous token of a
+	// rotation stays valid, connected runners pick up the new one meanwhile.
+	runnerRotateGrace = 24 * time.Hour
+)
+
+// JoinRunner implements the v1.ServerInterface.
+func (a *API) JoinRunner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	body := &JoinRunnerBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("action", "JoinRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	join, err := a.storage.JoinTokens.Resolve(
+		ctx,
+		body.Token,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrJoinTokenNotFound) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Invalid or expired join token"),
+				Status:  ToPtr(http.StatusForbidden),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to resolve join token",
+			slog.Any("error", err),
+			slog.String("action", "JoinRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to redeem join token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	incoming := &model.Runner{
+		ProjectID: join.ProjectID,
+		Name:      FromPtr(body.Name),
+		Token:     secret.Generate(32),
+		Labels:    join.Labels,
+	}
+
+	if incoming.Name == "" {
+		incoming.Name = fmt.Sprintf(
+			"%s-%s",
+			join.Name,
+			strings.ToLower(uniuri.NewLen(6)),
+		)
+	}
+
+	token := incoming.Token
+
+	if err := incoming.SerializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to encrypt secrets",
+			slog.Any("error", err),
+			slog.String("join", join.ID),
+			slog.String("action", "JoinRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to encrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	record, err := a.storage.JoinTokens.Redeem(
+		ctx,
+		join,
+		incoming,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrJoinTokenNotFound) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Invalid or expired join token"),
+				Status:  ToPtr(http.StatusForbidden),
+			})
+
+			return
+		}
+
+		if v, ok := err.(validate.Errors); ok {
+			errors := make([]Validation, 0)
+
+			for _, verr := range v.Errors {
+				errors = append(
+					errors,
+					Validation{
+						Field:   ToPtr(verr.Field),
+						Message: ToPtr(verr.Error.Error()),
+					},
+				)
+			}
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to validate runner"),
+				Status:  ToPtr(http.StatusUnprocessableEntity),
+				Errors:  ToPtr(errors),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to create runner",
+			slog.Any("error", err),
+			slog.String("join", join.ID),
+			slog.String("action", "JoinRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to create runner"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	slog.Info(
+		"Runner joined",
+		slog.String("runner", record.ID),
+		slog.String("name", record.Name),
+		slog.String("join", join.ID),
+	)
+
+	result := a.convertRunner(record)
+	result.Token = ToPtr(token)
+
+	render.JSON(w, r, JoinedRunnerResponse(
+		result,
+	))
+}
+
+// RegisterRunner implements the v1.ServerInterface.
+func (a *API) RegisterRunner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+	body := &RegisterRunnerBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("action", "RegisterRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	runner.Capacity = max(FromPtr(body.Capacity), 1)
+	runner.Version = FromPtr(body.Version)
+	runner.Hostname = FromPtr(body.Hostname)
+	runner.OS = FromPtr(body.OS)
+	runner.Arch = FromPtr(body.Arch)
+
+	if err := a.storage.Runners.Register(
+		ctx,
+		runner,
+	); err != nil {
+		slog.Error(
+			"Failed to register runner",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("action", "RegisterRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to register runner"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	slog.Info(
+		"Runner registered",
+		slog.String("runner", runner.ID),
+		slog.String("name", runner.Name),
+		slog.Int("capacity", runner.Capacity),
+		slog.String("version", runner.Version),
+		slog.String("hostname", runner.Hostname),
+	)
+
+	render.JSON(w, r, CurrentRunnerResponse(
+		a.convertRunner(runner),
+	))
+}
+
+// HeartbeatRunner implements the v1.ServerInterface.
+func (a *API) HeartbeatRunner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+
+	if err := a.storage.Runners.Heartbeat(
+		ctx,
+		runner,
+	); err != nil {
+		slog.Error(
+			"Failed to store heartbeat",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("action", "HeartbeatRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to store heartbeat"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	stop, err := a.storage.Executions.Stopping(
+		ctx,
+		runner,
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to load stopping executions",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("action", "HeartbeatRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to load stopping executions"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	result := RunnerHeartbeatResponse{
+		Runner: a.convertRunner(runner),
+		Stop:   stop,
+	}
+
+	// Runners still using the previous token of a rotation get the new one.
+	if r.Header.Get("X-Runner-Token") != runner.Token {
+		result.Token = ToPtr(runner.Token)
+	}
+
+	render.JSON(w, r, result)
+}
+
+// ClaimRunnerExecution implements the v1.ServerInterface.
+func (a *API) ClaimRunnerExecution(w http.ResponseWriter, r *http.Request, params ClaimRunnerExecutionParams) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+	wait := time.Duration(FromPtr(params.Wait)) * time.Second
+
+	if wait > runnerMaxWait {
+		wait = runnerMaxWait
+	}
+
+	record, err := a.claimExecution(
+		ctx,
+		runner,
+		wait,
+	)
+
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("No waiting execution available"),
+				Status:  ToPtr(http.StatusNotFound),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to claim execution",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("action", "ClaimRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to claim execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := record.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "ClaimRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, RunnerExecutionResponse(
+		a.convertRunnerExecution(record),
+	))
+}
+
+// ShowRunnerExecution implements the v1.ServerInterface.
+func (a *API) ShowRunnerExecution(w http.ResponseWriter, r *http.Request, _ ExecutionID) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+	record := a.RunnerExecutionFromContext(ctx)
+
+	if err := record.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "ShowRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, RunnerExecutionResponse(
+		a.convertRunnerExecution(record),
+	))
+}
+
+// UpdateRunnerExecution implements the v1.ServerInterface.
+func (a *API) UpdateRunnerExecution(w http.ResponseWriter, r *http.Request, _ ExecutionID) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+	record := a.RunnerExecutionFromContext(ctx)
+	body := &UpdateRunnerExecutionBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "UpdateRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	if body.ExitCode != nil {
+		record.ExitCode = FromPtr(body.ExitCode)
+	}
+
+	if body.CommitSHA != nil {
+		record.CommitSHA = FromPtr(body.CommitSHA)
+	}
+
+	if err := a.storage.Executions.Transition(
+		ctx,
+		record,
+		model.ExecutionStatus(body.Status),
+	); err != nil {
+		if errors.Is(err, store.ErrExecutionConflict) {
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Execution is not held by the runner"),
+				Status:  ToPtr(http.StatusConflict),
+			})
+
+			return
+		}
+
+		if v, ok := err.(validate.Errors); ok {
+			errors := make([]Validation, 0)
+
+			for _, verr := range v.Errors {
+				errors = append(
+					errors,
+					Validation{
+						Field:   ToPtr(verr.Field),
+						Message: ToPtr(verr.Error.Error()),
+					},
+				)
+			}
+
+			a.RenderNotify(w, r, Notification{
+				Message: ToPtr("Failed to validate execution"),
+				Status:  ToPtr(http.StatusUnprocessableEntity),
+				Errors:  ToPtr(errors),
+			})
+
+			return
+		}
+
+		slog.Error(
+			"Failed to update execution",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "UpdateRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to update execution"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := record.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "UpdateRunnerExecution"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, RunnerExecutionResponse(
+		a.convertRunnerExecution(record),
+	))
+}
+
+// CreateRunnerOutput implements the v1.ServerInterface.
+func (a *API) CreateRunnerOutput(w http.ResponseWriter, r *http.Request, _ ExecutionID) {
+	ctx := r.Context()
+	runner := current.GetRunner(ctx)
+	record := a.RunnerExecutionFromContext(ctx)
+	body := &CreateRunnerOutputBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "CreateRunnerOutput"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	if err := a.storage.Executions.Append(
+		ctx,
+		record,
+		body.Content,
+	); err != nil {
+		slog.Error(
+			"Failed to append output",
+			slog.Any("error", err),
+			slog.String("runner", runner.ID),
+			slog.String("execution", record.ID),
+			slog.String("action", "CreateRunnerOutput"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to append output"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	a.RenderNotify(w, r, Notification{
+		Message: ToPtr("Successfully appended output"),
+		Status:  ToPtr(http.StatusOK),
+	})
+}
+
+func (a *API) claimExecution(ctx context.Context, runner *model.Runner, wait time.Duration) (*model.Execution, error) {
+	deadline := time.Now().Add(wait)
+	ticker := time.NewTicker(runnerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		record, err := a.storage.Executions.Claim(
+			ctx,
+			runner,
+		)
+
+		if err == nil || !errors.Is(err, store.ErrExecutionNotFound) {
+			return record, err
+		}
+
+		if time.Now().After(deadline) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-ticker.C:
+		}
+	}
+}
@@ -2,8 +2,11 @@ package v1
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gexec/gexec/pkg/middleware/current"
 	"github.com/gexec/gexec/pkg/model"
@@ -144,6 +147,10 @@ func (a *API) CreateProjectRunner(w http.ResponseWriter, r *http.Request, _ Proj
 		incoming.Token = FromPtr(body.Token)
 	}
 
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if incoming.Token == "" {
 		incoming.Token = secret.Generate(32)
 	}
@@ -268,6 +275,10 @@ func (a *API) UpdateProjectRunner(w http.ResponseWriter, r *http.Request, _ Proj
 		incoming.Token = FromPtr(body.Token)
 	}
 
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if incoming.Token == "" {
 		incoming.Token = secret.Generate(32)
 	}
@@ -376,16 +387,215 @@ func (a *API) DeleteProjectRunner(w http.ResponseWriter, r *http.Request, _ Proj
 	})
 }
 
+// RotateProjectRunner implements the v1.ServerInterface.
+func (a *API) RotateProjectRunner(w http.ResponseWriter, r *http.Request, _ ProjectID, _ RunnerID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectRunnerFromContext(ctx)
+	body := &RotateRunnerBody{}
+
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error(
+			"Failed to decode request body",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decode request"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	grace := runnerRotateGrace
+
+	if body.Grace != nil {
+		grace = time.Duration(FromPtr(body.Grace)) * time.Second
+	}
+
+	if grace < 0 {
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to validate runner"),
+			Status:  ToPtr(http.StatusUnprocessableEntity),
+			Errors: ToPtr([]Validation{
+				{
+					Field:   ToPtr("grace"),
+					Message: ToPtr("must be no less than 0"),
+				},
+			}),
+		})
+
+		return
+	}
+
+	if err := a.rotateRunnerToken(record, grace); err != nil {
+		slog.Error(
+			"Failed to rotate token",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to rotate token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	result, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Runners.Rotate(
+		ctx,
+		project,
+		record,
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to rotate token",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to rotate token"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := result.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RotateProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	render.JSON(w, r, ProjectRunnerResponse(
+		a.convertRunner(result),
+	))
+}
+
+// RevokeProjectRunner implements the v1.ServerInterface.
+func (a *API) RevokeProjectRunner(w http.ResponseWriter, r *http.Request, _ ProjectID, _ RunnerID) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectRunnerFromContext(ctx)
+	record.Token = secret.Generate(32)
+
+	if err := record.SerializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to encrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RevokeProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to encrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if _, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Runners.Revoke(
+		ctx,
+		project,
+		record,
+	); err != nil {
+		slog.Error(
+			"Failed to revoke token",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("runner", record.ID),
+			slog.String("action", "RevokeProjectRunner"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to revoke token"),
+			Status:  ToPtr(http.StatusBadRequest),
+		})
+
+		return
+	}
+
+	a.RenderNotify(w, r, Notification{
+		Message: ToPtr("Successfully revoked token"),
+		Status:  ToPtr(http.StatusOK),
+	})
+}
+
+// rotateRunnerToken replaces the runner token and keeps the previous one valid
+// for the grace period, the record gets encrypted again afterwards.
+func (a *API) rotateRunnerToken(record *model.Runner, grace time.Duration) error {
+	if err := record.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		return err
+	}
+
+	record.GraceToken = ""
+	record.GraceExpiresAt = time.Time{}
+
+	if grace > 0 {
+		record.GraceToken = record.Token
+		record.GraceExpiresAt = time.Now().Add(grace)
+	}
+
+	record.Token = secret.Generate(32)
+	return record.SerializeSecret(a.config.Encrypt.Passphrase)
+}
+
 func (a *API) convertRunner(record *model.Runner) Runner {
 	result := Runner{
 		ID:        ToPtr(record.ID),
 		Slug:      ToPtr(record.Slug),
 		Name:      ToPtr(record.Name),
 		Token:     ToPtr(record.Token),
+		Labels:    ToPtr(map[string]string(record.Labels)),
+		Capacity:  ToPtr(record.Capacity),
+		Version:   ToPtr(record.Version),
+		Hostname:  ToPtr(record.Hostname),
+		OS:        ToPtr(record.OS),
+		Arch:      ToPtr(record.Arch),
 		CreatedAt: ToPtr(record.CreatedAt),
 		UpdatedAt: ToPtr(record.UpdatedAt),
 	}
 
+	if !record.LastSeenAt.IsZero() {
+		result.LastSeenAt = ToPtr(record.LastSeenAt)
+	}
+
+	if !record.RotatedAt.IsZero() {
+		result.RotatedAt = ToPtr(record.RotatedAt)
+	}
+
+	if record.GraceActive() {
+		result.GraceExpiresAt = ToPtr(record.GraceExpiresAt)
+	}
+
 	return result
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gexec/gexec/pkg/middleware/current"
 	"github.com/gexec/gexec/pkg/model"
@@ -11,6 +12,11 @@ import (
 	"github.com/go-chi/render"
 )
 
+const (
+	// scheduleMaxNext defines the maximum of upcoming runs to compute.
+	scheduleMaxNext = 100
+)
+
 // ListProjectSchedules implements the v1.ServerInterface.
 func (a *API) ListProjectSchedules(w http.ResponseWriter, r *http.Request, _ ProjectID, params ListProjectSchedulesParams) {
 	ctx := r.Context()
@@ -78,7 +84,7 @@ func (a *API) ListProjectSchedules(w http.ResponseWriter, r *http.Request, _ Pro
 }
 
 // ShowProjectSchedule implements the v1.ServerInterface.
-func (a *API) ShowProjectSchedule(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ScheduleID) {
+func (a *API) ShowProjectSchedule(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ScheduleID, params ShowProjectScheduleParams) {
 	ctx := r.Context()
 	project := a.ProjectFromContext(ctx)
 	record := a.ProjectScheduleFromContext(ctx)
@@ -100,11 +106,90 @@ func (a *API) ShowProjectSchedule(w http.ResponseWriter, r *http.Request, _ Proj
 		return
 	}
 
+	result := a.convertSchedule(record)
+
+	if record.Active {
+		next := 5
+
+		if params.Next != nil {
+			next = min(max(FromPtr(params.Next), 0), scheduleMaxNext)
+		}
+
+		result.NextRuns = ToPtr(record.Next(time.Now(), next))
+	}
+
 	render.JSON(w, r, ProjectScheduleResponse(
-		a.convertSchedule(record),
+		result,
 	))
 }
 
+// ListProjectScheduleRuns implements the v1.ServerInterface.
+func (a *API) ListProjectScheduleRuns(w http.ResponseWriter, r *http.Request, _ ProjectID, _ ScheduleID, params ListProjectScheduleRunsParams) {
+	ctx := r.Context()
+	project := a.ProjectFromContext(ctx)
+	record := a.ProjectScheduleFromContext(ctx)
+	_, limit, offset, _ := toPageParams(nil, params.Limit, params.Offset, nil)
+
+	records, count, err := a.storage.WithPrincipal(
+		current.GetUser(ctx),
+	).Schedules.Runs(
+		ctx,
+		record,
+		model.ListParams{
+			Limit:  limit,
+			Offset: offset,
+		},
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to load schedule runs",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("schedule", record.ID),
+			slog.String("action", "ListProjectScheduleRuns"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to load schedule runs"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	if err := record.DeserializeSecret(a.config.Encrypt.Passphrase); err != nil {
+		slog.Error(
+			"Failed to decrypt secrets",
+			slog.Any("error", err),
+			slog.String("project", project.ID),
+			slog.String("schedule", record.ID),
+			slog.String("action", "ListProjectScheduleRuns"),
+		)
+
+		a.RenderNotify(w, r, Notification{
+			Message: ToPtr("Failed to decrypt secrets"),
+			Status:  ToPtr(http.StatusInternalServerError),
+		})
+
+		return
+	}
+
+	payload := make([]ScheduleRun, len(records))
+	for id, run := range records {
+		payload[id] = a.convertScheduleRun(run)
+	}
+
+	render.JSON(w, r, ProjectScheduleRunsResponse{
+		Total:    count,
+		Limit:    limit,
+		Offset:   offset,
+		Project:  ToPtr(a.convertProject(project)),
+		Schedule: ToPtr(a.convertSchedule(record)),
+		Runs:     payload,
+	})
+}
+
 // CreateProjectSchedule implements the v1.ServerInterface.
 func (a *API) CreateProjectSchedule(w http.ResponseWriter, r *http.Request, _ ProjectID) {
 	ctx := r.Context()
@@ -147,6 +232,26 @@ func (a *API) CreateProjectSchedule(w http.ResponseWriter, r *http.Request, _ Pr
 		incoming.Cron = FromPtr(body.Cron)
 	}
 
+	if body.Timezone != nil {
+		incoming.Timezone = FromPtr(body.Timezone)
+	}
+
+	if body.Missed != nil {
+		incoming.Missed = FromPtr(body.Missed)
+	}
+
+	if body.RunAt != nil {
+		incoming.RunAt = FromPtr(body.RunAt)
+	}
+
+	if body.StartAt != nil {
+		incoming.StartAt = FromPtr(body.StartAt)
+	}
+
+	if body.EndAt != nil {
+		incoming.EndAt = FromPtr(body.EndAt)
+	}
+
 	if body.Active != nil {
 		incoming.Active = FromPtr(body.Active)
 	}
@@ -275,6 +380,26 @@ func (a *API) UpdateProjectSchedule(w http.ResponseWriter, r *http.Request, _ Pr
 		incoming.Cron = FromPtr(body.Cron)
 	}
 
+	if body.Timezone != nil {
+		incoming.Timezone = FromPtr(body.Timezone)
+	}
+
+	if body.Missed != nil {
+		incoming.Missed = FromPtr(body.Missed)
+	}
+
+	if body.RunAt != nil {
+		incoming.RunAt = FromPtr(body.RunAt)
+	}
+
+	if body.StartAt != nil {
+		incoming.StartAt = FromPtr(body.StartAt)
+	}
+
+	if body.EndAt != nil {
+		incoming.EndAt = FromPtr(body.EndAt)
+	}
+
 	if body.Active != nil {
 		incoming.Active = FromPtr(body.Active)
 	}
@@ -388,12 +513,38 @@ func (a *API) convertSchedule(record *model.Schedule) Schedule {
 		ID:        ToPtr(record.ID),
 		Slug:      ToPtr(record.Slug),
 		Name:      ToPtr(record.Name),
-		Cron:      ToPtr(record.Cron),
+		Timezone:  ToPtr(record.Timezone),
+		Missed:    ToPtr(ScheduleMissed(record.Missed)),
 		Active:    ToPtr(record.Active),
 		CreatedAt: ToPtr(record.CreatedAt),
 		UpdatedAt: ToPtr(record.UpdatedAt),
 	}
 
+	if record.Cron != "" {
+		result.Cron = ToPtr(record.Cron)
+	}
+
+	if !record.RunAt.IsZero() {
+		result.RunAt = ToPtr(record.RunAt)
+	}
+
+	if !record.StartAt.IsZero() {
+		result.StartAt = ToPtr(record.StartAt)
+	}
+
+	if !record.EndAt.IsZero() {
+		result.EndAt = ToPtr(record.EndAt)
+	}
+
+	if !record.LastRunAt.IsZero() {
+		result.LastRunAt = ToPtr(record.LastRunAt)
+		result.LastStatus = ToPtr(string(record.LastStatus))
+	}
+
+	if record.LastExecutionID != "" {
+		result.LastExecutionID = ToPtr(record.LastExecutionID)
+	}
+
 	if record.Template != nil {
 		result.TemplateID = ToPtr(record.TemplateID)
 
@@ -407,6 +558,29 @@ func (a *API) convertSchedule(record *model.Schedule) Schedule {
 	return result
 }
 
+func (a *API) convertScheduleRun(record *model.ScheduleRun) ScheduleRun {
+	result := ScheduleRun{
+		ID:          ToPtr(record.ID),
+		Status:      ToPtr(string(record.Status)),
+		TriggeredAt: ToPtr(record.TriggeredAt),
+		CreatedAt:   ToPtr(record.CreatedAt),
+	}
+
+	if record.ExecutionID != "" {
+		result.ExecutionID = ToPtr(record.ExecutionID)
+	}
+
+	if record.Message != "" {
+		result.Message = ToPtr(record.Message)
+	}
+
+	if !record.FinishedAt.IsZero() {
+		result.FinishedAt = ToPtr(record.FinishedAt)
+	}
+
+	return result
+}
+
 // AllowShowProjectSchedule defines a middleware to check permissions.
 func (a *API) AllowShowProjectSchedule(next http.Handler) http.Handler {
 	return a.AllowShowProject(next)
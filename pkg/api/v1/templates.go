@@ -175,10 +175,34 @@ func (a *API) CreateProjectTemplate(w http.ResponseWriter, r *http.Request, _ Pr
 		incoming.Branch = FromPtr(body.Branch)
 	}
 
+	if body.Image != nil {
+		incoming.Image = FromPtr(body.Image)
+	}
+
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if body.AllowOverride != nil {
 		incoming.Override = FromPtr(body.AllowOverride)
 	}
 
+	if body.Sequential != nil {
+		incoming.Sequential = FromPtr(body.Sequential)
+	}
+
+	if body.RetryAttempts != nil {
+		incoming.RetryAttempts = FromPtr(body.RetryAttempts)
+	}
+
+	if body.RetryBackoff != nil {
+		incoming.RetryBackoff = FromPtr(body.RetryBackoff)
+	}
+
+	if body.RetryCodes != nil {
+		incoming.RetryCodes = model.ExitCodes(FromPtr(body.RetryCodes))
+	}
+
 	if body.Surveys != nil {
 		incoming.Surveys = make([]*model.TemplateSurvey, 0)
 
@@ -401,10 +425,34 @@ func (a *API) UpdateProjectTemplate(w http.ResponseWriter, r *http.Request, _ Pr
 		incoming.Branch = FromPtr(body.Branch)
 	}
 
+	if body.Image != nil {
+		incoming.Image = FromPtr(body.Image)
+	}
+
+	if body.Labels != nil {
+		incoming.Labels = model.Labels(FromPtr(body.Labels))
+	}
+
 	if body.AllowOverride != nil {
 		incoming.Override = FromPtr(body.AllowOverride)
 	}
 
+	if body.Sequential != nil {
+		incoming.Sequential = FromPtr(body.Sequential)
+	}
+
+	if body.RetryAttempts != nil {
+		incoming.RetryAttempts = FromPtr(body.RetryAttempts)
+	}
+
+	if body.RetryBackoff != nil {
+		incoming.RetryBackoff = FromPtr(body.RetryBackoff)
+	}
+
+	if body.RetryCodes != nil {
+		incoming.RetryCodes = model.ExitCodes(FromPtr(body.RetryCodes))
+	}
+
 	if body.Surveys != nil {
 		incoming.Surveys = make([]*model.TemplateSurvey, 0)
 
@@ -1282,7 +1330,13 @@ func (a *API) convertTemplate(record *model.Template) Template {
 		Limit:         ToPtr(record.Limit),
 		Executor:      ToPtr(record.Executor),
 		Branch:        ToPtr(record.Branch),
+		Image:         ToPtr(record.Image),
+		Labels:        ToPtr(map[string]string(record.Labels)),
 		AllowOverride: ToPtr(record.Override),
+		Sequential:    ToPtr(record.Sequential),
+		RetryAttempts: ToPtr(record.RetryAttempts),
+		RetryBackoff:  ToPtr(record.RetryBackoff),
+		RetryCodes:    ToPtr([]int(record.RetryCodes)),
 		CreatedAt:     ToPtr(record.CreatedAt),
 		UpdatedAt:     ToPtr(record.UpdatedAt),
 	}
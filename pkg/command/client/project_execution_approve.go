@@ -0,0 +1,102 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectExecutionApproveBind struct {
+	ProjectID   string
+	ExecutionID string
+}
+
+var (
+	projectExecutionApproveCmd = &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a project execution awaiting confirmation",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectExecutionApproveAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectExecutionApproveArgs = projectExecutionApproveBind{}
+)
+
+func init() {
+	projectExecutionCmd.AddCommand(projectExecutionApproveCmd)
+
+	projectExecutionApproveCmd.Flags().StringVar(
+		&projectExecutionApproveArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectExecutionApproveCmd.Flags().StringVar(
+		&projectExecutionApproveArgs.ExecutionID,
+		"execution-id",
+		"",
+		"Execution ID or slug",
+	)
+}
+
+func projectExecutionApproveAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectExecutionApproveArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectExecutionApproveArgs.ExecutionID == "" {
+		return fmt.Errorf("you must provide a execution ID or a slug")
+	}
+
+	resp, err := client.ApproveProjectExecutionWithResponse(
+		ccmd.Context(),
+		projectExecutionApproveArgs.ProjectID,
+		projectExecutionApproveArgs.ExecutionID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		fmt.Fprintln(os.Stderr, "Successfully approved")
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -1,21 +1,32 @@
 package command
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"text/template"
 
 	v1 "github.com/gexec/gexec/pkg/api/v1"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 type projectExecutionCreateBind struct {
-	ProjectID  string
-	TemplateID string
-	Debug      bool
-	Format     string
+	ProjectID   string
+	TemplateID  string
+	Debug       bool
+	Priority    int
+	Branch      string
+	Limit       string
+	Path        string
+	Arguments   string
+	Environment string
+	Secret      string
+	Answers     map[string]string
+	Format      string
 }
 
 var (
@@ -55,6 +66,62 @@ func init() {
 		"Debug for project execution",
 	)
 
+	projectExecutionCreateCmd.Flags().IntVar(
+		&projectExecutionCreateArgs.Priority,
+		"priority",
+		50,
+		"Priority for project execution, between 0 and 100",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Branch,
+		"branch",
+		"",
+		"Branch override for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Limit,
+		"limit",
+		"",
+		"Limit override for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Path,
+		"path",
+		"",
+		"Path override for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Arguments,
+		"arguments",
+		"",
+		"Arguments override for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Environment,
+		"environment",
+		"",
+		"Extra environment as JSON for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringVar(
+		&projectExecutionCreateArgs.Secret,
+		"secret",
+		"",
+		"Extra secrets as JSON for project execution",
+	)
+
+	projectExecutionCreateCmd.Flags().StringToStringVar(
+		&projectExecutionCreateArgs.Answers,
+		"answer",
+		map[string]string{},
+		"Survey answers for project execution, e.g. version=1.0",
+	)
+
 	projectExecutionCreateCmd.Flags().StringVar(
 		&projectExecutionCreateArgs.Format,
 		"format",
@@ -85,6 +152,52 @@ func projectExecutionCreateAction(ccmd *cobra.Command, _ []string, client *Clien
 		changed = true
 	}
 
+	if ccmd.Flags().Changed("priority") {
+		body.Priority = v1.ToPtr(projectExecutionCreateArgs.Priority)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Branch; val != "" {
+		body.Branch = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Limit; val != "" {
+		body.Limit = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Path; val != "" {
+		body.Path = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Arguments; val != "" {
+		body.Arguments = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Environment; val != "" {
+		body.Environment = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectExecutionCreateArgs.Secret; val != "" {
+		body.Secret = v1.ToPtr(val)
+		changed = true
+	}
+
+	answers, err := projectExecutionCreateSurvey(ccmd, client)
+
+	if err != nil {
+		return err
+	}
+
+	if len(answers) > 0 {
+		body.Answers = v1.ToPtr(answers)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
@@ -156,3 +269,79 @@ func projectExecutionCreateAction(ccmd *cobra.Command, _ []string, client *Clien
 
 	return nil
 }
+
+// projectExecutionCreateSurvey prompts for missing answers to required survey
+// questions of the template, only if the client runs within a terminal.
+func projectExecutionCreateSurvey(ccmd *cobra.Command, client *Client) (map[string]string, error) {
+	answers := projectExecutionCreateArgs.Answers
+
+	if stat, err := os.Stdin.Stat(); err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return answers, nil
+	}
+
+	resp, err := client.ShowProjectTemplateWithResponse(
+		ccmd.Context(),
+		projectExecutionCreateArgs.ProjectID,
+		projectExecutionCreateArgs.TemplateID,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Let the execution endpoint report proper errors.
+	if resp.StatusCode() != http.StatusOK || resp.JSON200.Surveys == nil {
+		return answers, nil
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	for _, survey := range v1.FromPtr(resp.JSON200.Surveys) {
+		name := v1.FromPtr(survey.Name)
+
+		if !v1.FromPtr(survey.Required) || answers[name] != "" {
+			continue
+		}
+
+		label := v1.FromPtr(survey.Title)
+
+		if label == "" {
+			label = name
+		}
+
+		if survey.Values != nil && len(v1.FromPtr(survey.Values)) > 0 {
+			values := make([]string, 0)
+
+			for _, row := range v1.FromPtr(survey.Values) {
+				values = append(values, v1.FromPtr(row.Value))
+			}
+
+			label = fmt.Sprintf("%s [%s]", label, strings.Join(values, ", "))
+		}
+
+		fmt.Fprintf(os.Stderr, "%s: ", label)
+
+		// Secrets must not be echoed within the terminal.
+		if v1.FromPtr(survey.Kind) == v1.Secret && term.IsTerminal(int(os.Stdin.Fd())) {
+			secret, err := term.ReadPassword(int(os.Stdin.Fd()))
+			fmt.Fprintln(os.Stderr)
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to read answer: %w", err)
+			}
+
+			answers[name] = strings.TrimSpace(string(secret))
+			continue
+		}
+
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read answer: %w", err)
+		}
+
+		answers[name] = strings.TrimSpace(line)
+	}
+
+	return answers, nil
+}
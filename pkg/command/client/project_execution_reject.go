@@ -0,0 +1,102 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectExecutionRejectBind struct {
+	ProjectID   string
+	ExecutionID string
+}
+
+var (
+	projectExecutionRejectCmd = &cobra.Command{
+		Use:   "reject",
+		Short: "Reject a project execution awaiting confirmation",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectExecutionRejectAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectExecutionRejectArgs = projectExecutionRejectBind{}
+)
+
+func init() {
+	projectExecutionCmd.AddCommand(projectExecutionRejectCmd)
+
+	projectExecutionRejectCmd.Flags().StringVar(
+		&projectExecutionRejectArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectExecutionRejectCmd.Flags().StringVar(
+		&projectExecutionRejectArgs.ExecutionID,
+		"execution-id",
+		"",
+		"Execution ID or slug",
+	)
+}
+
+func projectExecutionRejectAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectExecutionRejectArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectExecutionRejectArgs.ExecutionID == "" {
+		return fmt.Errorf("you must provide a execution ID or a slug")
+	}
+
+	resp, err := client.RejectProjectExecutionWithResponse(
+		ccmd.Context(),
+		projectExecutionRejectArgs.ProjectID,
+		projectExecutionRejectArgs.ExecutionID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		fmt.Fprintln(os.Stderr, "Successfully rejected")
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectExecutionRerunBind struct {
+	ProjectID   string
+	ExecutionID string
+	Format      string
+}
+
+var (
+	projectExecutionRerunCmd = &cobra.Command{
+		Use:   "rerun",
+		Short: "Rerun a project execution with the same parameters",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectExecutionRerunAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectExecutionRerunArgs = projectExecutionRerunBind{}
+)
+
+func init() {
+	projectExecutionCmd.AddCommand(projectExecutionRerunCmd)
+
+	projectExecutionRerunCmd.Flags().StringVar(
+		&projectExecutionRerunArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectExecutionRerunCmd.Flags().StringVar(
+		&projectExecutionRerunArgs.ExecutionID,
+		"execution-id",
+		"",
+		"Execution ID or slug",
+	)
+
+	projectExecutionRerunCmd.Flags().StringVar(
+		&projectExecutionRerunArgs.Format,
+		"format",
+		tmplProjectExecutionShow,
+		"Custom output format",
+	)
+}
+
+func projectExecutionRerunAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectExecutionRerunArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectExecutionRerunArgs.ExecutionID == "" {
+		return fmt.Errorf("you must provide a execution ID or a slug")
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(projectExecutionRerunArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	resp, err := client.RerunProjectExecutionWithResponse(
+		ccmd.Context(),
+		projectExecutionRerunArgs.ProjectID,
+		projectExecutionRerunArgs.ExecutionID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusUnprocessableEntity:
+		return validationError(resp.JSON422)
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -18,22 +18,61 @@ ID: {{ .ID }}
 Template: {{ .Slug }}
 {{ end -}}
 Status: {{ .Status }}
+{{ with .Priority -}}
+Priority: {{ . }}
+{{ end -}}
+{{ with .QueuePosition -}}
+Queue Position: {{ . }}
+{{ end -}}
+{{ with .ParentID -}}
+Parent: {{ . }}
+{{ end -}}
+{{ with .ScheduleID -}}
+Schedule: {{ . }}
+{{ end -}}
+{{ with .Attempt -}}
+Attempt: {{ . }}
+{{ end -}}
+{{ with .NotBefore -}}
+Not Before: {{ . }}
+{{ end -}}
 Debug: {{ .Debug }}
 {{ with .Path -}}
 Path: {{ . }}
 {{ end -}}
+{{ with .Arguments -}}
+Arguments: {{ . }}
+{{ end -}}
 {{ with .Environment -}}
 Environment: {{ . }}
 {{ end -}}
 {{ with .Secret -}}
 Secret: {{ . }}
 {{ end -}}
+{{ with .Answers -}}
+Answers: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
 {{ with .Limit -}}
 Limit: {{ . }}
 {{ end -}}
 {{ with .Branch -}}
 Branch: {{ . }}
 {{ end -}}
+{{ with .CommitSHA -}}
+Commit: {{ . }}
+{{ end -}}
+{{ with .Runner -}}
+Runner: {{ .Slug }}
+{{ end -}}
+{{ with .StartedAt -}}
+Started: {{ . }}
+{{ end -}}
+{{ with .FinishedAt -}}
+Finished: {{ . }}
+{{ end -}}
+{{ with .ExitCode -}}
+Exit Code: {{ . }}
+{{ end -}}
 Created: {{ .CreatedAt }}
 Updated: {{ .UpdatedAt }}
 `
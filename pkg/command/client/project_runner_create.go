@@ -15,6 +15,7 @@ type projectRunnerCreateBind struct {
 	ProjectID string
 	Slug      string
 	Name      string
+	Labels    map[string]string
 	Format    string
 }
 
@@ -55,6 +56,13 @@ func init() {
 		"Name for project runner",
 	)
 
+	projectRunnerCreateCmd.Flags().StringToStringVar(
+		&projectRunnerCreateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for project runner, e.g. dc=fra",
+	)
+
 	projectRunnerCreateCmd.Flags().StringVar(
 		&projectRunnerCreateArgs.Format,
 		"format",
@@ -85,6 +93,11 @@ func projectRunnerCreateAction(ccmd *cobra.Command, _ []string, client *Client)
 		changed = true
 	}
 
+	if val := projectRunnerCreateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	projectRunnerJoinCmd = &cobra.Command{
+		Use:   "join",
+		Short: "Project runner join token commands",
+		Args:  cobra.NoArgs,
+	}
+)
+
+func init() {
+	projectRunnerCmd.AddCommand(projectRunnerJoinCmd)
+}
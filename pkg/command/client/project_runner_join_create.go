@@ -0,0 +1,181 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectRunnerJoinCreateBind struct {
+	ProjectID string
+	Name      string
+	Uses      int
+	Expire    time.Duration
+	Labels    map[string]string
+	Format    string
+}
+
+// tmplProjectRunnerJoinShow represents a project join token within details view.
+var tmplProjectRunnerJoinShow = "Name: \x1b[33m{{ .Name }} \x1b[0m" + `
+ID: {{ .ID }}
+{{ with .Token -}}
+Token: {{ . }}
+{{ end -}}
+{{ with .Labels -}}
+Labels: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
+Uses: {{ .Used }}/{{ .Uses }}
+Expires: {{ .ExpiresAt }}
+Created: {{ .CreatedAt }}
+`
+
+var (
+	projectRunnerJoinCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create a project join token",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectRunnerJoinCreateAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectRunnerJoinCreateArgs = projectRunnerJoinCreateBind{}
+)
+
+func init() {
+	projectRunnerJoinCmd.AddCommand(projectRunnerJoinCreateCmd)
+
+	projectRunnerJoinCreateCmd.Flags().StringVar(
+		&projectRunnerJoinCreateArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectRunnerJoinCreateCmd.Flags().StringVar(
+		&projectRunnerJoinCreateArgs.Name,
+		"name",
+		"",
+		"Name for join token",
+	)
+
+	projectRunnerJoinCreateCmd.Flags().IntVar(
+		&projectRunnerJoinCreateArgs.Uses,
+		"uses",
+		1,
+		"How many runners are able to join",
+	)
+
+	projectRunnerJoinCreateCmd.Flags().DurationVar(
+		&projectRunnerJoinCreateArgs.Expire,
+		"expire",
+		time.Hour,
+		"Duration until the join token expires",
+	)
+
+	projectRunnerJoinCreateCmd.Flags().StringToStringVar(
+		&projectRunnerJoinCreateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for joined runners, e.g. dc=fra",
+	)
+
+	projectRunnerJoinCreateCmd.Flags().StringVar(
+		&projectRunnerJoinCreateArgs.Format,
+		"format",
+		tmplProjectRunnerJoinShow,
+		"Custom output format",
+	)
+}
+
+func projectRunnerJoinCreateAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectRunnerJoinCreateArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectRunnerJoinCreateArgs.Name == "" {
+		return fmt.Errorf("you must provide a name")
+	}
+
+	body := v1.CreateProjectJoinJSONRequestBody{
+		Name:      v1.ToPtr(projectRunnerJoinCreateArgs.Name),
+		Uses:      v1.ToPtr(projectRunnerJoinCreateArgs.Uses),
+		ExpiresAt: v1.ToPtr(time.Now().Add(projectRunnerJoinCreateArgs.Expire)),
+	}
+
+	if val := projectRunnerJoinCreateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(projectRunnerJoinCreateArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	resp, err := client.CreateProjectJoinWithResponse(
+		ccmd.Context(),
+		projectRunnerJoinCreateArgs.ProjectID,
+		body,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusUnprocessableEntity:
+		return validationError(resp.JSON422)
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectRunnerJoinDeleteBind struct {
+	ProjectID string
+	JoinID    string
+}
+
+var (
+	projectRunnerJoinDeleteCmd = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a project join token",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectRunnerJoinDeleteAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectRunnerJoinDeleteArgs = projectRunnerJoinDeleteBind{}
+)
+
+func init() {
+	projectRunnerJoinCmd.AddCommand(projectRunnerJoinDeleteCmd)
+
+	projectRunnerJoinDeleteCmd.Flags().StringVar(
+		&projectRunnerJoinDeleteArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectRunnerJoinDeleteCmd.Flags().StringVar(
+		&projectRunnerJoinDeleteArgs.JoinID,
+		"join-id",
+		"",
+		"Join token ID",
+	)
+}
+
+func projectRunnerJoinDeleteAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectRunnerJoinDeleteArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectRunnerJoinDeleteArgs.JoinID == "" {
+		return fmt.Errorf("you must provide an ID")
+	}
+
+	resp, err := client.DeleteProjectJoinWithResponse(
+		ccmd.Context(),
+		projectRunnerJoinDeleteArgs.ProjectID,
+		projectRunnerJoinDeleteArgs.JoinID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		fmt.Fprintln(os.Stderr, "Successfully deleted")
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
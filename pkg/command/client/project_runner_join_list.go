@@ -0,0 +1,130 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectRunnerJoinListBind struct {
+	ProjectID string
+	Format    string
+}
+
+// tmplProjectRunnerJoinList represents a row within project join token listing.
+var tmplProjectRunnerJoinList = "{{ range . }}Name: \x1b[33m{{ .Name }} \x1b[0m" + `
+ID: {{ .ID }}
+Uses: {{ .Used }}/{{ .Uses }}
+Expires: {{ .ExpiresAt }}
+
+{{ end -}}`
+
+var (
+	projectRunnerJoinListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List all join tokens for a project",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectRunnerJoinListAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectRunnerJoinListArgs = projectRunnerJoinListBind{}
+)
+
+func init() {
+	projectRunnerJoinCmd.AddCommand(projectRunnerJoinListCmd)
+
+	projectRunnerJoinListCmd.Flags().StringVar(
+		&projectRunnerJoinListArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectRunnerJoinListCmd.Flags().StringVar(
+		&projectRunnerJoinListArgs.Format,
+		"format",
+		tmplProjectRunnerJoinList,
+		"Custom output format",
+	)
+}
+
+func projectRunnerJoinListAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectRunnerJoinListArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	resp, err := client.ListProjectJoinsWithResponse(
+		ccmd.Context(),
+		projectRunnerJoinListArgs.ProjectID,
+		&v1.ListProjectJoinsParams{
+			Limit:  v1.ToPtr(10000),
+			Offset: v1.ToPtr(0),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(projectRunnerJoinListArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		records := resp.JSON200.Joins
+
+		if len(records) == 0 {
+			fmt.Fprintln(os.Stderr, "Empty result")
+			return nil
+		}
+
+		if err := tmpl.Execute(
+			os.Stdout,
+			records,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -20,6 +20,9 @@ type projectRunnerListBind struct {
 var tmplProjectRunnerList = "{{ range . }}Slug: \x1b[33m{{ .Slug }} \x1b[0m" + `
 ID: {{ .ID }}
 Name: {{ .Name }}
+{{ with .LastSeenAt -}}
+Last Seen: {{ . }}
+{{ end -}}
 
 {{ end -}}`
 
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectRunnerRevokeBind struct {
+	ProjectID string
+	RunnerID  string
+}
+
+var (
+	projectRunnerRevokeCmd = &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke the token of a project runner",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectRunnerRevokeAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectRunnerRevokeArgs = projectRunnerRevokeBind{}
+)
+
+func init() {
+	projectRunnerCmd.AddCommand(projectRunnerRevokeCmd)
+
+	projectRunnerRevokeCmd.Flags().StringVar(
+		&projectRunnerRevokeArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectRunnerRevokeCmd.Flags().StringVar(
+		&projectRunnerRevokeArgs.RunnerID,
+		"runner-id",
+		"",
+		"Runner ID or slug",
+	)
+}
+
+func projectRunnerRevokeAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectRunnerRevokeArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectRunnerRevokeArgs.RunnerID == "" {
+		return fmt.Errorf("you must provide a runner ID or a slug")
+	}
+
+	resp, err := client.RevokeProjectRunnerWithResponse(
+		ccmd.Context(),
+		projectRunnerRevokeArgs.ProjectID,
+		projectRunnerRevokeArgs.RunnerID,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		fmt.Fprintln(os.Stderr, "Successfully revoked")
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type projectRunnerRotateBind struct {
+	ProjectID string
+	RunnerID  string
+	Grace     time.Duration
+	Format    string
+}
+
+var (
+	projectRunnerRotateCmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the token of a project runner",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, projectRunnerRotateAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	projectRunnerRotateArgs = projectRunnerRotateBind{}
+)
+
+func init() {
+	projectRunnerCmd.AddCommand(projectRunnerRotateCmd)
+
+	projectRunnerRotateCmd.Flags().StringVar(
+		&projectRunnerRotateArgs.ProjectID,
+		"project-id",
+		"",
+		"Project ID or slug",
+	)
+
+	projectRunnerRotateCmd.Flags().StringVar(
+		&projectRunnerRotateArgs.RunnerID,
+		"runner-id",
+		"",
+		"Runner ID or slug",
+	)
+
+	projectRunnerRotateCmd.Flags().DurationVar(
+		&projectRunnerRotateArgs.Grace,
+		"grace",
+		24*time.Hour,
+		"Duration the previous token stays valid",
+	)
+
+	projectRunnerRotateCmd.Flags().StringVar(
+		&projectRunnerRotateArgs.Format,
+		"format",
+		tmplProjectRunnerShow,
+		"Custom output format",
+	)
+}
+
+func projectRunnerRotateAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if projectRunnerRotateArgs.ProjectID == "" {
+		return fmt.Errorf("you must provide a project ID or a slug")
+	}
+
+	if projectRunnerRotateArgs.RunnerID == "" {
+		return fmt.Errorf("you must provide a runner ID or a slug")
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(projectRunnerRotateArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	resp, err := client.RotateProjectRunnerWithResponse(
+		ccmd.Context(),
+		projectRunnerRotateArgs.ProjectID,
+		projectRunnerRotateArgs.RunnerID,
+		v1.RotateProjectRunnerJSONRequestBody{
+			Grace: v1.ToPtr(int(projectRunnerRotateArgs.Grace.Seconds())),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusUnprocessableEntity:
+		return validationError(resp.JSON422)
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
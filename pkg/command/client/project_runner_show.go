@@ -16,6 +16,30 @@ var tmplProjectRunnerShow = "Slug: \x1b[33m{{ .Slug }} \x1b[0m" + `
 ID: {{ .ID }}
 Name: {{ .Name }}
 Token: {{ .Token }}
+{{ with .Labels -}}
+Labels: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
+{{ with .Capacity -}}
+Capacity: {{ . }}
+{{ end -}}
+{{ with .Version -}}
+Version: {{ . }}
+{{ end -}}
+{{ with .Hostname -}}
+Hostname: {{ . }}
+{{ end -}}
+{{ with .OS -}}
+Platform: {{ . }}/{{ $.Arch }}
+{{ end -}}
+{{ with .LastSeenAt -}}
+Last Seen: {{ . }}
+{{ end -}}
+{{ with .RotatedAt -}}
+Rotated: {{ . }}
+{{ end -}}
+{{ with .GraceExpiresAt -}}
+Grace Until: {{ . }}
+{{ end -}}
 Created: {{ .CreatedAt }}
 Updated: {{ .UpdatedAt }}`
 
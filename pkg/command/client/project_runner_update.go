@@ -16,6 +16,7 @@ type projectRunnerUpdateBind struct {
 	RunnerID  string
 	Slug      string
 	Name      string
+	Labels    map[string]string
 	Format    string
 }
 
@@ -63,6 +64,13 @@ func init() {
 		"Name for project runner",
 	)
 
+	projectRunnerUpdateCmd.Flags().StringToStringVar(
+		&projectRunnerUpdateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for project runner, e.g. dc=fra",
+	)
+
 	projectRunnerUpdateCmd.Flags().StringVar(
 		&projectRunnerUpdateArgs.Format,
 		"format",
@@ -93,6 +101,11 @@ func projectRunnerUpdateAction(ccmd *cobra.Command, _ []string, client *Client)
 		changed = true
 	}
 
+	if val := projectRunnerUpdateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
@@ -1,6 +1,9 @@
 package command
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
 )
 
@@ -15,3 +18,19 @@ var (
 func init() {
 	projectCmd.AddCommand(projectScheduleCmd)
 }
+
+// projectScheduleTime parses a RFC3339 timestamp, an empty value results in
+// a zero time to clear the timestamp.
+func projectScheduleTime(name, val string) (time.Time, error) {
+	if val == "" {
+		return time.Time{}, nil
+	}
+
+	result, err := time.Parse(time.RFC3339, val)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s, expected RFC3339: %w", name, err)
+	}
+
+	return result, nil
+}
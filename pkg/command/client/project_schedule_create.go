@@ -17,6 +17,11 @@ type projectScheduleCreateBind struct {
 	Slug       string
 	Name       string
 	Cron       string
+	Timezone   string
+	Missed     string
+	RunAt      string
+	StartAt    string
+	EndAt      string
 	Active     bool
 	Format     string
 }
@@ -72,6 +77,41 @@ func init() {
 		"Cron for project schedule",
 	)
 
+	projectScheduleCreateCmd.Flags().StringVar(
+		&projectScheduleCreateArgs.Timezone,
+		"timezone",
+		"",
+		"Time zone for project schedule",
+	)
+
+	projectScheduleCreateCmd.Flags().StringVar(
+		&projectScheduleCreateArgs.Missed,
+		"missed",
+		"",
+		"Policy for missed runs, one of skip, once or all",
+	)
+
+	projectScheduleCreateCmd.Flags().StringVar(
+		&projectScheduleCreateArgs.RunAt,
+		"run-at",
+		"",
+		"Single run for project schedule as RFC3339",
+	)
+
+	projectScheduleCreateCmd.Flags().StringVar(
+		&projectScheduleCreateArgs.StartAt,
+		"start-at",
+		"",
+		"Start date for project schedule as RFC3339",
+	)
+
+	projectScheduleCreateCmd.Flags().StringVar(
+		&projectScheduleCreateArgs.EndAt,
+		"end-at",
+		"",
+		"End date for project schedule as RFC3339",
+	)
+
 	projectScheduleCreateCmd.Flags().BoolVar(
 		&projectScheduleCreateArgs.Active,
 		"active",
@@ -100,8 +140,8 @@ func projectScheduleCreateAction(ccmd *cobra.Command, _ []string, client *Client
 		return fmt.Errorf("you must provide a name")
 	}
 
-	if projectScheduleCreateArgs.Cron == "" {
-		return fmt.Errorf("you must provide a cron")
+	if projectScheduleCreateArgs.Cron == "" && projectScheduleCreateArgs.RunAt == "" {
+		return fmt.Errorf("you must provide a cron or a run at")
 	}
 
 	body := v1.CreateProjectScheduleJSONRequestBody{}
@@ -127,6 +167,49 @@ func projectScheduleCreateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectScheduleCreateArgs.Timezone; val != "" {
+		body.Timezone = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectScheduleCreateArgs.Missed; val != "" {
+		body.Missed = v1.ToPtr(val)
+		changed = true
+	}
+
+	if projectScheduleCreateArgs.RunAt != "" {
+		val, err := projectScheduleTime("run at", projectScheduleCreateArgs.RunAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.RunAt = v1.ToPtr(val)
+		changed = true
+	}
+
+	if projectScheduleCreateArgs.StartAt != "" {
+		val, err := projectScheduleTime("start at", projectScheduleCreateArgs.StartAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.StartAt = v1.ToPtr(val)
+		changed = true
+	}
+
+	if projectScheduleCreateArgs.EndAt != "" {
+		val, err := projectScheduleTime("end at", projectScheduleCreateArgs.EndAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.EndAt = v1.ToPtr(val)
+		changed = true
+	}
+
 	if val := projectScheduleCreateArgs.Active; val {
 		body.Active = v1.ToPtr(val)
 		changed = true
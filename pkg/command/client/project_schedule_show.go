@@ -18,16 +18,58 @@ Name: {{ .Name }}
 {{ with .Template -}}
 Template: {{ .Slug }}
 {{ end -}}
-Cron: {{ .Cron }}
+{{ with .Cron -}}
+Cron: {{ . }}
+{{ end -}}
+{{ with .RunAt -}}
+Run At: {{ . }}
+{{ end -}}
+{{ with .StartAt -}}
+Start At: {{ . }}
+{{ end -}}
+{{ with .EndAt -}}
+End At: {{ . }}
+{{ end -}}
+{{ with .Timezone -}}
+Timezone: {{ . }}
+{{ end -}}
+{{ with .Missed -}}
+Missed: {{ . }}
+{{ end -}}
 Active: {{ .Active }}
+{{ with .LastRunAt -}}
+Last Run: {{ . }}
+{{ end -}}
+{{ with .LastStatus -}}
+Last Status: {{ . }}
+{{ end -}}
+{{ with .LastExecutionID -}}
+Last Execution: {{ . }}
+{{ end -}}
+{{ with .NextRuns -}}
+Next Runs: {{ range . }}{{ . }} {{ end }}
+{{ end -}}
 Created: {{ .CreatedAt }}
 Updated: {{ .UpdatedAt }}
 `
 
+// tmplProjectScheduleRuns represents the run history of a project schedule.
+var tmplProjectScheduleRuns = `{{ with .Runs -}}
+History: {{ $.Total }} runs
+{{ range . -}}
+- {{ .TriggeredAt }}: {{ .Status }}{{ with .ExecutionID }} #{{ . }}{{ end }}{{ with .Message }} {{ . }}{{ end }}
+{{ end -}}
+{{ end -}}
+`
+
 type projectScheduleShowBind struct {
-	ProjectID  string
-	ScheduleID string
-	Format     string
+	ProjectID     string
+	ScheduleID    string
+	Next          int
+	HistoryLimit  int
+	HistoryOffset int
+	HistoryFormat string
+	Format        string
 }
 
 var (
@@ -60,6 +102,34 @@ func init() {
 		"Schedule ID or slug",
 	)
 
+	projectScheduleShowCmd.Flags().IntVar(
+		&projectScheduleShowArgs.Next,
+		"next",
+		5,
+		"Number of upcoming runs",
+	)
+
+	projectScheduleShowCmd.Flags().IntVar(
+		&projectScheduleShowArgs.HistoryLimit,
+		"history-limit",
+		10,
+		"Number of runs within history",
+	)
+
+	projectScheduleShowCmd.Flags().IntVar(
+		&projectScheduleShowArgs.HistoryOffset,
+		"history-offset",
+		0,
+		"Offset of runs within history",
+	)
+
+	projectScheduleShowCmd.Flags().StringVar(
+		&projectScheduleShowArgs.HistoryFormat,
+		"history-format",
+		tmplProjectScheduleRuns,
+		"Custom output format for history",
+	)
+
 	projectScheduleShowCmd.Flags().StringVar(
 		&projectScheduleShowArgs.Format,
 		"format",
@@ -81,6 +151,9 @@ func projectScheduleShowAction(ccmd *cobra.Command, _ []string, client *Client)
 		ccmd.Context(),
 		projectScheduleShowArgs.ProjectID,
 		projectScheduleShowArgs.ScheduleID,
+		&v1.ShowProjectScheduleParams{
+			Next: v1.ToPtr(projectScheduleShowArgs.Next),
+		},
 	)
 
 	if err != nil {
@@ -101,6 +174,74 @@ func projectScheduleShowAction(ccmd *cobra.Command, _ []string, client *Client)
 		return fmt.Errorf("failed to process template: %w", err)
 	}
 
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+
+		if projectScheduleShowArgs.HistoryLimit > 0 {
+			return projectScheduleShowHistory(ccmd, client)
+		}
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
+
+func projectScheduleShowHistory(ccmd *cobra.Command, client *Client) error {
+	resp, err := client.ListProjectScheduleRunsWithResponse(
+		ccmd.Context(),
+		projectScheduleShowArgs.ProjectID,
+		projectScheduleShowArgs.ScheduleID,
+		&v1.ListProjectScheduleRunsParams{
+			Limit:  v1.ToPtr(projectScheduleShowArgs.HistoryLimit),
+			Offset: v1.ToPtr(projectScheduleShowArgs.HistoryOffset),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		projectScheduleShowArgs.HistoryFormat,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
 	switch resp.StatusCode() {
 	case http.StatusOK:
 		if err := tmpl.Execute(
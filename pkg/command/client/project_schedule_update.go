@@ -18,6 +18,11 @@ type projectScheduleUpdateBind struct {
 	Slug       string
 	Name       string
 	Cron       string
+	Timezone   string
+	Missed     string
+	RunAt      string
+	StartAt    string
+	EndAt      string
 	Active     bool
 	NoActive   bool
 	Format     string
@@ -81,6 +86,41 @@ func init() {
 		"Cron for project schedule",
 	)
 
+	projectScheduleUpdateCmd.Flags().StringVar(
+		&projectScheduleUpdateArgs.Timezone,
+		"timezone",
+		"",
+		"Time zone for project schedule",
+	)
+
+	projectScheduleUpdateCmd.Flags().StringVar(
+		&projectScheduleUpdateArgs.Missed,
+		"missed",
+		"",
+		"Policy for missed runs, one of skip, once or all",
+	)
+
+	projectScheduleUpdateCmd.Flags().StringVar(
+		&projectScheduleUpdateArgs.RunAt,
+		"run-at",
+		"",
+		"Single run for project schedule as RFC3339",
+	)
+
+	projectScheduleUpdateCmd.Flags().StringVar(
+		&projectScheduleUpdateArgs.StartAt,
+		"start-at",
+		"",
+		"Start date for project schedule as RFC3339",
+	)
+
+	projectScheduleUpdateCmd.Flags().StringVar(
+		&projectScheduleUpdateArgs.EndAt,
+		"end-at",
+		"",
+		"End date for project schedule as RFC3339",
+	)
+
 	projectScheduleUpdateCmd.Flags().BoolVar(
 		&projectScheduleUpdateArgs.Active,
 		"active",
@@ -130,8 +170,51 @@ func projectScheduleUpdateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
-	if val := projectScheduleUpdateArgs.Cron; val != "" {
-		body.Cron = v1.ToPtr(val)
+	if ccmd.Flags().Changed("cron") {
+		body.Cron = v1.ToPtr(projectScheduleUpdateArgs.Cron)
+		changed = true
+	}
+
+	if val := projectScheduleUpdateArgs.Timezone; val != "" {
+		body.Timezone = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectScheduleUpdateArgs.Missed; val != "" {
+		body.Missed = v1.ToPtr(val)
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("run-at") {
+		val, err := projectScheduleTime("run at", projectScheduleUpdateArgs.RunAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.RunAt = v1.ToPtr(val)
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("start-at") {
+		val, err := projectScheduleTime("start at", projectScheduleUpdateArgs.StartAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.StartAt = v1.ToPtr(val)
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("end-at") {
+		val, err := projectScheduleTime("end at", projectScheduleUpdateArgs.EndAt)
+
+		if err != nil {
+			return err
+		}
+
+		body.EndAt = v1.ToPtr(val)
 		changed = true
 	}
 
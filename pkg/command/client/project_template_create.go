@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"text/template"
+	"time"
 
 	v1 "github.com/gexec/gexec/pkg/api/v1"
 	"github.com/spf13/cobra"
@@ -24,7 +25,13 @@ type projectTemplateCreateBind struct {
 	Arguments      string
 	Limit          string
 	Branch         string
+	Image          string
 	AllowmOverride bool
+	Sequential     bool
+	RetryAttempts  int
+	RetryBackoff   time.Duration
+	RetryCodes     []int
+	Labels         map[string]string
 	Format         string
 }
 
@@ -86,6 +93,13 @@ func init() {
 		"Name for project template",
 	)
 
+	projectTemplateCreateCmd.Flags().StringToStringVar(
+		&projectTemplateCreateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for project template, e.g. dc=fra",
+	)
+
 	projectTemplateCreateCmd.Flags().StringVar(
 		&projectTemplateCreateArgs.Executor,
 		"executor",
@@ -128,6 +142,13 @@ func init() {
 		"Branch for project template",
 	)
 
+	projectTemplateCreateCmd.Flags().StringVar(
+		&projectTemplateCreateArgs.Image,
+		"image",
+		"",
+		"Container image for project template",
+	)
+
 	projectTemplateCreateCmd.Flags().BoolVar(
 		&projectTemplateCreateArgs.AllowmOverride,
 		"allow-override",
@@ -135,6 +156,34 @@ func init() {
 		"Allow override for project template",
 	)
 
+	projectTemplateCreateCmd.Flags().BoolVar(
+		&projectTemplateCreateArgs.Sequential,
+		"sequential",
+		false,
+		"Queue concurrent executions for project template",
+	)
+
+	projectTemplateCreateCmd.Flags().IntVar(
+		&projectTemplateCreateArgs.RetryAttempts,
+		"retry-attempts",
+		0,
+		"Maximum attempts for executions of project template",
+	)
+
+	projectTemplateCreateCmd.Flags().DurationVar(
+		&projectTemplateCreateArgs.RetryBackoff,
+		"retry-backoff",
+		0,
+		"Initial backoff between attempts for project template",
+	)
+
+	projectTemplateCreateCmd.Flags().IntSliceVar(
+		&projectTemplateCreateArgs.RetryCodes,
+		"retry-code",
+		[]int{},
+		"Exit codes to retry for project template, defaults to any",
+	)
+
 	projectTemplateCreateCmd.Flags().StringVar(
 		&projectTemplateCreateArgs.Format,
 		"format",
@@ -184,6 +233,11 @@ func projectTemplateCreateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectTemplateCreateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if val := projectTemplateCreateArgs.Executor; val != "" {
 		body.Executor = v1.ToPtr(val)
 		changed = true
@@ -214,11 +268,36 @@ func projectTemplateCreateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectTemplateCreateArgs.Image; val != "" {
+		body.Image = v1.ToPtr(val)
+		changed = true
+	}
+
 	if val := projectTemplateCreateArgs.AllowmOverride; val {
 		body.AllowOverride = v1.ToPtr(val)
 		changed = true
 	}
 
+	if val := projectTemplateCreateArgs.Sequential; val {
+		body.Sequential = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectTemplateCreateArgs.RetryAttempts; val > 0 {
+		body.RetryAttempts = v1.ToPtr(val)
+		changed = true
+	}
+
+	if val := projectTemplateCreateArgs.RetryBackoff; val > 0 {
+		body.RetryBackoff = v1.ToPtr(int(val.Seconds()))
+		changed = true
+	}
+
+	if val := projectTemplateCreateArgs.RetryCodes; len(val) > 0 {
+		body.RetryCodes = v1.ToPtr(val)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
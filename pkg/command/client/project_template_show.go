@@ -22,6 +22,12 @@ Repository: {{ .ID }} {{ .Slug }}
 {{ with .Branch -}}
 Branch: {{ . }}
 {{ end -}}
+{{ with .Image -}}
+Image: {{ . }}
+{{ end -}}
+{{ with .Labels -}}
+Labels: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
 {{ with .Inventory -}}
 Inventory: {{ .ID }} {{ .Slug }}
 {{ end -}}
@@ -41,6 +47,16 @@ Arguments: {{ . }}
 Limit: {{ . }}
 {{ end -}}
 AllowOverride: {{ .AllowOverride }}
+Sequential: {{ .Sequential }}
+{{ with .RetryAttempts -}}
+Retry Attempts: {{ . }}
+{{ end -}}
+{{ with .RetryBackoff -}}
+Retry Backoff: {{ . }}s
+{{ end -}}
+{{ with .RetryCodes -}}
+Retry Codes: {{ range . }}{{ . }} {{ end }}
+{{ end -}}
 {{ with .Surveys -}}
 Surveys: {{ len . }}
 {{ else -}}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"text/template"
+	"time"
 
 	v1 "github.com/gexec/gexec/pkg/api/v1"
 	"github.com/spf13/cobra"
@@ -27,8 +28,15 @@ type projectTemplateUpdateBind struct {
 	Arguments        string
 	Limit            string
 	Branch           string
+	Image            string
 	AllowmOverride   bool
 	NoAllowmOverride bool
+	Sequential       bool
+	NoSequential     bool
+	RetryAttempts    int
+	RetryBackoff     time.Duration
+	RetryCodes       []int
+	Labels           map[string]string
 	Format           string
 }
 
@@ -118,6 +126,13 @@ func init() {
 		"Name for project template",
 	)
 
+	projectTemplateUpdateCmd.Flags().StringToStringVar(
+		&projectTemplateUpdateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for project template, e.g. dc=fra",
+	)
+
 	projectTemplateUpdateCmd.Flags().StringVar(
 		&projectTemplateUpdateArgs.Description,
 		"description",
@@ -153,6 +168,13 @@ func init() {
 		"Branch for project template",
 	)
 
+	projectTemplateUpdateCmd.Flags().StringVar(
+		&projectTemplateUpdateArgs.Image,
+		"image",
+		"",
+		"Container image for project template",
+	)
+
 	projectTemplateUpdateCmd.Flags().BoolVar(
 		&projectTemplateUpdateArgs.AllowmOverride,
 		"allow-override",
@@ -167,6 +189,41 @@ func init() {
 		"No allow override for project template",
 	)
 
+	projectTemplateUpdateCmd.Flags().BoolVar(
+		&projectTemplateUpdateArgs.Sequential,
+		"sequential",
+		false,
+		"Queue concurrent executions for project template",
+	)
+
+	projectTemplateUpdateCmd.Flags().BoolVar(
+		&projectTemplateUpdateArgs.NoSequential,
+		"no-sequential",
+		false,
+		"Allow concurrent executions for project template",
+	)
+
+	projectTemplateUpdateCmd.Flags().IntVar(
+		&projectTemplateUpdateArgs.RetryAttempts,
+		"retry-attempts",
+		0,
+		"Maximum attempts for executions of project template",
+	)
+
+	projectTemplateUpdateCmd.Flags().DurationVar(
+		&projectTemplateUpdateArgs.RetryBackoff,
+		"retry-backoff",
+		0,
+		"Initial backoff between attempts for project template",
+	)
+
+	projectTemplateUpdateCmd.Flags().IntSliceVar(
+		&projectTemplateUpdateArgs.RetryCodes,
+		"retry-code",
+		[]int{},
+		"Exit codes to retry for project template, defaults to any",
+	)
+
 	projectTemplateUpdateCmd.Flags().StringVar(
 		&projectTemplateUpdateArgs.Format,
 		"format",
@@ -227,6 +284,11 @@ func projectTemplateUpdateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectTemplateUpdateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if val := projectTemplateUpdateArgs.Description; val != "" {
 		body.Description = v1.ToPtr(val)
 		changed = true
@@ -252,6 +314,11 @@ func projectTemplateUpdateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectTemplateUpdateArgs.Image; val != "" {
+		body.Image = v1.ToPtr(val)
+		changed = true
+	}
+
 	if val := projectTemplateUpdateArgs.AllowmOverride; val {
 		body.AllowOverride = v1.ToPtr(true)
 		changed = true
@@ -262,6 +329,31 @@ func projectTemplateUpdateAction(ccmd *cobra.Command, _ []string, client *Client
 		changed = true
 	}
 
+	if val := projectTemplateUpdateArgs.Sequential; val {
+		body.Sequential = v1.ToPtr(true)
+		changed = true
+	}
+
+	if val := projectTemplateUpdateArgs.NoSequential; val {
+		body.Sequential = v1.ToPtr(false)
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("retry-attempts") {
+		body.RetryAttempts = v1.ToPtr(projectTemplateUpdateArgs.RetryAttempts)
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("retry-backoff") {
+		body.RetryBackoff = v1.ToPtr(int(projectTemplateUpdateArgs.RetryBackoff.Seconds()))
+		changed = true
+	}
+
+	if ccmd.Flags().Changed("retry-code") {
+		body.RetryCodes = v1.ToPtr(projectTemplateUpdateArgs.RetryCodes)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
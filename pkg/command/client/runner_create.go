@@ -15,6 +15,7 @@ type runnerCreateBind struct {
 	Project string
 	Slug    string
 	Name    string
+	Labels  map[string]string
 	Format  string
 }
 
@@ -55,6 +56,13 @@ func init() {
 		"Name for runner",
 	)
 
+	runnerCreateCmd.Flags().StringToStringVar(
+		&runnerCreateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for runner, e.g. dc=fra",
+	)
+
 	runnerCreateCmd.Flags().StringVar(
 		&runnerCreateArgs.Format,
 		"format",
@@ -86,6 +94,11 @@ func runnerCreateAction(ccmd *cobra.Command, _ []string, client *Client) error {
 		changed = true
 	}
 
+	if val := runnerCreateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
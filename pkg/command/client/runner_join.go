@@ -0,0 +1,17 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	runnerJoinCmd = &cobra.Command{
+		Use:   "join",
+		Short: "Runner join token commands",
+		Args:  cobra.NoArgs,
+	}
+)
+
+func init() {
+	runnerCmd.AddCommand(runnerJoinCmd)
+}
@@ -0,0 +1,177 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type runnerJoinCreateBind struct {
+	Project string
+	Name    string
+	Uses    int
+	Expire  time.Duration
+	Labels  map[string]string
+	Format  string
+}
+
+// tmplRunnerJoinShow represents a join token within details view.
+var tmplRunnerJoinShow = "Name: \x1b[33m{{ .Name }} \x1b[0m" + `
+ID: {{ .ID }}
+{{ with .Project -}}
+Project: {{ .Name }}
+{{ end -}}
+{{ with .Token -}}
+Token: {{ . }}
+{{ end -}}
+{{ with .Labels -}}
+Labels: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
+Uses: {{ .Used }}/{{ .Uses }}
+Expires: {{ .ExpiresAt }}
+Created: {{ .CreatedAt }}
+`
+
+var (
+	runnerJoinCreateCmd = &cobra.Command{
+		Use:   "create",
+		Short: "Create a join token",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, runnerJoinCreateAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	runnerJoinCreateArgs = runnerJoinCreateBind{}
+)
+
+func init() {
+	runnerJoinCmd.AddCommand(runnerJoinCreateCmd)
+
+	runnerJoinCreateCmd.Flags().StringVar(
+		&runnerJoinCreateArgs.Project,
+		"project",
+		"",
+		"Project for joined runners",
+	)
+
+	runnerJoinCreateCmd.Flags().StringVar(
+		&runnerJoinCreateArgs.Name,
+		"name",
+		"",
+		"Name for join token",
+	)
+
+	runnerJoinCreateCmd.Flags().IntVar(
+		&runnerJoinCreateArgs.Uses,
+		"uses",
+		1,
+		"How many runners are able to join",
+	)
+
+	runnerJoinCreateCmd.Flags().DurationVar(
+		&runnerJoinCreateArgs.Expire,
+		"expire",
+		time.Hour,
+		"Duration until the join token expires",
+	)
+
+	runnerJoinCreateCmd.Flags().StringToStringVar(
+		&runnerJoinCreateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for joined runners, e.g. dc=fra",
+	)
+
+	runnerJoinCreateCmd.Flags().StringVar(
+		&runnerJoinCreateArgs.Format,
+		"format",
+		tmplRunnerJoinShow,
+		"Custom output format",
+	)
+}
+
+func runnerJoinCreateAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if runnerJoinCreateArgs.Name == "" {
+		return fmt.Errorf("you must provide a name")
+	}
+
+	body := v1.CreateGlobalJoinJSONRequestBody{
+		Name:      v1.ToPtr(runnerJoinCreateArgs.Name),
+		Uses:      v1.ToPtr(runnerJoinCreateArgs.Uses),
+		ExpiresAt: v1.ToPtr(time.Now().Add(runnerJoinCreateArgs.Expire)),
+	}
+
+	if val := runnerJoinCreateArgs.Project; val != "" {
+		body.ProjectID = v1.ToPtr(val)
+	}
+
+	if val := runnerJoinCreateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(runnerJoinCreateArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	resp, err := client.CreateGlobalJoinWithResponse(
+		ccmd.Context(),
+		body,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusUnprocessableEntity:
+		return validationError(resp.JSON422)
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
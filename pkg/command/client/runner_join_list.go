@@ -0,0 +1,111 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type runnerJoinListBind struct {
+	Format string
+}
+
+// tmplRunnerJoinList represents a row within join token listing.
+var tmplRunnerJoinList = "{{ range . }}Name: \x1b[33m{{ .Name }} \x1b[0m" + `
+ID: {{ .ID }}
+Uses: {{ .Used }}/{{ .Uses }}
+Expires: {{ .ExpiresAt }}
+
+{{ end -}}`
+
+var (
+	runnerJoinListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List all join tokens",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, runnerJoinListAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	runnerJoinListArgs = runnerJoinListBind{}
+)
+
+func init() {
+	runnerJoinCmd.AddCommand(runnerJoinListCmd)
+
+	runnerJoinListCmd.Flags().StringVar(
+		&runnerJoinListArgs.Format,
+		"format",
+		tmplRunnerJoinList,
+		"Custom output format",
+	)
+}
+
+func runnerJoinListAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	resp, err := client.ListGlobalJoinsWithResponse(
+		ccmd.Context(),
+		&v1.ListGlobalJoinsParams{
+			Limit:  v1.ToPtr(10000),
+			Offset: v1.ToPtr(0),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(runnerJoinListArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		records := resp.JSON200.Joins
+
+		if len(records) == 0 {
+			fmt.Fprintln(os.Stderr, "Empty result")
+			return nil
+		}
+
+		if err := tmpl.Execute(
+			os.Stdout,
+			records,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -22,6 +22,9 @@ Name: {{ .Name }}
 {{ with .Project -}}
 Project: {{ .Name }}
 {{ end -}}
+{{ with .LastSeenAt -}}
+Last Seen: {{ . }}
+{{ end -}}
 
 {{ end -}}`
 
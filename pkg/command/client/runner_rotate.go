@@ -0,0 +1,132 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type runnerRotateBind struct {
+	RunnerID string
+	Grace    time.Duration
+	Format   string
+}
+
+var (
+	runnerRotateCmd = &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the token of a runner",
+		Run: func(ccmd *cobra.Command, args []string) {
+			Handle(ccmd, args, runnerRotateAction)
+		},
+		Args: cobra.NoArgs,
+	}
+
+	runnerRotateArgs = runnerRotateBind{}
+)
+
+func init() {
+	runnerCmd.AddCommand(runnerRotateCmd)
+
+	runnerRotateCmd.Flags().StringVarP(
+		&runnerRotateArgs.RunnerID,
+		"runner-id",
+		"i",
+		"",
+		"Runner ID or slug",
+	)
+
+	runnerRotateCmd.Flags().DurationVar(
+		&runnerRotateArgs.Grace,
+		"grace",
+		24*time.Hour,
+		"Duration the previous token stays valid",
+	)
+
+	runnerRotateCmd.Flags().StringVar(
+		&runnerRotateArgs.Format,
+		"format",
+		tmplRunnerShow,
+		"Custom output format",
+	)
+}
+
+func runnerRotateAction(ccmd *cobra.Command, _ []string, client *Client) error {
+	if runnerRotateArgs.RunnerID == "" {
+		return fmt.Errorf("you must provide an ID or a slug")
+	}
+
+	tmpl, err := template.New(
+		"_",
+	).Funcs(
+		globalFuncMap,
+	).Funcs(
+		basicFuncMap,
+	).Parse(
+		fmt.Sprintln(runnerRotateArgs.Format),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to process template: %w", err)
+	}
+
+	resp, err := client.RotateGlobalRunnerWithResponse(
+		ccmd.Context(),
+		runnerRotateArgs.RunnerID,
+		v1.RotateGlobalRunnerJSONRequestBody{
+			Grace: v1.ToPtr(int(runnerRotateArgs.Grace.Seconds())),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if err := tmpl.Execute(
+			os.Stdout,
+			resp.JSON200,
+		); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	case http.StatusUnprocessableEntity:
+		return validationError(resp.JSON422)
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil {
+			return errors.New(v1.FromPtr(resp.JSON400.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusBadRequest))
+	case http.StatusForbidden:
+		if resp.JSON403 != nil {
+			return errors.New(v1.FromPtr(resp.JSON403.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusForbidden))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil {
+			return errors.New(v1.FromPtr(resp.JSON404.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusNotFound))
+	case http.StatusInternalServerError:
+		if resp.JSON500 != nil {
+			return errors.New(v1.FromPtr(resp.JSON500.Message))
+		}
+
+		return errors.New(http.StatusText(http.StatusInternalServerError))
+	case http.StatusUnauthorized:
+		return ErrMissingRequiredCredentials
+	default:
+		return ErrUnknownServerResponse
+	}
+
+	return nil
+}
@@ -19,6 +19,30 @@ Name: {{ .Name }}
 Project: {{ .Name }}
 {{ end -}}
 Token: {{ .Token }}
+{{ with .Labels -}}
+Labels: {{ range $key, $val := . }}{{ $key }}={{ $val }} {{ end }}
+{{ end -}}
+{{ with .Capacity -}}
+Capacity: {{ . }}
+{{ end -}}
+{{ with .Version -}}
+Version: {{ . }}
+{{ end -}}
+{{ with .Hostname -}}
+Hostname: {{ . }}
+{{ end -}}
+{{ with .OS -}}
+Platform: {{ . }}/{{ $.Arch }}
+{{ end -}}
+{{ with .LastSeenAt -}}
+Last Seen: {{ . }}
+{{ end -}}
+{{ with .RotatedAt -}}
+Rotated: {{ . }}
+{{ end -}}
+{{ with .GraceExpiresAt -}}
+Grace Until: {{ . }}
+{{ end -}}
 Created: {{ .CreatedAt }}
 Updated: {{ .UpdatedAt }}`
 
@@ -16,6 +16,7 @@ type runnerUpdateBind struct {
 	Project  string
 	Slug     string
 	Name     string
+	Labels   map[string]string
 	Format   string
 }
 
@@ -63,6 +64,13 @@ func init() {
 		"Name for runner",
 	)
 
+	runnerUpdateCmd.Flags().StringToStringVar(
+		&runnerUpdateArgs.Labels,
+		"label",
+		map[string]string{},
+		"Labels for runner, e.g. dc=fra",
+	)
+
 	runnerUpdateCmd.Flags().StringVar(
 		&runnerUpdateArgs.Format,
 		"format",
@@ -94,6 +102,11 @@ func runnerUpdateAction(ccmd *cobra.Command, _ []string, client *Client) error {
 		changed = true
 	}
 
+	if val := runnerUpdateArgs.Labels; len(val) > 0 {
+		body.Labels = v1.ToPtr(val)
+		changed = true
+	}
+
 	if !changed {
 		fmt.Fprintln(os.Stderr, "Nothing to create...")
 		return nil
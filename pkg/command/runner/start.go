@@ -11,6 +11,7 @@ import (
 	"github.com/gexec/gexec/pkg/config"
 	"github.com/gexec/gexec/pkg/metrics"
 	"github.com/gexec/gexec/pkg/router"
+	"github.com/gexec/gexec/pkg/runner"
 	"github.com/oklog/run"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -24,11 +25,22 @@ var (
 		Args:  cobra.NoArgs,
 	}
 
-	defaultMetricsAddr  = "0.0.0.0:8001"
-	defaultMetricsToken = ""
-	defaultMetricsPprof = false
-	defaultRunnerServer = "http://localhost:8080"
-	defaultRunnerToken  = ""
+	defaultMetricsAddr     = "0.0.0.0:8001"
+	defaultMetricsToken    = ""
+	defaultMetricsPprof    = false
+	defaultRunnerServer    = "http://localhost:8080"
+	defaultRunnerToken     = ""
+	defaultRunnerJoinToken = ""
+	defaultRunnerIdentity  = ""
+	defaultRunnerInterval  = 5 * time.Second
+	defaultRunnerWait      = 30 * time.Second
+	defaultRunnerHeartbeat = 30 * time.Second
+	defaultRunnerInterrupt = 10 * time.Second
+	defaultRunnerTerminate = 10 * time.Second
+	defaultRunnerWorkspace = ""
+	defaultRunnerKeep      = false
+	defaultRunnerRuntime   = "docker"
+	defaultRunnerCapacity  = 1
 )
 
 func init() {
@@ -53,6 +65,50 @@ func init() {
 	serverCmd.PersistentFlags().String("runner-token", defaultRunnerToken, "Access token for runner on server")
 	viper.SetDefault("runner.token", defaultRunnerToken)
 	_ = viper.BindPFlag("runner.token", serverCmd.PersistentFlags().Lookup("runner-token"))
+
+	serverCmd.PersistentFlags().String("join-token", defaultRunnerJoinToken, "Join token to register a new runner on server")
+	viper.SetDefault("runner.join_token", defaultRunnerJoinToken)
+	_ = viper.BindPFlag("runner.join_token", serverCmd.PersistentFlags().Lookup("join-token"))
+
+	serverCmd.PersistentFlags().String("runner-identity", defaultRunnerIdentity, "Path to persist the token of a joined or rotated runner")
+	viper.SetDefault("runner.identity", defaultRunnerIdentity)
+	_ = viper.BindPFlag("runner.identity", serverCmd.PersistentFlags().Lookup("runner-identity"))
+
+	serverCmd.PersistentFlags().Duration("runner-interval", defaultRunnerInterval, "Interval to poll for new executions")
+	viper.SetDefault("runner.interval", defaultRunnerInterval)
+	_ = viper.BindPFlag("runner.interval", serverCmd.PersistentFlags().Lookup("runner-interval"))
+
+	serverCmd.PersistentFlags().Duration("runner-wait", defaultRunnerWait, "Duration to wait for new executions per request")
+	viper.SetDefault("runner.wait", defaultRunnerWait)
+	_ = viper.BindPFlag("runner.wait", serverCmd.PersistentFlags().Lookup("runner-wait"))
+
+	serverCmd.PersistentFlags().Duration("runner-heartbeat", defaultRunnerHeartbeat, "Interval to send heartbeats to the server")
+	viper.SetDefault("runner.heartbeat", defaultRunnerHeartbeat)
+	_ = viper.BindPFlag("runner.heartbeat", serverCmd.PersistentFlags().Lookup("runner-heartbeat"))
+
+	serverCmd.PersistentFlags().Duration("runner-interrupt", defaultRunnerInterrupt, "Timeout after SIGINT before sending SIGTERM to stopped executions")
+	viper.SetDefault("runner.interrupt", defaultRunnerInterrupt)
+	_ = viper.BindPFlag("runner.interrupt", serverCmd.PersistentFlags().Lookup("runner-interrupt"))
+
+	serverCmd.PersistentFlags().Duration("runner-terminate", defaultRunnerTerminate, "Timeout after SIGTERM before sending SIGKILL to stopped executions")
+	viper.SetDefault("runner.terminate", defaultRunnerTerminate)
+	_ = viper.BindPFlag("runner.terminate", serverCmd.PersistentFlags().Lookup("runner-terminate"))
+
+	serverCmd.PersistentFlags().String("runner-workspace", defaultRunnerWorkspace, "Directory to create the execution workspaces within")
+	viper.SetDefault("runner.workspace", defaultRunnerWorkspace)
+	_ = viper.BindPFlag("runner.workspace", serverCmd.PersistentFlags().Lookup("runner-workspace"))
+
+	serverCmd.PersistentFlags().Bool("runner-keep-failed", defaultRunnerKeep, "Keep workspaces of failed executions for debugging")
+	viper.SetDefault("runner.keep_failed", defaultRunnerKeep)
+	_ = viper.BindPFlag("runner.keep_failed", serverCmd.PersistentFlags().Lookup("runner-keep-failed"))
+
+	serverCmd.PersistentFlags().String("runner-runtime", defaultRunnerRuntime, "Container runtime for templates defining an image")
+	viper.SetDefault("runner.runtime", defaultRunnerRuntime)
+	_ = viper.BindPFlag("runner.runtime", serverCmd.PersistentFlags().Lookup("runner-runtime"))
+
+	serverCmd.PersistentFlags().Int("runner-capacity", defaultRunnerCapacity, "Maximum number of concurrent executions")
+	viper.SetDefault("runner.capacity", defaultRunnerCapacity)
+	_ = viper.BindPFlag("runner.capacity", serverCmd.PersistentFlags().Lookup("runner-capacity"))
 }
 
 func serverAction(_ *cobra.Command, _ []string) {
@@ -67,6 +123,53 @@ func serverAction(_ *cobra.Command, _ []string) {
 		os.Exit(1)
 	}
 
+	runnerToken, err := config.Value(cfg.Runner.Token)
+
+	if err != nil {
+		slog.Error(
+			"Failed to parse runner token secret",
+			slog.Any("error", err),
+		)
+
+		os.Exit(1)
+	}
+
+	joinToken, err := config.Value(cfg.Runner.JoinToken)
+
+	if err != nil {
+		slog.Error(
+			"Failed to parse runner join token secret",
+			slog.Any("error", err),
+		)
+
+		os.Exit(1)
+	}
+
+	handler, err := runner.New(
+		runner.WithServer(cfg.Runner.Server),
+		runner.WithToken(runnerToken),
+		runner.WithJoinToken(joinToken),
+		runner.WithIdentity(cfg.Runner.Identity),
+		runner.WithInterval(cfg.Runner.Interval),
+		runner.WithWait(cfg.Runner.Wait),
+		runner.WithHeartbeat(cfg.Runner.Heartbeat),
+		runner.WithInterrupt(cfg.Runner.Interrupt),
+		runner.WithTerminate(cfg.Runner.Terminate),
+		runner.WithWorkspace(cfg.Runner.Workspace),
+		runner.WithKeepFailed(cfg.Runner.KeepFailed),
+		runner.WithRuntime(cfg.Runner.Runtime),
+		runner.WithCapacity(cfg.Runner.Capacity),
+	)
+
+	if err != nil {
+		slog.Error(
+			"Failed to initialize runner",
+			slog.Any("error", err),
+		)
+
+		os.Exit(1)
+	}
+
 	registry := metrics.New(
 		metrics.WithNamespace("gexec_runner"),
 		metrics.WithToken(token),
@@ -112,6 +215,27 @@ func serverAction(_ *cobra.Command, _ []string) {
 		})
 	}
 
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+
+		gr.Add(func() error {
+			slog.Info(
+				"Starting runner",
+				slog.String("server", cfg.Runner.Server),
+				slog.Duration("interval", cfg.Runner.Interval),
+			)
+
+			return handler.Run(ctx)
+		}, func(reason error) {
+			cancel()
+
+			slog.Info(
+				"Runner shutdown gracefully",
+				slog.Any("reason", reason),
+			)
+		})
+	}
+
 	{
 		stop := make(chan os.Signal, 1)
 
@@ -2,16 +2,19 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v7"
 	"github.com/gexec/gexec/pkg/authn"
 	"github.com/gexec/gexec/pkg/config"
 	"github.com/gexec/gexec/pkg/metrics"
+	"github.com/gexec/gexec/pkg/model"
 	"github.com/gexec/gexec/pkg/router"
 	"github.com/gexec/gexec/pkg/secret"
 	"github.com/gexec/gexec/pkg/store"
@@ -62,6 +65,16 @@ var (
 	defaultScimToken         = ""
 	defaultCleanupEnabled    = true
 	defaultCleanupInterval   = 30 * time.Minute
+	defaultLivenessEnabled   = true
+	defaultLivenessInterval  = time.Minute
+	defaultLivenessTimeout   = 5 * time.Minute
+	defaultLivenessRequeue   = false
+	defaultSchedulerEnabled  = true
+	defaultSchedulerInterval = 30 * time.Second
+	defaultLeaderEnabled     = true
+	defaultLeaderIdentity    = ""
+	defaultLeaderLease       = 30 * time.Second
+	defaultLeaderRenew       = 10 * time.Second
 	defaultAdminCreate       = true
 	defaultAdminUsername     = "admin"
 	defaultAdminPassword     = "admin"
@@ -209,6 +222,46 @@ func init() {
 	viper.SetDefault("cleanup.interval", defaultCleanupInterval)
 	_ = viper.BindPFlag("cleanup.interval", serverCmd.PersistentFlags().Lookup("cleanup-interval"))
 
+	serverCmd.PersistentFlags().Bool("liveness-enabled", defaultLivenessEnabled, "Enable recovery of executions from dead runners")
+	viper.SetDefault("liveness.enabled", defaultLivenessEnabled)
+	_ = viper.BindPFlag("liveness.enabled", serverCmd.PersistentFlags().Lookup("liveness-enabled"))
+
+	serverCmd.PersistentFlags().Duration("liveness-interval", defaultLivenessInterval, "Interval to check for dead runners")
+	viper.SetDefault("liveness.interval", defaultLivenessInterval)
+	_ = viper.BindPFlag("liveness.interval", serverCmd.PersistentFlags().Lookup("liveness-interval"))
+
+	serverCmd.PersistentFlags().Duration("liveness-timeout", defaultLivenessTimeout, "Duration without heartbeat until a runner is dead")
+	viper.SetDefault("liveness.timeout", defaultLivenessTimeout)
+	_ = viper.BindPFlag("liveness.timeout", serverCmd.PersistentFlags().Lookup("liveness-timeout"))
+
+	serverCmd.PersistentFlags().Bool("liveness-requeue", defaultLivenessRequeue, "Queue executions of dead runners again instead of failing them")
+	viper.SetDefault("liveness.requeue", defaultLivenessRequeue)
+	_ = viper.BindPFlag("liveness.requeue", serverCmd.PersistentFlags().Lookup("liveness-requeue"))
+
+	serverCmd.PersistentFlags().Bool("scheduler-enabled", defaultSchedulerEnabled, "Enable evaluation of schedules")
+	viper.SetDefault("scheduler.enabled", defaultSchedulerEnabled)
+	_ = viper.BindPFlag("scheduler.enabled", serverCmd.PersistentFlags().Lookup("scheduler-enabled"))
+
+	serverCmd.PersistentFlags().Duration("scheduler-interval", defaultSchedulerInterval, "Interval to evaluate schedules")
+	viper.SetDefault("scheduler.interval", defaultSchedulerInterval)
+	_ = viper.BindPFlag("scheduler.interval", serverCmd.PersistentFlags().Lookup("scheduler-interval"))
+
+	serverCmd.PersistentFlags().Bool("leader-enabled", defaultLeaderEnabled, "Enable leader election between multiple servers")
+	viper.SetDefault("leader.enabled", defaultLeaderEnabled)
+	_ = viper.BindPFlag("leader.enabled", serverCmd.PersistentFlags().Lookup("leader-enabled"))
+
+	serverCmd.PersistentFlags().String("leader-identity", defaultLeaderIdentity, "Identity of this server for leader election")
+	viper.SetDefault("leader.identity", defaultLeaderIdentity)
+	_ = viper.BindPFlag("leader.identity", serverCmd.PersistentFlags().Lookup("leader-identity"))
+
+	serverCmd.PersistentFlags().Duration("leader-lease", defaultLeaderLease, "Duration until the lease of a dead leader expires")
+	viper.SetDefault("leader.lease", defaultLeaderLease)
+	_ = viper.BindPFlag("leader.lease", serverCmd.PersistentFlags().Lookup("leader-lease"))
+
+	serverCmd.PersistentFlags().Duration("leader-renew", defaultLeaderRenew, "Interval to acquire or renew the leader lease")
+	viper.SetDefault("leader.renew", defaultLeaderRenew)
+	_ = viper.BindPFlag("leader.renew", serverCmd.PersistentFlags().Lookup("leader-renew"))
+
 	serverCmd.PersistentFlags().Bool("admin-create", defaultAdminCreate, "Create an initial admin user")
 	viper.SetDefault("admin.create", defaultAdminCreate)
 	_ = viper.BindPFlag("admin.create", serverCmd.PersistentFlags().Lookup("admin-create"))
@@ -494,6 +547,94 @@ func serverAction(ccmd *cobra.Command, _ []string) {
 		})
 	}
 
+	// Without leader election every server acts as the leader, this is only
+	// safe if there is a single server.
+	leader := &atomic.Bool{}
+	leader.Store(!cfg.Leader.Enabled)
+
+	if cfg.Leader.Enabled {
+		holder := cfg.Leader.Identity
+
+		if holder == "" {
+			hostname, _ := os.Hostname()
+			holder = fmt.Sprintf("%s-%s", hostname, secret.Generate(8))
+		}
+
+		ticker := time.NewTicker(cfg.Leader.Renew)
+		stop := make(chan struct{})
+
+		elect := func() {
+			acquired, err := storage.Leases.Acquire(
+				context.Background(),
+				model.LeaseLeader,
+				holder,
+				cfg.Leader.Lease,
+			)
+
+			if err != nil {
+				slog.Error(
+					"Failed to acquire leader lease",
+					slog.Any("error", err),
+				)
+			}
+
+			if leader.Swap(acquired) != acquired {
+				if acquired {
+					slog.Info(
+						"Acquired leadership",
+						slog.String("identity", holder),
+					)
+				} else {
+					slog.Warn(
+						"Lost leadership",
+						slog.String("identity", holder),
+					)
+				}
+			}
+		}
+
+		gr.Add(func() error {
+			defer ticker.Stop()
+
+			slog.Info(
+				"Starting leader election",
+				slog.String("identity", holder),
+				slog.Duration("lease", cfg.Leader.Lease),
+				slog.Duration("renew", cfg.Leader.Renew),
+			)
+
+			elect()
+
+			for {
+				select {
+				case <-ticker.C:
+					elect()
+				case <-stop:
+					leader.Store(false)
+
+					if err := storage.Leases.Release(
+						context.Background(),
+						model.LeaseLeader,
+						holder,
+					); err != nil {
+						slog.Error(
+							"Failed to release leader lease",
+							slog.Any("error", err),
+						)
+					}
+
+					slog.Info(
+						"Shutdown leader election",
+					)
+
+					return nil
+				}
+			}
+		}, func(_ error) {
+			close(stop)
+		})
+	}
+
 	if cfg.Cleanup.Enabled {
 		ticker := time.NewTicker(cfg.Cleanup.Interval)
 		stop := make(chan struct{})
@@ -509,6 +650,10 @@ func serverAction(ccmd *cobra.Command, _ []string) {
 			for {
 				select {
 				case <-ticker.C:
+					if !leader.Load() {
+						continue
+					}
+
 					slog.Debug(
 						"Running periodic cleanup",
 					)
@@ -534,6 +679,169 @@ func serverAction(ccmd *cobra.Command, _ []string) {
 		})
 	}
 
+	if cfg.Liveness.Enabled {
+		ticker := time.NewTicker(cfg.Liveness.Interval)
+		stop := make(chan struct{})
+
+		gr.Add(func() error {
+			defer ticker.Stop()
+
+			slog.Info(
+				"Starting runner liveness check",
+				slog.Duration("interval", cfg.Liveness.Interval),
+				slog.Duration("timeout", cfg.Liveness.Timeout),
+			)
+
+			for {
+				select {
+				case <-ticker.C:
+					if !leader.Load() {
+						continue
+					}
+
+					slog.Debug(
+						"Running runner liveness check",
+					)
+
+					recovered, err := storage.Executions.Recover(
+						context.Background(),
+						cfg.Liveness.Timeout,
+						cfg.Liveness.Requeue,
+					)
+
+					if err != nil {
+						slog.Error(
+							"Failed to recover executions",
+							slog.Any("error", err),
+						)
+					}
+
+					if recovered > 0 {
+						slog.Warn(
+							"Recovered executions from dead runners",
+							slog.Int("count", recovered),
+						)
+					}
+				case <-stop:
+					slog.Info(
+						"Shutdown runner liveness check",
+					)
+
+					return nil
+				}
+			}
+		}, func(_ error) {
+			close(stop)
+		})
+	}
+
+	if cfg.Scheduler.Enabled {
+		ticker := time.NewTicker(cfg.Scheduler.Interval)
+		stop := make(chan struct{})
+
+		gr.Add(func() error {
+			defer ticker.Stop()
+
+			slog.Info(
+				"Starting schedule evaluation",
+				slog.Duration("interval", cfg.Scheduler.Interval),
+			)
+
+			for {
+				select {
+				case <-ticker.C:
+					if !leader.Load() {
+						continue
+					}
+
+					slog.Debug(
+						"Running schedule evaluation",
+					)
+
+					schedules, err := storage.Schedules.Active(
+						context.Background(),
+					)
+
+					if err != nil {
+						slog.Error(
+							"Failed to list active schedules",
+							slog.Any("error", err),
+						)
+
+						continue
+					}
+
+					now := time.Now()
+
+				schedules:
+					for _, schedule := range schedules {
+						// Ticks within two intervals are considered on time,
+						// everything older has been missed during a downtime.
+						grace := 2 * cfg.Scheduler.Interval
+
+						for _, tick := range schedule.Pending(now, grace) {
+							execution, err := storage.Schedules.Trigger(
+								context.Background(),
+								schedule,
+								tick,
+							)
+
+							if err != nil {
+								slog.Error(
+									"Failed to trigger schedule",
+									slog.Any("error", err),
+									slog.String("project", schedule.ProjectID),
+									slog.String("schedule", schedule.ID),
+								)
+
+								continue schedules
+							}
+
+							if execution != nil {
+								slog.Info(
+									"Triggered schedule",
+									slog.String("project", schedule.ProjectID),
+									slog.String("schedule", schedule.ID),
+									slog.String("execution", execution.ID),
+								)
+							}
+						}
+
+						if schedule.Expired(now, grace) {
+							if err := storage.Schedules.Deactivate(
+								context.Background(),
+								schedule,
+							); err != nil {
+								slog.Error(
+									"Failed to deactivate schedule",
+									slog.Any("error", err),
+									slog.String("project", schedule.ProjectID),
+									slog.String("schedule", schedule.ID),
+								)
+
+								continue
+							}
+
+							slog.Info(
+								"Deactivated expired schedule",
+								slog.String("project", schedule.ProjectID),
+								slog.String("schedule", schedule.ID),
+							)
+						}
+					}
+				case <-stop:
+					slog.Info(
+						"Shutdown schedule evaluation",
+					)
+
+					return nil
+				}
+			}
+		}, func(_ error) {
+			close(stop)
+		})
+	}
+
 	{
 		stop := make(chan os.Signal, 1)
 
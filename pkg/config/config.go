@@ -39,6 +39,28 @@ type Cleanup struct {
 	Interval time.Duration `mapstructure:"interval"`
 }
 
+// Liveness defines the runner liveness configuration.
+type Liveness struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Requeue  bool          `mapstructure:"requeue"`
+}
+
+// Scheduler defines the schedule evaluation configuration.
+type Scheduler struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// Leader defines the leader election configuration.
+type Leader struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Identity string        `mapstructure:"identity"`
+	Lease    time.Duration `mapstructure:"lease"`
+	Renew    time.Duration `mapstructure:"renew"`
+}
+
 // Auth defines the authentication configuration.
 type Auth struct {
 	Config string `mapstructure:"config"`
@@ -91,8 +113,19 @@ type Scim struct {
 
 // Runner defines the runner configuration.
 type Runner struct {
-	Server string `mapstructure:"server"`
-	Token  string `mapstructure:"token"`
+	Server     string        `mapstructure:"server"`
+	Token      string        `mapstructure:"token"`
+	JoinToken  string        `mapstructure:"join_token"`
+	Identity   string        `mapstructure:"identity"`
+	Interval   time.Duration `mapstructure:"interval"`
+	Wait       time.Duration `mapstructure:"wait"`
+	Heartbeat  time.Duration `mapstructure:"heartbeat"`
+	Interrupt  time.Duration `mapstructure:"interrupt"`
+	Terminate  time.Duration `mapstructure:"terminate"`
+	Workspace  string        `mapstructure:"workspace"`
+	KeepFailed bool          `mapstructure:"keep_failed"`
+	Runtime    string        `mapstructure:"runtime"`
+	Capacity   int           `mapstructure:"capacity"`
 }
 
 // Encrypt defines the encrypt configuration.
@@ -102,18 +135,21 @@ type Encrypt struct {
 
 // Config is a combination of all available configurations.
 type Config struct {
-	Server   Server   `mapstructure:"server"`
-	Metrics  Metrics  `mapstructure:"metrics"`
-	Logs     Logs     `mapstructure:"log"`
-	Cleanup  Cleanup  `mapstructure:"cleanup"`
-	Auth     Auth     `mapstructure:"auth"`
-	Database Database `mapstructure:"database"`
-	Upload   Upload   `mapstructure:"upload"`
-	Token    Token    `mapstructure:"token"`
-	Scim     Scim     `mapstructure:"scim"`
-	Admin    Admin    `mapstructure:"admin"`
-	Runner   Runner   `mapstructure:"runner"`
-	Encrypt  Encrypt  `mapstructure:"encrypt"`
+	Server    Server    `mapstructure:"server"`
+	Metrics   Metrics   `mapstructure:"metrics"`
+	Logs      Logs      `mapstructure:"log"`
+	Cleanup   Cleanup   `mapstructure:"cleanup"`
+	Liveness  Liveness  `mapstructure:"liveness"`
+	Scheduler Scheduler `mapstructure:"scheduler"`
+	Leader    Leader    `mapstructure:"leader"`
+	Auth      Auth      `mapstructure:"auth"`
+	Database  Database  `mapstructure:"database"`
+	Upload    Upload    `mapstructure:"upload"`
+	Token     Token     `mapstructure:"token"`
+	Scim      Scim      `mapstructure:"scim"`
+	Admin     Admin     `mapstructure:"admin"`
+	Runner    Runner    `mapstructure:"runner"`
+	Encrypt   Encrypt   `mapstructure:"encrypt"`
 }
 
 // Load initializes a default configuration struct.
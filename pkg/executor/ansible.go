@@ -0,0 +1,284 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+// Ansible implements the executor for Ansible playbooks.
+type Ansible struct {
+	options Options
+}
+
+// Execute implements the Executor interface.
+func (e *Ansible) Execute(ctx context.Context, execution *v1.Execution, ws *workspace.Workspace, output io.Writer) (*Result, error) {
+	template := execution.Template
+	result := &Result{}
+
+	commit, err := checkout(
+		ctx,
+		e.options,
+		ws,
+		template.Repository,
+		value(execution.Branch, template.Branch),
+		"repository",
+		output,
+	)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.CommitSHA = commit
+
+	env := make([]string, 0)
+	extra := make(map[string]interface{})
+	args := make([]string, 0)
+
+	if inventory := template.Inventory; inventory != nil {
+		path, err := e.inventory(ctx, ws, template, inventory, output)
+
+		if err != nil {
+			return result, err
+		}
+
+		args = append(args, "--inventory", path)
+
+		creds, err := e.credentials(ws, inventory, extra)
+
+		if err != nil {
+			return result, err
+		}
+
+		args = append(args, creds...)
+	}
+
+	if template.Vaults != nil {
+		vaults, err := e.vaults(ws, *template.Vaults)
+
+		if err != nil {
+			return result, err
+		}
+
+		args = append(args, vaults...)
+	}
+
+	env = variables(template.Environment, env, extra)
+	answers(execution, extra)
+	env, err = overrides(execution, env)
+
+	if err != nil {
+		return result, err
+	}
+
+	if len(extra) > 0 {
+		content, err := json.Marshal(extra)
+
+		if err != nil {
+			return result, fmt.Errorf("failed to encode extra vars: %w", err)
+		}
+
+		path, err := ws.Secret("extra-vars.json", string(content))
+
+		if err != nil {
+			return result, err
+		}
+
+		args = append(args, "--extra-vars", "@"+path)
+	}
+
+	if limit := value(execution.Limit, template.Limit); limit != "" {
+		args = append(args, "--limit", limit)
+	}
+
+	if deref(execution.Debug) {
+		args = append(args, "-vvvv")
+	}
+
+	arguments, err := split(value(execution.Arguments, template.Arguments))
+
+	if err != nil {
+		return result, err
+	}
+
+	args = append(args, arguments...)
+	args = append(args, value(execution.Path, template.Path))
+
+	fmt.Fprintf(output, "$ ansible-playbook %s\n", strings.Join(args, " "))
+
+	return result, e.options.Backend.Run(
+		ctx,
+		ws,
+		Command{
+			Dir:  ws.Path("repository"),
+			Env:  env,
+			Name: "ansible-playbook",
+			Args: args,
+		},
+		output,
+	)
+}
+
+func (e *Ansible) inventory(ctx context.Context, ws *workspace.Workspace, template *v1.Template, inventory *v1.Inventory, output io.Writer) (string, error) {
+	switch deref(inventory.Kind) {
+	case v1.Static:
+		return ws.Secret(
+			"inventory",
+			deref(inventory.Content),
+		)
+	case v1.File:
+		if inventory.Repository == nil || deref(inventory.RepositoryID) == deref(template.RepositoryID) {
+			return ws.Path(
+				"repository",
+				deref(inventory.Content),
+			), nil
+		}
+
+		if _, err := checkout(
+			ctx,
+			e.options,
+			ws,
+			inventory.Repository,
+			"",
+			"inventory",
+			output,
+		); err != nil {
+			return "", err
+		}
+
+		return ws.Path(
+			"inventory",
+			deref(inventory.Content),
+		), nil
+	default:
+		return "", ErrUnsupportedInventory
+	}
+}
+
+func (e *Ansible) credentials(ws *workspace.Workspace, inventory *v1.Inventory, extra map[string]interface{}) ([]string, error) {
+	args := make([]string, 0)
+
+	if credential := inventory.Credential; credential != nil {
+		switch deref(credential.Kind) {
+		case v1.Shell:
+			if credential.Shell == nil {
+				break
+			}
+
+			if username := deref(credential.Shell.Username); username != "" {
+				args = append(args, "--user", username)
+			}
+
+			if password := deref(credential.Shell.Password); password != "" {
+				extra["ansible_password"] = password
+			}
+
+			if key := deref(credential.Shell.PrivateKey); key != "" {
+				path, err := ws.Secret("inventory.key", key)
+
+				if err != nil {
+					return nil, err
+				}
+
+				args = append(args, "--private-key", path)
+			}
+		case v1.Login:
+			if credential.Login == nil {
+				break
+			}
+
+			if username := deref(credential.Login.Username); username != "" {
+				args = append(args, "--user", username)
+			}
+
+			if password := deref(credential.Login.Password); password != "" {
+				extra["ansible_password"] = password
+			}
+		}
+	}
+
+	if become := inventory.Become; become != nil {
+		var (
+			username string
+			password string
+		)
+
+		switch deref(become.Kind) {
+		case v1.Shell:
+			if become.Shell != nil {
+				username = deref(become.Shell.Username)
+				password = deref(become.Shell.Password)
+			}
+		case v1.Login:
+			if become.Login != nil {
+				username = deref(become.Login.Username)
+				password = deref(become.Login.Password)
+			}
+		}
+
+		if username != "" {
+			extra["ansible_become_user"] = username
+		}
+
+		if password != "" {
+			extra["ansible_become_password"] = password
+		}
+	}
+
+	return args, nil
+}
+
+func (e *Ansible) vaults(ws *workspace.Workspace, vaults []v1.TemplateVault) ([]string, error) {
+	args := make([]string, 0)
+
+	for i, vault := range vaults {
+		name := value(vault.Name)
+
+		if name == "" {
+			name = "default"
+		}
+
+		switch deref(vault.Kind) {
+		case v1.Password:
+			var password string
+
+			if credential := vault.Credential; credential != nil {
+				switch deref(credential.Kind) {
+				case v1.Login:
+					if credential.Login != nil {
+						password = deref(credential.Login.Password)
+					}
+				case v1.Shell:
+					if credential.Shell != nil {
+						password = deref(credential.Shell.Password)
+					}
+				}
+			}
+
+			path, err := ws.Secret(
+				fmt.Sprintf("vault-%d", i),
+				password,
+			)
+
+			if err != nil {
+				return nil, err
+			}
+
+			args = append(args, "--vault-id", fmt.Sprintf("%s@%s", name, path))
+		case v1.Script:
+			args = append(args, "--vault-id", fmt.Sprintf(
+				"%s@%s",
+				name,
+				ws.Path("repository", deref(vault.Script)),
+			))
+		}
+	}
+
+	return args, nil
+}
@@ -0,0 +1,168 @@
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+// Asdf implements the executor for generic scripts, the required tools are
+// installed based on the .tool-versions file of the repository.
+type Asdf struct {
+	options Options
+}
+
+// Execute implements the Executor interface.
+func (e *Asdf) Execute(ctx context.Context, execution *v1.Execution, ws *workspace.Workspace, output io.Writer) (*Result, error) {
+	template := execution.Template
+	result := &Result{}
+
+	commit, err := checkout(
+		ctx,
+		e.options,
+		ws,
+		template.Repository,
+		value(execution.Branch, template.Branch),
+		"repository",
+		output,
+	)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.CommitSHA = commit
+
+	dir := ws.Path("repository")
+	extra := make(map[string]interface{})
+	env := variables(template.Environment, make([]string, 0), extra)
+	answers(execution, extra)
+
+	for name, val := range extra {
+		env = append(env, fmt.Sprintf("%s=%s", name, val))
+	}
+
+	env, err = overrides(execution, env)
+
+	if err != nil {
+		return result, err
+	}
+
+	tools, err := e.tools(ws.Path("repository", ".tool-versions"))
+
+	if err != nil {
+		return result, err
+	}
+
+	if len(tools) > 0 {
+		if err := e.install(ctx, ws, dir, env, tools, output); err != nil {
+			return result, err
+		}
+	}
+
+	arguments, err := split(value(execution.Arguments, template.Arguments))
+
+	if err != nil {
+		return result, err
+	}
+
+	script := value(execution.Path, template.Path)
+	fmt.Fprintf(output, "$ %s %s\n", script, strings.Join(arguments, " "))
+
+	return result, e.options.Backend.Run(
+		ctx,
+		ws,
+		Command{
+			Dir:  dir,
+			Env:  env,
+			Name: ws.Path("repository", script),
+			Args: arguments,
+		},
+		output,
+	)
+}
+
+func (e *Asdf) tools(path string) ([]string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read tool versions: %w", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	result := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+
+		if len(fields) < 2 {
+			continue
+		}
+
+		result = append(result, fields[0])
+	}
+
+	return result, scanner.Err()
+}
+
+func (e *Asdf) install(ctx context.Context, ws *workspace.Workspace, dir string, env []string, tools []string, output io.Writer) error {
+	plugins := &bytes.Buffer{}
+
+	// Listing fails if there are no plugins yet, treat it as an empty list.
+	_ = e.asdf(ctx, ws, dir, env, plugins, "plugin", "list")
+
+	installed := make(map[string]bool)
+
+	for _, plugin := range strings.Fields(plugins.String()) {
+		installed[plugin] = true
+	}
+
+	for _, tool := range tools {
+		if installed[tool] {
+			continue
+		}
+
+		fmt.Fprintf(output, "$ asdf plugin add %s\n", tool)
+
+		if err := e.asdf(ctx, ws, dir, env, output, "plugin", "add", tool); err != nil {
+			return fmt.Errorf("failed to add plugin %s: %w", tool, err)
+		}
+	}
+
+	fmt.Fprintln(output, "$ asdf install")
+
+	if err := e.asdf(ctx, ws, dir, env, output, "install"); err != nil {
+		return fmt.Errorf("failed to install tools: %w", err)
+	}
+
+	return nil
+}
+
+func (e *Asdf) asdf(ctx context.Context, ws *workspace.Workspace, dir string, env []string, output io.Writer, args ...string) error {
+	return e.options.Backend.Run(
+		ctx,
+		ws,
+		Command{
+			Dir:  dir,
+			Env:  env,
+			Name: "asdf",
+			Args: args,
+		},
+		output,
+	)
+}
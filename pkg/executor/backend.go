@@ -0,0 +1,132 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+// Command defines a single process launched by a backend.
+type Command struct {
+	Dir  string
+	Env  []string
+	Name string
+	Args []string
+}
+
+// Backend provides the interface to launch the processes of an execution.
+type Backend interface {
+	Run(context.Context, *workspace.Workspace, Command, io.Writer) error
+}
+
+// Host implements a backend which launches the processes directly on the
+// runner host, the environment of the runner gets inherited.
+type Host struct {
+	options Options
+}
+
+// Run implements the Backend interface.
+func (b *Host) Run(ctx context.Context, _ *workspace.Workspace, cmd Command, output io.Writer) error {
+	return run(
+		ctx,
+		b.options,
+		cmd.Dir,
+		append(os.Environ(), cmd.Env...),
+		output,
+		cmd.Name,
+		cmd.Args...,
+	)
+}
+
+// Container implements a backend which launches every process within a
+// throwaway OCI container through a local container runtime CLI. The
+// workspace gets mounted at the same path, so paths stay valid.
+type Container struct {
+	runtime string
+	image   string
+	backend Backend
+}
+
+// Run implements the Backend interface.
+func (b *Container) Run(ctx context.Context, ws *workspace.Workspace, cmd Command, output io.Writer) error {
+	name := "gexec-" + strings.ToLower(uniuri.NewLen(16))
+
+	err := b.backend.Run(
+		ctx,
+		ws,
+		Command{
+			Env:  cmd.Env,
+			Name: b.runtime,
+			Args: b.args(name, ws.Path(), cmd),
+		},
+		output,
+	)
+
+	if ctx.Err() != nil {
+		// Killing the runtime CLI does not stop the container itself.
+		cleanup, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		_ = b.backend.Run(
+			cleanup,
+			ws,
+			Command{
+				Name: b.runtime,
+				Args: []string{"rm", "--force", name},
+			},
+			io.Discard,
+		)
+	}
+
+	return err
+}
+
+func (b *Container) args(name, root string, cmd Command) []string {
+	args := []string{
+		"run",
+		"--rm",
+		"--init",
+		"--name", name,
+		"--user", fmt.Sprintf("%d:%d", os.Getuid(), os.Getgid()),
+		"--volume", root + ":" + root,
+		"--workdir", cmd.Dir,
+		"--env", "HOME=" + root,
+	}
+
+	for _, row := range cmd.Env {
+		// Only pass the names, values are inherited from the runtime CLI and
+		// this way secrets are not visible within the process list.
+		key, _, _ := strings.Cut(row, "=")
+		args = append(args, "--env", key)
+	}
+
+	args = append(args, b.image, cmd.Name)
+	return append(args, cmd.Args...)
+}
+
+// Fake implements a backend which only records the launched commands, it is
+// intended to be used within tests.
+type Fake struct {
+	Commands []Command
+	Output   string
+	Err      error
+	mutex    sync.Mutex
+}
+
+// Run implements the Backend interface.
+func (b *Fake) Run(_ context.Context, _ *workspace.Workspace, cmd Command, output io.Writer) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.Commands = append(b.Commands, cmd)
+	fmt.Fprint(output, b.Output)
+
+	return b.Err
+}
@@ -0,0 +1,50 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gexec/gexec/pkg/workspace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer(t *testing.T) {
+	manager, err := workspace.New(workspace.WithRoot(t.TempDir()))
+	assert.NoError(t, err)
+
+	ws, err := manager.Create("test")
+	assert.NoError(t, err)
+
+	defer func() { _ = ws.Close(false) }()
+
+	fake := &Fake{}
+	backend := &Container{
+		runtime: "podman",
+		image:   "alpine:latest",
+		backend: fake,
+	}
+
+	err = backend.Run(
+		context.Background(),
+		ws,
+		Command{
+			Dir:  ws.Path("repository"),
+			Env:  []string{"TOKEN=secret"},
+			Name: "ansible-playbook",
+			Args: []string{"site.yml"},
+		},
+		&bytes.Buffer{},
+	)
+
+	assert.NoError(t, err)
+	assert.Len(t, fake.Commands, 1)
+
+	cmd := fake.Commands[0]
+	assert.Equal(t, "podman", cmd.Name)
+	assert.Equal(t, []string{"TOKEN=secret"}, cmd.Env)
+	assert.Contains(t, cmd.Args, ws.Path()+":"+ws.Path())
+	assert.Contains(t, cmd.Args, "TOKEN")
+	assert.NotContains(t, cmd.Args, "TOKEN=secret")
+	assert.Equal(t, []string{"alpine:latest", "ansible-playbook", "site.yml"}, cmd.Args[len(cmd.Args)-3:])
+}
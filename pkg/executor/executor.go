@@ -0,0 +1,90 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+var (
+	// ErrUnknownExecutor defines a named error for unknown executors.
+	ErrUnknownExecutor = fmt.Errorf("unknown executor")
+
+	// ErrMissingRepository defines a named error for missing repositories.
+	ErrMissingRepository = fmt.Errorf("missing repository")
+
+	// ErrUnsupportedInventory defines a named error for unsupported inventories.
+	ErrUnsupportedInventory = fmt.Errorf("unsupported inventory kind")
+
+	// ErrInvalidArguments defines a named error for unparsable arguments.
+	ErrInvalidArguments = fmt.Errorf("invalid arguments")
+
+	// ErrInvalidOverrides defines a named error for unparsable overrides.
+	ErrInvalidOverrides = fmt.Errorf("invalid overrides")
+
+	// ErrMissingConfirm defines a named error if confirmations are not supported.
+	ErrMissingConfirm = fmt.Errorf("missing confirm handler")
+
+	// ErrRejected defines a named error if an execution got rejected.
+	ErrRejected = fmt.Errorf("execution rejected")
+)
+
+// Result defines the details reported back after an execution.
+type Result struct {
+	ExitCode  int
+	CommitSHA string
+}
+
+// Executor provides the interface for the executor implementations.
+type Executor interface {
+	Execute(context.Context, *v1.Execution, *workspace.Workspace, io.Writer) (*Result, error)
+}
+
+// New initializes the executor defined by the template of an execution.
+func New(execution *v1.Execution, opts ...Option) (Executor, error) {
+	options := newOptions(opts...)
+
+	if execution.Template == nil {
+		return nil, ErrUnknownExecutor
+	}
+
+	if options.Backend == nil {
+		options.Backend = &Host{
+			options: options,
+		}
+
+		if image := deref(execution.Template.Image); image != "" {
+			options.Backend = &Container{
+				runtime: options.Runtime,
+				image:   image,
+				backend: options.Backend,
+			}
+		}
+	}
+
+	switch v1.FromPtr(execution.Template.Executor) {
+	case "ansible":
+		return &Ansible{
+			options: options,
+		}, nil
+	case "terraform":
+		return &Terraform{
+			binary:  "terraform",
+			options: options,
+		}, nil
+	case "opentofu":
+		return &Terraform{
+			binary:  "tofu",
+			options: options,
+		}, nil
+	case "asdf":
+		return &Asdf{
+			options: options,
+		}, nil
+	default:
+		return nil, ErrUnknownExecutor
+	}
+}
@@ -0,0 +1,121 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+// checkout clones the repository into the named directory within the
+// workspace and returns the resolved commit SHA.
+func checkout(ctx context.Context, options Options, ws *workspace.Workspace, repository *v1.Repository, branch, name string, output io.Writer) (string, error) {
+	if repository == nil {
+		return "", ErrMissingRepository
+	}
+
+	target := ws.Path(name)
+	remote := deref(repository.URL)
+
+	env := append(
+		os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+	)
+
+	// Global options are only applied to the command and never persisted
+	// within the configuration of the cloned repository.
+	args := make([]string, 0)
+
+	if credential := repository.Credential; credential != nil {
+		switch deref(credential.Kind) {
+		case v1.Login:
+			if credential.Login != nil {
+				parsed, err := url.Parse(remote)
+
+				if err != nil {
+					return "", fmt.Errorf("failed to parse repository url: %w", err)
+				}
+
+				store := &url.URL{
+					Scheme: parsed.Scheme,
+					Host:   parsed.Host,
+					User: url.UserPassword(
+						deref(credential.Login.Username),
+						deref(credential.Login.Password),
+					),
+				}
+
+				// The credential file is a secret, it gets always removed
+				// when the workspace is closed.
+				file, err := ws.Secret(
+					name+".credentials",
+					store.String()+"\n",
+				)
+
+				if err != nil {
+					return "", err
+				}
+
+				args = append(
+					args,
+					"-c",
+					"credential.helper=",
+					"-c",
+					fmt.Sprintf("credential.helper=store --file=%s", file),
+				)
+			}
+		case v1.Shell:
+			if credential.Shell != nil && deref(credential.Shell.PrivateKey) != "" {
+				key, err := ws.Secret(
+					name+".key",
+					deref(credential.Shell.PrivateKey),
+				)
+
+				if err != nil {
+					return "", err
+				}
+
+				env = append(
+					env,
+					fmt.Sprintf(
+						"GIT_SSH_COMMAND=ssh -i %s -o IdentitiesOnly=yes -o StrictHostKeyChecking=accept-new",
+						key,
+					),
+				)
+			}
+		}
+	}
+
+	args = append(
+		args,
+		"clone",
+		"--depth",
+		"1",
+	)
+
+	if branch := value(&branch, repository.Branch); branch != "" {
+		args = append(args, "--branch", branch)
+	}
+
+	fmt.Fprintf(output, "Cloning %s into %s\n", deref(repository.URL), name)
+	clone := &bytes.Buffer{}
+
+	if err := run(ctx, options, ws.Path(), env, clone, "git", append(args, remote, target)...); err != nil {
+		fmt.Fprint(output, clone.String())
+		return "", fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	commit := &bytes.Buffer{}
+
+	if err := run(ctx, options, target, env, commit, "git", "rev-parse", "HEAD"); err != nil {
+		return "", fmt.Errorf("failed to resolve commit: %w", err)
+	}
+
+	return strings.TrimSpace(commit.String()), nil
+}
@@ -0,0 +1,68 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckout(t *testing.T) {
+	source := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(source, "site.yml"), []byte("---\n"), 0o600))
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "site.yml"},
+		{"-c", "user.name=gexec", "-c", "user.email=gexec@localhost", "commit", "--quiet", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = source
+
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(out))
+	}
+
+	manager, err := workspace.New(workspace.WithRoot(t.TempDir()))
+	assert.NoError(t, err)
+
+	ws, err := manager.Create("test")
+	assert.NoError(t, err)
+
+	defer func() { _ = ws.Close(false) }()
+
+	output := &bytes.Buffer{}
+
+	commit, err := checkout(
+		context.Background(),
+		newOptions(),
+		ws,
+		&v1.Repository{
+			URL: v1.ToPtr("file://" + source),
+			Credential: &v1.Credential{
+				Kind: v1.ToPtr(v1.Login),
+				Login: &v1.CredentialLogin{
+					Username: v1.ToPtr("gexec"),
+					Password: v1.ToPtr("supersecret"),
+				},
+			},
+		},
+		"",
+		"repository",
+		output,
+	)
+
+	assert.NoError(t, err, output.String())
+	assert.Len(t, commit, 40)
+
+	config, err := os.ReadFile(ws.Path("repository", ".git", "config"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(config), "supersecret")
+	assert.NotContains(t, output.String(), "supersecret")
+}
@@ -0,0 +1,221 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+	"unicode"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+)
+
+// run executes a command within the defined directory and writes the
+// combined output to the writer. If the context gets canceled the process
+// receives SIGINT, SIGTERM and finally SIGKILL after the defined timeouts.
+func run(ctx context.Context, options Options, dir string, env []string, output io.Writer, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+	}
+
+	for _, step := range []struct {
+		signal  os.Signal
+		timeout time.Duration
+	}{
+		{signal: os.Interrupt, timeout: options.Interrupt},
+		{signal: syscall.SIGTERM, timeout: options.Terminate},
+	} {
+		if err := cmd.Process.Signal(step.signal); err != nil {
+			continue
+		}
+
+		select {
+		case <-done:
+			return ctx.Err()
+		case <-time.After(step.timeout):
+		}
+	}
+
+	_ = cmd.Process.Kill()
+	<-done
+
+	return ctx.Err()
+}
+
+// value returns the first non-empty value of the provided pointers.
+func value(vals ...*string) string {
+	for _, v := range vals {
+		if v != nil && *v != "" {
+			return *v
+		}
+	}
+
+	return ""
+}
+
+// deref returns the value of a pointer or the zero value for nil.
+func deref[T any](v *T) T {
+	var result T
+
+	if v != nil {
+		result = *v
+	}
+
+	return result
+}
+
+// split parses a string of arguments into a list while respecting single
+// quotes, double quotes and escaped characters.
+func split(input string) ([]string, error) {
+	result := make([]string, 0)
+	current := strings.Builder{}
+
+	var (
+		quote   rune
+		escaped bool
+		started bool
+	)
+
+	for _, r := range input {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			started = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			started = true
+		case unicode.IsSpace(r):
+			if started {
+				result = append(result, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrInvalidArguments
+	}
+
+	if started {
+		result = append(result, current.String())
+	}
+
+	return result, nil
+}
+
+// variables collects the environment values and secrets, either as
+// environment variables or as extra variables.
+func variables(environment *v1.Environment, env []string, extra map[string]interface{}) []string {
+	if environment == nil {
+		return env
+	}
+
+	if environment.Values != nil {
+		for _, row := range *environment.Values {
+			switch deref(row.Kind) {
+			case v1.EnvironmentValueKindEnv:
+				env = append(env, fmt.Sprintf("%s=%s", value(row.Name), value(row.Content)))
+			case v1.EnvironmentValueKindVar:
+				extra[value(row.Name)] = value(row.Content)
+			}
+		}
+	}
+
+	if environment.Secrets != nil {
+		for _, row := range *environment.Secrets {
+			switch deref(row.Kind) {
+			case v1.EnvironmentSecretKindEnv:
+				env = append(env, fmt.Sprintf("%s=%s", value(row.Name), value(row.Content)))
+			case v1.EnvironmentSecretKindVar:
+				extra[value(row.Name)] = value(row.Content)
+			}
+		}
+	}
+
+	return env
+}
+
+// overrides appends the extra environment variables and secrets defined for
+// an execution, both of them are provided as JSON objects.
+func overrides(execution *v1.Execution, env []string) ([]string, error) {
+	for _, content := range []*string{execution.Environment, execution.Secret} {
+		if deref(content) == "" {
+			continue
+		}
+
+		vars := make(map[string]string)
+
+		if err := json.Unmarshal([]byte(*content), &vars); err != nil {
+			return nil, ErrInvalidOverrides
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(vars)) {
+			env = append(env, fmt.Sprintf("%s=%s", name, vars[name]))
+		}
+	}
+
+	return env, nil
+}
+
+// answers collects the survey answers of an execution as extra variables,
+// answers to number questions are passed as numbers.
+func answers(execution *v1.Execution, extra map[string]interface{}) {
+	if execution.Answers == nil || execution.Template.Surveys == nil {
+		return
+	}
+
+	given := *execution.Answers
+
+	for _, survey := range *execution.Template.Surveys {
+		val, ok := given[value(survey.Name)]
+
+		if !ok {
+			continue
+		}
+
+		switch deref(survey.Kind) {
+		case v1.Number:
+			extra[value(survey.Name)] = json.Number(val)
+		default:
+			extra[value(survey.Name)] = val
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"context"
+	"time"
+)
+
+// ConfirmFunc defines the callback to request a confirmation, it returns
+// true if the execution got approved.
+type ConfirmFunc func(context.Context) (bool, error)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Confirm   ConfirmFunc
+	Backend   Backend
+	Runtime   string
+	Interrupt time.Duration
+	Terminate time.Duration
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Runtime:   "docker",
+		Interrupt: 10 * time.Second,
+		Terminate: 10 * time.Second,
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// WithConfirm provides a function to set the confirm option.
+func WithConfirm(v ConfirmFunc) Option {
+	return func(o *Options) {
+		o.Confirm = v
+	}
+}
+
+// WithBackend provides a function to set the backend option.
+func WithBackend(v Backend) Option {
+	return func(o *Options) {
+		o.Backend = v
+	}
+}
+
+// WithRuntime provides a function to set the runtime option.
+func WithRuntime(v string) Option {
+	return func(o *Options) {
+		if v != "" {
+			o.Runtime = v
+		}
+	}
+}
+
+// WithInterrupt provides a function to set the interrupt option.
+func WithInterrupt(v time.Duration) Option {
+	return func(o *Options) {
+		o.Interrupt = v
+	}
+}
+
+// WithTerminate provides a function to set the terminate option.
+func WithTerminate(v time.Duration) Option {
+	return func(o *Options) {
+		o.Terminate = v
+	}
+}
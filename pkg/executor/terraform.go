@@ -0,0 +1,136 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+const (
+	// planChanges defines the exit code of a plan with pending changes.
+	planChanges = 2
+)
+
+// Terraform implements the executor for Terraform and OpenTofu, the plan
+// always gets confirmed before it is applied.
+type Terraform struct {
+	binary  string
+	options Options
+}
+
+// Execute implements the Executor interface.
+func (e *Terraform) Execute(ctx context.Context, execution *v1.Execution, ws *workspace.Workspace, output io.Writer) (*Result, error) {
+	template := execution.Template
+	result := &Result{}
+
+	commit, err := checkout(
+		ctx,
+		e.options,
+		ws,
+		template.Repository,
+		value(execution.Branch, template.Branch),
+		"repository",
+		output,
+	)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.CommitSHA = commit
+
+	dir := ws.Path("repository", value(execution.Path, template.Path))
+	plan := ws.Protect("terraform.tfplan")
+	extra := make(map[string]interface{})
+	env := variables(template.Environment, make([]string, 0), extra)
+	answers(execution, extra)
+
+	for name, val := range extra {
+		env = append(env, fmt.Sprintf("TF_VAR_%s=%s", name, val))
+	}
+
+	env, err = overrides(execution, env)
+
+	if err != nil {
+		return result, err
+	}
+
+	if deref(execution.Debug) {
+		env = append(env, "TF_LOG=DEBUG")
+	}
+
+	arguments, err := split(value(execution.Arguments, template.Arguments))
+
+	if err != nil {
+		return result, err
+	}
+
+	if err := e.command(ctx, ws, dir, env, output, "init", "-input=false", "-no-color"); err != nil {
+		return result, err
+	}
+
+	if inventory := template.Inventory; inventory != nil && deref(inventory.Kind) == v1.Workspace {
+		if err := e.command(ctx, ws, dir, env, output, "workspace", "select", "-or-create", deref(inventory.Content)); err != nil {
+			return result, err
+		}
+	}
+
+	err = e.command(
+		ctx,
+		ws,
+		dir,
+		env,
+		output,
+		append([]string{"plan", "-input=false", "-no-color", "-detailed-exitcode", "-out=" + plan}, arguments...)...,
+	)
+
+	var exitErr *exec.ExitError
+
+	switch {
+	case err == nil:
+		fmt.Fprintln(output, "No changes detected, skipping apply")
+		return result, nil
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == planChanges:
+	default:
+		return result, err
+	}
+
+	if e.options.Confirm == nil {
+		return result, ErrMissingConfirm
+	}
+
+	approved, err := e.options.Confirm(ctx)
+
+	if err != nil {
+		return result, err
+	}
+
+	if !approved {
+		fmt.Fprintln(output, "Plan has been rejected")
+		return result, ErrRejected
+	}
+
+	return result, e.command(ctx, ws, dir, env, output, "apply", "-input=false", "-no-color", plan)
+}
+
+func (e *Terraform) command(ctx context.Context, ws *workspace.Workspace, dir string, env []string, output io.Writer, args ...string) error {
+	fmt.Fprintf(output, "$ %s %s\n", e.binary, strings.Join(args, " "))
+
+	return e.options.Backend.Run(
+		ctx,
+		ws,
+		Command{
+			Dir:  dir,
+			Env:  env,
+			Name: e.binary,
+			Args: args,
+		},
+		output,
+	)
+}
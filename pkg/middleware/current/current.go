@@ -75,3 +75,30 @@ func SetUser(ctx context.Context, user *model.User) {
 		user,
 	)
 }
+
+// GetRunner returns the current runner from context.
+func GetRunner(ctx context.Context) *model.Runner {
+	general := ctx.Value(generalKey).(*Context)
+
+	value, ok := general.Get("current_runner")
+
+	if !ok {
+		return nil
+	}
+
+	if res, ok := value.(*model.Runner); ok {
+		return res
+	}
+
+	return nil
+}
+
+// SetRunner stores the current runner within context.
+func SetRunner(ctx context.Context, runner *model.Runner) {
+	general := ctx.Value(generalKey).(*Context)
+
+	general.Set(
+		"current_runner",
+		runner,
+	)
+}
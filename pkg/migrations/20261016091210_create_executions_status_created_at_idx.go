@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+
+			ID        string    `bun:",pk,type:varchar(20)"`
+			Status    string    `bun:"type:varchar(255)"`
+			CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+		}
+
+		_, err := db.NewCreateIndex().
+			Model((*Execution)(nil)).
+			Index("executions_status_and_created_at_idx").
+			Column("status").
+			Column("created_at").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		_, err := db.NewDropIndex().
+			Model((*Execution)(nil)).
+			IfExists().
+			Index("executions_status_and_created_at_idx").
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		for _, column := range []string{
+			fmt.Sprintf("started_at %s", timestamp),
+			fmt.Sprintf("finished_at %s", timestamp),
+			"exit_code INTEGER",
+			"commit_sha VARCHAR(255)",
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Execution)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		for _, column := range []string{
+			"started_at",
+			"finished_at",
+			"exit_code",
+			"commit_sha",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Execution)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
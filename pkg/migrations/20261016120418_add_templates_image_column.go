@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		_, err := db.NewAddColumn().
+			Model((*Template)(nil)).
+			ColumnExpr("image VARCHAR(255)").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Template)(nil)).
+			Column("image").
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		if _, err := db.NewAddColumn().
+			Model((*Runner)(nil)).
+			ColumnExpr("capacity INTEGER").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewAddColumn().
+			Model((*Template)(nil)).
+			ColumnExpr("sequential BOOLEAN DEFAULT FALSE").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		if _, err := db.NewDropColumn().
+			Model((*Runner)(nil)).
+			Column("capacity").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Template)(nil)).
+			Column("sequential").
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type JoinToken struct {
+			bun.BaseModel `bun:"table:join_tokens"`
+
+			ID        string    `bun:",pk,type:varchar(20)"`
+			ProjectID string    `bun:",nullzero,type:varchar(20)"`
+			Name      string    `bun:"type:varchar(255)"`
+			Token     string    `bun:"type:varchar(255)"`
+			Labels    string    `bun:"type:text"`
+			Uses      int       `bun:"type:integer"`
+			Used      int       `bun:"type:integer"`
+			ExpiresAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+			CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+			UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+		}
+
+		_, err := db.NewCreateTable().
+			Model((*JoinToken)(nil)).
+			WithForeignKeys().
+			ForeignKey(`(project_id) REFERENCES projects (id) ON DELETE CASCADE`).
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type JoinToken struct {
+			bun.BaseModel `bun:"table:join_tokens"`
+		}
+
+		_, err := db.NewDropTable().
+			Model((*JoinToken)(nil)).
+			IfExists().
+			Exec(ctx)
+
+		return err
+	})
+}
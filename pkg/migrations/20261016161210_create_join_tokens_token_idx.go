@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type JoinToken struct {
+			bun.BaseModel `bun:"table:join_tokens"`
+
+			ID    string `bun:",pk,type:varchar(20)"`
+			Token string `bun:"type:varchar(255)"`
+		}
+
+		_, err := db.NewCreateIndex().
+			Model((*JoinToken)(nil)).
+			Index("join_tokens_token_idx").
+			Column("token").
+			Unique().
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type JoinToken struct {
+			bun.BaseModel `bun:"table:join_tokens"`
+		}
+
+		_, err := db.NewDropIndex().
+			Model((*JoinToken)(nil)).
+			IfExists().
+			Index("join_tokens_token_idx").
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		for _, column := range []string{
+			"grace_token VARCHAR(255)",
+			fmt.Sprintf("grace_expires_at %s", timestamp),
+			fmt.Sprintf("rotated_at %s", timestamp),
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Runner)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		for _, column := range []string{
+			"grace_token",
+			"grace_expires_at",
+			"rotated_at",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Runner)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		if _, err := db.NewAddColumn().
+			Model((*Execution)(nil)).
+			ColumnExpr("arguments VARCHAR(255)").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Encrypted secrets and extra environments exceed the previous size,
+		// SQLite does not enforce the length at all.
+		for _, column := range []string{
+			"environment",
+			"secret",
+		} {
+			switch db.Dialect().Name() {
+			case dialect.PG:
+				if _, err := db.ExecContext(
+					ctx,
+					"ALTER TABLE executions ALTER COLUMN ? TYPE TEXT",
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			case dialect.MySQL:
+				if _, err := db.ExecContext(
+					ctx,
+					"ALTER TABLE executions MODIFY ? TEXT",
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		for _, column := range []string{
+			"environment",
+			"secret",
+		} {
+			switch db.Dialect().Name() {
+			case dialect.PG:
+				if _, err := db.ExecContext(
+					ctx,
+					"ALTER TABLE executions ALTER COLUMN ? TYPE VARCHAR(255)",
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			case dialect.MySQL:
+				if _, err := db.ExecContext(
+					ctx,
+					"ALTER TABLE executions MODIFY ? VARCHAR(255)",
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Execution)(nil)).
+			Column("arguments").
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		for _, column := range []string{
+			"parent_id VARCHAR(20)",
+			"attempt INTEGER DEFAULT 1",
+			fmt.Sprintf("not_before %s", timestamp),
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Execution)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, column := range []string{
+			"retry_attempts INTEGER",
+			"retry_backoff INTEGER",
+			"retry_codes TEXT",
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Template)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		type Template struct {
+			bun.BaseModel `bun:"table:templates"`
+		}
+
+		for _, column := range []string{
+			"parent_id",
+			"attempt",
+			"not_before",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Execution)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		for _, column := range []string{
+			"retry_attempts",
+			"retry_backoff",
+			"retry_codes",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Template)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		_, err := db.NewAddColumn().
+			Model((*Execution)(nil)).
+			ColumnExpr("priority INTEGER DEFAULT 50").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Execution)(nil)).
+			Column("priority").
+			Exec(ctx)
+
+		return err
+	})
+}
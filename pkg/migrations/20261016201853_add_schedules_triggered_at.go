@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		if _, err := db.NewAddColumn().
+			Model((*Schedule)(nil)).
+			ColumnExpr(fmt.Sprintf("triggered_at %s", timestamp)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewAddColumn().
+			Model((*Execution)(nil)).
+			ColumnExpr("schedule_id VARCHAR(20)").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		type Execution struct {
+			bun.BaseModel `bun:"table:executions"`
+		}
+
+		if _, err := db.NewDropColumn().
+			Model((*Schedule)(nil)).
+			Column("triggered_at").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Execution)(nil)).
+			Column("schedule_id").
+			Exec(ctx)
+
+		return err
+	})
+}
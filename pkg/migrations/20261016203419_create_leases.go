@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Lease struct {
+			bun.BaseModel `bun:"table:leases"`
+
+			Name      string    `bun:",pk,type:varchar(255)"`
+			Holder    string    `bun:"type:varchar(255)"`
+			ExpiresAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+			CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+			UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+		}
+
+		_, err := db.NewCreateTable().
+			Model((*Lease)(nil)).
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Lease struct {
+			bun.BaseModel `bun:"table:leases"`
+		}
+
+		_, err := db.NewDropTable().
+			Model((*Lease)(nil)).
+			IfExists().
+			Exec(ctx)
+
+		return err
+	})
+}
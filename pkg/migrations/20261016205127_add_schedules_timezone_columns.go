@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		if _, err := db.NewAddColumn().
+			Model((*Schedule)(nil)).
+			ColumnExpr("timezone VARCHAR(255) DEFAULT 'UTC'").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewAddColumn().
+			Model((*Schedule)(nil)).
+			ColumnExpr("missed VARCHAR(255) DEFAULT 'skip'").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		if _, err := db.NewDropColumn().
+			Model((*Schedule)(nil)).
+			Column("timezone").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := db.NewDropColumn().
+			Model((*Schedule)(nil)).
+			Column("missed").
+			Exec(ctx)
+
+		return err
+	})
+}
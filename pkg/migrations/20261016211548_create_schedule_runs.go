@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type ScheduleRun struct {
+			bun.BaseModel `bun:"table:schedule_runs"`
+
+			ID          string    `bun:",pk,type:varchar(20)"`
+			ScheduleID  string    `bun:"type:varchar(20)"`
+			ExecutionID string    `bun:",nullzero,type:varchar(20)"`
+			Status      string    `bun:"type:varchar(255)"`
+			Message     string    `bun:"type:text"`
+			TriggeredAt time.Time `bun:",nullzero"`
+			FinishedAt  time.Time `bun:",nullzero"`
+			CreatedAt   time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+			UpdatedAt   time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+		}
+
+		_, err := db.NewCreateTable().
+			Model((*ScheduleRun)(nil)).
+			WithForeignKeys().
+			ForeignKey(`(schedule_id) REFERENCES schedules (id) ON DELETE CASCADE`).
+			ForeignKey(`(execution_id) REFERENCES executions (id) ON DELETE SET NULL`).
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type ScheduleRun struct {
+			bun.BaseModel `bun:"table:schedule_runs"`
+		}
+
+		_, err := db.NewDropTable().
+			Model((*ScheduleRun)(nil)).
+			IfExists().
+			Exec(ctx)
+
+		return err
+	})
+}
@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		for _, column := range []string{
+			fmt.Sprintf("last_run_at %s", timestamp),
+			"last_status VARCHAR(255)",
+			"last_execution_id VARCHAR(20)",
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Schedule)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		for _, column := range []string{
+			"last_run_at",
+			"last_status",
+			"last_execution_id",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Schedule)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
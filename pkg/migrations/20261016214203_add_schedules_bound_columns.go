@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		timestamp := "TIMESTAMP"
+
+		switch db.Dialect().Name() {
+		case dialect.PG:
+			timestamp = "TIMESTAMPTZ"
+		case dialect.MySQL:
+			timestamp = "DATETIME"
+		}
+
+		for _, column := range []string{
+			"run_at",
+			"start_at",
+			"end_at",
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Schedule)(nil)).
+				ColumnExpr(fmt.Sprintf("%s %s", column, timestamp)).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Schedule struct {
+			bun.BaseModel `bun:"table:schedules"`
+		}
+
+		for _, column := range []string{
+			"run_at",
+			"start_at",
+			"end_at",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Schedule)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		for _, column := range []string{
+			"token_digest VARCHAR(64)",
+			"grace_digest VARCHAR(64)",
+		} {
+			if _, err := db.NewAddColumn().
+				Model((*Runner)(nil)).
+				ColumnExpr(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		for _, column := range []string{
+			"token_digest",
+			"grace_digest",
+		} {
+			if _, err := db.NewDropColumn().
+				Model((*Runner)(nil)).
+				Column(column).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+
+			ID          string `bun:",pk,type:varchar(20)"`
+			TokenDigest string `bun:"type:varchar(64)"`
+		}
+
+		_, err := db.NewCreateIndex().
+			Model((*Runner)(nil)).
+			Index("runners_token_digest_idx").
+			Column("token_digest").
+			Exec(ctx)
+
+		return err
+	}, func(ctx context.Context, db *bun.DB) error {
+		type Runner struct {
+			bun.BaseModel `bun:"table:runners"`
+		}
+
+		_, err := db.NewDropIndex().
+			Model((*Runner)(nil)).
+			IfExists().
+			Index("runners_token_digest_idx").
+			Exec(ctx)
+
+		return err
+	})
+}
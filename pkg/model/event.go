@@ -22,6 +22,35 @@ const (
 
 	// EventActionDelete defines the action delete.
 	EventActionDelete EventAction = "delete"
+
+	// EventActionApprove defines the action approve.
+	EventActionApprove EventAction = "approve"
+
+	// EventActionReject defines the action reject.
+	EventActionReject EventAction = "reject"
+
+	// EventActionRotate defines the action rotate.
+	EventActionRotate EventAction = "rotate"
+
+	// EventActionRevoke defines the action revoke.
+	EventActionRevoke EventAction = "revoke"
+
+	// EventActionRerun defines the action rerun.
+	EventActionRerun EventAction = "rerun"
+
+	// EventActionRetry defines the action retry.
+	EventActionRetry EventAction = "retry"
+
+	// EventActionTrigger defines the action trigger.
+	EventActionTrigger EventAction = "trigger"
+)
+
+const (
+	// EventSystemHandle defines the user handle for system events.
+	EventSystemHandle = "system"
+
+	// EventSystemDisplay defines the user display for system events.
+	EventSystemDisplay = "System"
 )
 
 // EventType defines a custom type for event types.
@@ -58,6 +87,9 @@ const (
 	// EventTypeRunner defines event type for runners.
 	EventTypeRunner EventType = "runner"
 
+	// EventTypeJoinToken defines event type for join tokens.
+	EventTypeJoinToken EventType = "join_token"
+
 	// EventTypeCredential defines event type for credentials.
 	EventTypeCredential EventType = "credential"
 
@@ -182,3 +214,11 @@ func PrepareEvent(principal *User, event *Event) *Event {
 
 	return event
 }
+
+// SystemEvent prefills the event model as an action of the system itself.
+func SystemEvent(event *Event) *Event {
+	event.UserHandle = EventSystemHandle
+	event.UserDisplay = EventSystemDisplay
+
+	return event
+}
@@ -45,6 +45,14 @@ const (
 	ExecutionStatusFailure ExecutionStatus = "failure"
 )
 
+const (
+	// ExecutionPriorityScheduled defines the default priority of scheduled executions.
+	ExecutionPriorityScheduled = 25
+
+	// ExecutionPriorityManual defines the default priority of manual executions.
+	ExecutionPriorityManual = 50
+)
+
 var (
 	_ bun.BeforeAppendModelHook = (*Execution)(nil)
 	_ bun.AfterScanRowHook      = (*Event)(nil)
@@ -59,14 +67,29 @@ type Execution struct {
 	Project     *Project        `bun:"rel:belongs-to,join:project_id=id"`
 	TemplateID  string          `bun:"type:varchar(20)"`
 	Template    *Template       `bun:"rel:belongs-to,join:template_id=id"`
+	RunnerID    string          `bun:",nullzero,type:varchar(20)"`
+	Runner      *Runner         `bun:"rel:belongs-to,join:runner_id=id"`
+	ParentID    string          `bun:",nullzero,type:varchar(20)"`
+	Parent      *Execution      `bun:"rel:belongs-to,join:parent_id=id"`
+	ScheduleID  string          `bun:",nullzero,type:varchar(20)"`
+	Schedule    *Schedule       `bun:"rel:belongs-to,join:schedule_id=id"`
 	Name        string          `bun:"-"`
 	Status      ExecutionStatus `bun:"type:varchar(255)"`
 	Path        string          `bun:"type:varchar(255)"`
-	Environment string          `bun:"type:varchar(255)"`
-	Secret      string          `bun:"type:varchar(255)"`
+	Arguments   string          `bun:"type:varchar(255)"`
+	Environment string          `bun:"type:text"`
+	Secret      string          `bun:"type:text"`
+	Answers     string          `bun:"type:text"`
 	Limit       string          `bun:"type:varchar(255)"`
 	Branch      string          `bun:"type:varchar(255)"`
 	Debug       bool            `bun:"type:bool"`
+	Priority    int             `bun:"type:integer"`
+	Attempt     int             `bun:"type:integer"`
+	NotBefore   time.Time       `bun:",nullzero"`
+	CommitSHA   string          `bun:",nullzero,type:varchar(255)"`
+	ExitCode    int             `bun:",nullzero,type:integer"`
+	StartedAt   time.Time       `bun:",nullzero"`
+	FinishedAt  time.Time       `bun:",nullzero"`
 	CreatedAt   time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
 	UpdatedAt   time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
 }
@@ -80,6 +103,11 @@ func (m *Execution) BeforeAppendModel(_ context.Context, query bun.Query) error
 		}
 
 		m.Status = ExecutionStatusWaiting
+
+		if m.Attempt == 0 {
+			m.Attempt = 1
+		}
+
 		m.CreatedAt = time.Now()
 		m.UpdatedAt = time.Now()
 	case *bun.UpdateQuery:
@@ -108,8 +136,65 @@ func (m *Execution) AfterScanRow(_ context.Context) error {
 	return nil
 }
 
+// Derive prepares a new execution with the same template, overrides and
+// answers, linked to this execution as parent. Secrets are copied as they
+// are, so they stay encrypted.
+func (m *Execution) Derive() *Execution {
+	return &Execution{
+		ProjectID:   m.ProjectID,
+		TemplateID:  m.TemplateID,
+		ParentID:    m.ID,
+		Path:        m.Path,
+		Arguments:   m.Arguments,
+		Environment: m.Environment,
+		Secret:      m.Secret,
+		Answers:     m.Answers,
+		Limit:       m.Limit,
+		Branch:      m.Branch,
+		Debug:       m.Debug,
+	}
+}
+
+// Retry prepares the next attempt of this execution, in contrast to Derive
+// it stays linked to the schedule and keeps the priority.
+func (m *Execution) Retry() *Execution {
+	result := m.Derive()
+	result.ScheduleID = m.ScheduleID
+	result.Priority = m.Priority
+	result.Attempt = m.Attempt + 1
+
+	return result
+}
+
 // SerializeSecret ensures to encrypt all related secrets stored on the database.
 func (m *Execution) SerializeSecret(passphrase string) error {
+	gcm, err := prepareEncrypt(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	nonce, err := generateNonce(gcm.NonceSize())
+
+	if err != nil {
+		return err
+	}
+
+	if m.Secret != "" {
+		m.Secret = encryptSecret(gcm, nonce, m.Secret)
+	}
+
+	// Answers could contain secrets, they are always stored encrypted.
+	if m.Answers != "" {
+		nonce, err := generateNonce(gcm.NonceSize())
+
+		if err != nil {
+			return err
+		}
+
+		m.Answers = encryptSecret(gcm, nonce, m.Answers)
+	}
+
 	if m.Template != nil {
 		if err := m.Template.SerializeSecret(passphrase); err != nil {
 			return err
@@ -121,6 +206,32 @@ func (m *Execution) SerializeSecret(passphrase string) error {
 
 // DeserializeSecret ensures to decrypt all related secrets stored on the database.
 func (m *Execution) DeserializeSecret(passphrase string) error {
+	gcm, err := prepareEncrypt(passphrase)
+
+	if err != nil {
+		return err
+	}
+
+	if m.Secret != "" {
+		decrypted, err := decryptSecret(gcm, m.Secret)
+
+		if err != nil {
+			return err
+		}
+
+		m.Secret = decrypted
+	}
+
+	if m.Answers != "" {
+		decrypted, err := decryptSecret(gcm, m.Answers)
+
+		if err != nil {
+			return err
+		}
+
+		m.Answers = decrypted
+	}
+
 	if m.Template != nil {
 		if err := m.Template.DeserializeSecret(passphrase); err != nil {
 			return err
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionDerive(t *testing.T) {
+	parent := &Execution{
+		ID:         "parent",
+		ProjectID:  "project",
+		TemplateID: "template",
+		ScheduleID: "schedule",
+		Answers:    `{"name":"value"}`,
+		Priority:   5,
+		Attempt:    2,
+		ExitCode:   1,
+	}
+
+	record := parent.Derive()
+
+	assert.Equal(t, "parent", record.ParentID)
+	assert.Equal(t, "project", record.ProjectID)
+	assert.Equal(t, "template", record.TemplateID)
+	assert.Equal(t, `{"name":"value"}`, record.Answers)
+	assert.Empty(t, record.ID)
+	assert.Empty(t, record.ScheduleID)
+	assert.Zero(t, record.Priority)
+	assert.Zero(t, record.Attempt)
+	assert.Zero(t, record.ExitCode)
+}
+
+func TestExecutionRetry(t *testing.T) {
+	parent := &Execution{
+		ID:         "parent",
+		ProjectID:  "project",
+		TemplateID: "template",
+		ScheduleID: "schedule",
+		Priority:   ExecutionPriorityScheduled,
+		Attempt:    2,
+		ExitCode:   1,
+	}
+
+	record := parent.Retry()
+
+	assert.Equal(t, "parent", record.ParentID)
+	assert.Equal(t, "template", record.TemplateID)
+	assert.Equal(t, "schedule", record.ScheduleID)
+	assert.Equal(t, ExecutionPriorityScheduled, record.Priority)
+	assert.Equal(t, 3, record.Attempt)
+	assert.Zero(t, record.ExitCode)
+}
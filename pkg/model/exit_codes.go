@@ -0,0 +1,64 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+var (
+	_ sql.Scanner   = (*ExitCodes)(nil)
+	_ driver.Valuer = (*ExitCodes)(nil)
+)
+
+// ExitCodes defines a list of exit codes used to decide about retries.
+type ExitCodes []int
+
+// Matches checks if the exit code is part of the list, an empty list
+// matches any exit code.
+func (c ExitCodes) Matches(code int) bool {
+	if len(c) == 0 {
+		return true
+	}
+
+	return slices.Contains(c, code)
+}
+
+// Value implements the driver.Valuer interface.
+func (c ExitCodes) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+
+	result, err := json.Marshal(c)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(result), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *ExitCodes) Scan(src interface{}) error {
+	result := make(ExitCodes, 0)
+
+	switch val := src.(type) {
+	case nil:
+	case string:
+		if err := json.Unmarshal([]byte(val), &result); err != nil {
+			return err
+		}
+	case []byte:
+		if err := json.Unmarshal(val, &result); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported exit codes type %T", src)
+	}
+
+	*c = result
+	return nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook = (*JoinToken)(nil)
+)
+
+// JoinToken defines the model for join_tokens table.
+type JoinToken struct {
+	bun.BaseModel `bun:"table:join_tokens"`
+
+	ID        string    `bun:",pk,type:varchar(20)"`
+	ProjectID string    `bun:",nullzero,type:varchar(20)"`
+	Project   *Project  `bun:"rel:belongs-to,join:project_id=id"`
+	Name      string    `bun:"type:varchar(255)"`
+	Token     string    `bun:"type:varchar(255)"`
+	Labels    Labels    `bun:"type:text"`
+	Uses      int       `bun:"type:integer"`
+	Used      int       `bun:"type:integer"`
+	ExpiresAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// BeforeAppendModel implements the bun hook interface.
+func (m *JoinToken) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if m.ID == "" {
+			m.ID = strings.ToLower(uniuri.NewLen(uniuri.UUIDLen))
+		}
+
+		m.CreatedAt = time.Now()
+		m.UpdatedAt = time.Now()
+	case *bun.UpdateQuery:
+		if m.ID == "" {
+			m.ID = strings.ToLower(uniuri.NewLen(uniuri.UUIDLen))
+		}
+
+		m.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
+
+// HashJoinToken generates the digest of a join token as stored within the
+// database, the plain token is only visible once after the creation.
+func HashJoinToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
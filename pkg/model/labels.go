@@ -0,0 +1,64 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+var (
+	_ sql.Scanner   = (*Labels)(nil)
+	_ driver.Valuer = (*Labels)(nil)
+)
+
+// Labels defines key value pairs used to route executions to runners.
+type Labels map[string]string
+
+// Satisfies checks if all the required labels are matched by the labels.
+func (l Labels) Satisfies(required Labels) bool {
+	for key, val := range required {
+		if current, ok := l[key]; !ok || current != val {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value implements the driver.Valuer interface.
+func (l Labels) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	result, err := json.Marshal(l)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(result), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *Labels) Scan(src interface{}) error {
+	result := make(Labels)
+
+	switch val := src.(type) {
+	case nil:
+	case string:
+		if err := json.Unmarshal([]byte(val), &result); err != nil {
+			return err
+		}
+	case []byte:
+		if err := json.Unmarshal(val, &result); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported labels type %T", src)
+	}
+
+	*l = result
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+const (
+	// LeaseLeader defines the lease name for the leader election.
+	LeaseLeader = "leader"
+)
+
+var (
+	_ bun.BeforeAppendModelHook = (*Lease)(nil)
+)
+
+// Lease defines the model for leases table.
+type Lease struct {
+	bun.BaseModel `bun:"table:leases"`
+
+	Name      string    `bun:",pk,type:varchar(255)"`
+	Holder    string    `bun:"type:varchar(255)"`
+	ExpiresAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// BeforeAppendModel implements the bun hook interface.
+func (m *Lease) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		m.CreatedAt = time.Now()
+		m.UpdatedAt = time.Now()
+	case *bun.UpdateQuery:
+		m.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
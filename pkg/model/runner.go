@@ -2,6 +2,8 @@ package model
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 	"time"
 
@@ -17,14 +19,26 @@ var (
 type Runner struct {
 	bun.BaseModel `bun:"table:runners"`
 
-	ID        string    `bun:",pk,type:varchar(20)"`
-	ProjectID string    `bun:",nullzero,type:varchar(20)"`
-	Project   *Project  `bun:"rel:belongs-to,join:project_id=id"`
-	Slug      string    `bun:"type:varchar(255)"`
-	Name      string    `bun:"type:varchar(255)"`
-	Token     string    `bun:"type:varchar(255)"`
-	CreatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
-	UpdatedAt time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	ID             string    `bun:",pk,type:varchar(20)"`
+	ProjectID      string    `bun:",nullzero,type:varchar(20)"`
+	Project        *Project  `bun:"rel:belongs-to,join:project_id=id"`
+	Slug           string    `bun:"type:varchar(255)"`
+	Name           string    `bun:"type:varchar(255)"`
+	Token          string    `bun:"type:varchar(255)"`
+	TokenDigest    string    `bun:",nullzero,type:varchar(64)"`
+	GraceToken     string    `bun:",nullzero,type:varchar(255)"`
+	GraceDigest    string    `bun:",nullzero,type:varchar(64)"`
+	GraceExpiresAt time.Time `bun:",nullzero"`
+	RotatedAt      time.Time `bun:",nullzero"`
+	Labels         Labels    `bun:"type:text"`
+	Capacity       int       `bun:",nullzero,type:integer"`
+	Version        string    `bun:",nullzero,type:varchar(255)"`
+	Hostname       string    `bun:",nullzero,type:varchar(255)"`
+	OS             string    `bun:"os,nullzero,type:varchar(255)"`
+	Arch           string    `bun:",nullzero,type:varchar(255)"`
+	LastSeenAt     time.Time `bun:",nullzero"`
+	CreatedAt      time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time `bun:",nullzero,notnull,default:current_timestamp"`
 }
 
 // BeforeAppendModel implements the bun hook interface.
@@ -49,6 +63,7 @@ func (m *Runner) BeforeAppendModel(_ context.Context, query bun.Query) error {
 }
 
 // SerializeSecret ensures to encrypt all related secrets stored on the database.
+// The digests of the plain tokens are updated as well to lookup the runner.
 func (m *Runner) SerializeSecret(passphrase string) error {
 	gcm, err := prepareEncrypt(passphrase)
 
@@ -56,6 +71,8 @@ func (m *Runner) SerializeSecret(passphrase string) error {
 		return err
 	}
 
+	m.Digest()
+
 	nonce, err := generateNonce(gcm.NonceSize())
 
 	if err != nil {
@@ -66,6 +83,16 @@ func (m *Runner) SerializeSecret(passphrase string) error {
 		m.Token = encryptSecret(gcm, nonce, m.Token)
 	}
 
+	if m.GraceToken != "" {
+		nonce, err := generateNonce(gcm.NonceSize())
+
+		if err != nil {
+			return err
+		}
+
+		m.GraceToken = encryptSecret(gcm, nonce, m.GraceToken)
+	}
+
 	return nil
 }
 
@@ -87,5 +114,41 @@ func (m *Runner) DeserializeSecret(passphrase string) error {
 		m.Token = decrypted
 	}
 
+	if m.GraceToken != "" {
+		decrypted, err := decryptSecret(gcm, m.GraceToken)
+
+		if err != nil {
+			return err
+		}
+
+		m.GraceToken = decrypted
+	}
+
 	return nil
 }
+
+// GraceActive checks if the previous token of a rotation is still accepted.
+func (m *Runner) GraceActive() bool {
+	return m.GraceToken != "" && time.Now().Before(m.GraceExpiresAt)
+}
+
+// Digest updates the digests of the plain tokens to lookup the runner.
+func (m *Runner) Digest() {
+	m.TokenDigest = ""
+	m.GraceDigest = ""
+
+	if m.Token != "" {
+		m.TokenDigest = HashRunnerToken(m.Token)
+	}
+
+	if m.GraceToken != "" {
+		m.GraceDigest = HashRunnerToken(m.GraceToken)
+	}
+}
+
+// HashRunnerToken generates the digest of a runner token as stored within the
+// database, it gets used to lookup a runner without decrypting all tokens.
+func HashRunnerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -5,10 +5,25 @@ import (
 	"strings"
 	"time"
 
+	"github.com/adhocore/gronx"
 	"github.com/dchest/uniuri"
 	"github.com/uptrace/bun"
 )
 
+const (
+	// ScheduleMissedSkip defines the policy to skip missed runs.
+	ScheduleMissedSkip = "skip"
+
+	// ScheduleMissedOnce defines the policy to run missed runs once.
+	ScheduleMissedOnce = "once"
+
+	// ScheduleMissedAll defines the policy to run all missed runs.
+	ScheduleMissedAll = "all"
+
+	// ScheduleMissedLimit defines the maximum of missed runs to catch up.
+	ScheduleMissedLimit = 100
+)
+
 var (
 	_ bun.BeforeAppendModelHook = (*Schedule)(nil)
 )
@@ -17,17 +32,26 @@ var (
 type Schedule struct {
 	bun.BaseModel `bun:"table:schedules"`
 
-	ID         string    `bun:",pk,type:varchar(20)"`
-	ProjectID  string    `bun:"type:varchar(20)"`
-	Project    *Project  `bun:"rel:belongs-to,join:project_id=id"`
-	TemplateID string    `bun:"type:varchar(20)"`
-	Template   *Template `bun:"rel:belongs-to,join:template_id=id"`
-	Slug       string    `bun:"type:varchar(255)"`
-	Name       string    `bun:"type:varchar(255)"`
-	Cron       string    `bun:"type:varchar(255)"`
-	Active     bool      `bun:"type:bool"`
-	CreatedAt  time.Time `bun:",nullzero,notnull,default:current_timestamp"`
-	UpdatedAt  time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+	ID              string          `bun:",pk,type:varchar(20)"`
+	ProjectID       string          `bun:"type:varchar(20)"`
+	Project         *Project        `bun:"rel:belongs-to,join:project_id=id"`
+	TemplateID      string          `bun:"type:varchar(20)"`
+	Template        *Template       `bun:"rel:belongs-to,join:template_id=id"`
+	Slug            string          `bun:"type:varchar(255)"`
+	Name            string          `bun:"type:varchar(255)"`
+	Cron            string          `bun:"type:varchar(255)"`
+	RunAt           time.Time       `bun:",nullzero"`
+	StartAt         time.Time       `bun:",nullzero"`
+	EndAt           time.Time       `bun:",nullzero"`
+	Timezone        string          `bun:"type:varchar(255)"`
+	Missed          string          `bun:"type:varchar(255)"`
+	Active          bool            `bun:"type:bool"`
+	TriggeredAt     time.Time       `bun:",nullzero"`
+	LastRunAt       time.Time       `bun:",nullzero"`
+	LastStatus      ExecutionStatus `bun:"type:varchar(255)"`
+	LastExecutionID string          `bun:",nullzero,type:varchar(20)"`
+	CreatedAt       time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
 }
 
 // BeforeAppendModel implements the bun hook interface.
@@ -51,6 +75,160 @@ func (m *Schedule) BeforeAppendModel(_ context.Context, query bun.Query) error {
 	return nil
 }
 
+// Location returns the time zone of the schedule, it falls back to UTC for
+// missing or unknown time zones.
+func (m *Schedule) Location() *time.Location {
+	if m.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(m.Timezone)
+
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// Next returns the upcoming runs of the schedule after the given time. The
+// runs of recurring schedules are limited to their start and end date.
+func (m *Schedule) Next(after time.Time, count int) []time.Time {
+	result := make([]time.Time, 0, count)
+
+	if !m.RunAt.IsZero() {
+		if m.RunAt.After(after) && count > 0 {
+			result = append(result, m.RunAt)
+		}
+
+		return result
+	}
+
+	if !m.StartAt.IsZero() && m.StartAt.After(after) {
+		after = m.StartAt.Add(-time.Nanosecond)
+	}
+
+	cur := after.In(m.Location()).Truncate(time.Minute)
+
+	for len(result) < count {
+		tick, err := gronx.NextTickAfter(m.Cron, cur, false)
+
+		if err != nil || !tick.After(cur) {
+			break
+		}
+
+		cur = tick.Truncate(time.Minute)
+
+		if !m.EndAt.IsZero() && cur.After(m.EndAt) {
+			break
+		}
+
+		result = append(result, cur)
+	}
+
+	return result
+}
+
+// Pending returns the runs of the schedule up to now which have not been
+// triggered yet. Runs older than the grace period have been missed and are
+// handled depending on the missed policy. Runs before the creation of the
+// schedule are never pending.
+func (m *Schedule) Pending(now time.Time, grace time.Duration) []time.Time {
+	if !m.RunAt.IsZero() {
+		if m.RunAt.After(now) || !m.TriggeredAt.Before(m.RunAt) {
+			return nil
+		}
+
+		if m.missed(now, m.RunAt, grace) {
+			return nil
+		}
+
+		return []time.Time{m.RunAt}
+	}
+
+	since := m.CreatedAt
+
+	if m.TriggeredAt.After(since) {
+		since = m.TriggeredAt
+	}
+
+	if !m.StartAt.IsZero() && m.StartAt.After(since) {
+		since = m.StartAt.Add(-time.Nanosecond)
+	}
+
+	until := now
+
+	if !m.EndAt.IsZero() && m.EndAt.Before(until) {
+		until = m.EndAt
+	}
+
+	latest, err := gronx.PrevTickBefore(
+		m.Cron,
+		until.In(m.Location()).Truncate(time.Minute),
+		true,
+	)
+
+	if err != nil {
+		return nil
+	}
+
+	latest = latest.Truncate(time.Minute)
+
+	if !latest.After(since) {
+		return nil
+	}
+
+	switch m.Missed {
+	case ScheduleMissedOnce:
+		return []time.Time{latest}
+	case ScheduleMissedAll:
+		result := make([]time.Time, 0)
+
+		for _, tick := range m.Next(since, ScheduleMissedLimit) {
+			if tick.After(latest) {
+				break
+			}
+
+			result = append(result, tick)
+		}
+
+		return result
+	default:
+		if m.missed(now, latest, grace) {
+			return nil
+		}
+
+		return []time.Time{latest}
+	}
+}
+
+// Expired returns if the schedule will never run again, either the one-shot
+// run has passed or the end date of a recurring schedule has been reached.
+// Schedules with pending runs are not expired until they have been caught up.
+func (m *Schedule) Expired(now time.Time, grace time.Duration) bool {
+	if len(m.Pending(now, grace)) > 0 {
+		return false
+	}
+
+	if !m.RunAt.IsZero() {
+		return !m.RunAt.After(now)
+	}
+
+	if !m.EndAt.IsZero() {
+		return m.EndAt.Before(now)
+	}
+
+	return false
+}
+
+func (m *Schedule) missed(now, run time.Time, grace time.Duration) bool {
+	if m.Missed == ScheduleMissedOnce || m.Missed == ScheduleMissedAll {
+		return false
+	}
+
+	return now.Sub(run) > grace
+}
+
 // SerializeSecret ensures to encrypt all related secrets stored on the database.
 func (m *Schedule) SerializeSecret(passphrase string) error {
 	if m.Template != nil {
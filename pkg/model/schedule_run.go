@@ -0,0 +1,52 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/uptrace/bun"
+)
+
+var (
+	_ bun.BeforeAppendModelHook = (*ScheduleRun)(nil)
+)
+
+// ScheduleRun defines the model for schedule_runs table.
+type ScheduleRun struct {
+	bun.BaseModel `bun:"table:schedule_runs"`
+
+	ID          string          `bun:",pk,type:varchar(20)"`
+	ScheduleID  string          `bun:"type:varchar(20)"`
+	Schedule    *Schedule       `bun:"rel:belongs-to,join:schedule_id=id"`
+	ExecutionID string          `bun:",nullzero,type:varchar(20)"`
+	Execution   *Execution      `bun:"rel:belongs-to,join:execution_id=id"`
+	Status      ExecutionStatus `bun:"type:varchar(255)"`
+	Message     string          `bun:"type:text"`
+	TriggeredAt time.Time       `bun:",nullzero"`
+	FinishedAt  time.Time       `bun:",nullzero"`
+	CreatedAt   time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time       `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// BeforeAppendModel implements the bun hook interface.
+func (m *ScheduleRun) BeforeAppendModel(_ context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		if m.ID == "" {
+			m.ID = strings.ToLower(uniuri.NewLen(uniuri.UUIDLen))
+		}
+
+		m.CreatedAt = time.Now()
+		m.UpdatedAt = time.Now()
+	case *bun.UpdateQuery:
+		if m.ID == "" {
+			m.ID = strings.ToLower(uniuri.NewLen(uniuri.UUIDLen))
+		}
+
+		m.UpdatedAt = time.Now()
+	}
+
+	return nil
+}
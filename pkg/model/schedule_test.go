@@ -0,0 +1,288 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2026, time.January, day, hour, minute, 0, 0, time.UTC)
+}
+
+func utc(ticks []time.Time) []time.Time {
+	result := make([]time.Time, 0, len(ticks))
+
+	for _, tick := range ticks {
+		result = append(result, tick.UTC())
+	}
+
+	return result
+}
+
+func TestScheduleNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		after    time.Time
+		count    int
+		expected []time.Time
+	}{
+		{
+			name:     "hourly",
+			schedule: &Schedule{Cron: "0 * * * *"},
+			after:    at(1, 10, 30),
+			count:    3,
+			expected: []time.Time{at(1, 11, 0), at(1, 12, 0), at(1, 13, 0)},
+		},
+		{
+			name:     "timezone",
+			schedule: &Schedule{Cron: "0 9 * * *", Timezone: "Europe/Berlin"},
+			after:    at(1, 0, 0),
+			count:    2,
+			expected: []time.Time{at(1, 8, 0), at(2, 8, 0)},
+		},
+		{
+			name:     "daylight saving",
+			schedule: &Schedule{Cron: "0 9 * * *", Timezone: "Europe/Berlin"},
+			after:    time.Date(2026, time.March, 28, 12, 0, 0, 0, time.UTC),
+			count:    1,
+			expected: []time.Time{time.Date(2026, time.March, 29, 7, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "unknown timezone",
+			schedule: &Schedule{Cron: "0 9 * * *", Timezone: "Mars/Olympus"},
+			after:    at(1, 0, 0),
+			count:    1,
+			expected: []time.Time{at(1, 9, 0)},
+		},
+		{
+			name:     "start date",
+			schedule: &Schedule{Cron: "0 * * * *", StartAt: at(1, 12, 0)},
+			after:    at(1, 10, 30),
+			count:    2,
+			expected: []time.Time{at(1, 12, 0), at(1, 13, 0)},
+		},
+		{
+			name:     "end date",
+			schedule: &Schedule{Cron: "0 * * * *", EndAt: at(1, 12, 30)},
+			after:    at(1, 10, 30),
+			count:    5,
+			expected: []time.Time{at(1, 11, 0), at(1, 12, 0)},
+		},
+		{
+			name:     "one-shot upcoming",
+			schedule: &Schedule{RunAt: at(1, 12, 0)},
+			after:    at(1, 10, 30),
+			count:    5,
+			expected: []time.Time{at(1, 12, 0)},
+		},
+		{
+			name:     "one-shot passed",
+			schedule: &Schedule{RunAt: at(1, 12, 0)},
+			after:    at(1, 12, 30),
+			count:    5,
+			expected: []time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utc(tt.schedule.Next(tt.after, tt.count)))
+		})
+	}
+}
+
+func TestSchedulePending(t *testing.T) {
+	grace := 2 * time.Minute
+
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		now      time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "on time",
+			schedule: &Schedule{Cron: "0 * * * *", CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 9, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{at(1, 10, 0)},
+		},
+		{
+			name:     "already triggered",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedOnce, CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 10, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{},
+		},
+		{
+			name:     "before creation",
+			schedule: &Schedule{Cron: "0 * * * *", CreatedAt: at(1, 10, 30)},
+			now:      at(1, 10, 31),
+			expected: []time.Time{},
+		},
+		{
+			name:     "missed with skip",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedSkip, CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 6, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{},
+		},
+		{
+			name:     "missed with once",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedOnce, CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 6, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{at(1, 10, 0)},
+		},
+		{
+			name:     "missed with all",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedAll, CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 7, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{at(1, 8, 0), at(1, 9, 0), at(1, 10, 0)},
+		},
+		{
+			name:     "timezone",
+			schedule: &Schedule{Cron: "0 9 * * *", Timezone: "Europe/Berlin", CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 8, 0)},
+			now:      at(2, 8, 1),
+			expected: []time.Time{at(2, 8, 0)},
+		},
+		{
+			name:     "start date ahead",
+			schedule: &Schedule{Cron: "0 * * * *", StartAt: at(1, 12, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{},
+		},
+		{
+			name:     "end date with skip",
+			schedule: &Schedule{Cron: "0 * * * *", EndAt: at(1, 9, 30), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 8, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{},
+		},
+		{
+			name:     "end date with once",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedOnce, EndAt: at(1, 9, 30), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 8, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{at(1, 9, 0)},
+		},
+		{
+			name:     "one-shot on time",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{at(1, 10, 0)},
+		},
+		{
+			name:     "one-shot upcoming",
+			schedule: &Schedule{RunAt: at(1, 12, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{},
+		},
+		{
+			name:     "one-shot triggered",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 10, 0)},
+			now:      at(1, 10, 1),
+			expected: []time.Time{},
+		},
+		{
+			name:     "one-shot missed with skip",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{},
+		},
+		{
+			name:     "one-shot missed with once",
+			schedule: &Schedule{RunAt: at(1, 10, 0), Missed: ScheduleMissedOnce, CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 30),
+			expected: []time.Time{at(1, 10, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utc(tt.schedule.Pending(tt.now, grace)))
+		})
+	}
+}
+
+func TestSchedulePendingLimit(t *testing.T) {
+	schedule := &Schedule{
+		Cron:      "0 * * * *",
+		Missed:    ScheduleMissedAll,
+		CreatedAt: at(1, 0, 0),
+	}
+
+	pending := schedule.Pending(at(10, 0, 30), 2*time.Minute)
+
+	assert.Len(t, pending, ScheduleMissedLimit)
+	assert.Equal(t, at(1, 1, 0), pending[0].UTC())
+}
+
+func TestScheduleExpired(t *testing.T) {
+	grace := 2 * time.Minute
+
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "recurring",
+			schedule: &Schedule{Cron: "0 * * * *", CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 10, 0)},
+			now:      at(1, 10, 30),
+			expected: false,
+		},
+		{
+			name:     "end date upcoming",
+			schedule: &Schedule{Cron: "0 * * * *", EndAt: at(1, 12, 30), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 10, 0)},
+			now:      at(1, 10, 30),
+			expected: false,
+		},
+		{
+			name:     "end date reached",
+			schedule: &Schedule{Cron: "0 * * * *", EndAt: at(1, 9, 30), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 9, 0)},
+			now:      at(1, 10, 30),
+			expected: true,
+		},
+		{
+			name:     "end date reached with pending runs",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedAll, EndAt: at(1, 9, 30), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 7, 0)},
+			now:      at(1, 10, 30),
+			expected: false,
+		},
+		{
+			name:     "end date reached beyond limit",
+			schedule: &Schedule{Cron: "0 * * * *", Missed: ScheduleMissedAll, EndAt: at(9, 0, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(10, 0, 30),
+			expected: false,
+		},
+		{
+			name:     "one-shot upcoming",
+			schedule: &Schedule{RunAt: at(1, 12, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 30),
+			expected: false,
+		},
+		{
+			name:     "one-shot pending",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 1),
+			expected: false,
+		},
+		{
+			name:     "one-shot triggered",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0), TriggeredAt: at(1, 10, 0)},
+			now:      at(1, 10, 1),
+			expected: true,
+		},
+		{
+			name:     "one-shot missed",
+			schedule: &Schedule{RunAt: at(1, 10, 0), CreatedAt: at(1, 0, 0)},
+			now:      at(1, 10, 30),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.schedule.Expired(tt.now, grace))
+		})
+	}
+}
@@ -34,7 +34,13 @@ type Template struct {
 	Limit         string            `bun:"type:varchar(255)"`
 	Executor      string            `bun:"type:varchar(255)"`
 	Branch        string            `bun:"type:varchar(255)"`
+	Image         string            `bun:"type:varchar(255)"`
+	Labels        Labels            `bun:"type:text"`
 	Override      bool              `bun:"type:bool"`
+	Sequential    bool              `bun:"type:bool"`
+	RetryAttempts int               `bun:"type:integer"`
+	RetryBackoff  int               `bun:"type:integer"`
+	RetryCodes    ExitCodes         `bun:"type:text"`
 	CreatedAt     time.Time         `bun:",nullzero,notnull,default:current_timestamp"`
 	UpdatedAt     time.Time         `bun:",nullzero,notnull,default:current_timestamp"`
 	Surveys       []*TemplateSurvey `bun:"rel:has-many,join:id=template_id"`
@@ -62,6 +68,18 @@ func (m *Template) BeforeAppendModel(_ context.Context, query bun.Query) error {
 	return nil
 }
 
+// RetryDelay calculates the delay before the next attempt of an execution,
+// the backoff gets doubled for every attempt.
+func (m *Template) RetryDelay(attempt int) time.Duration {
+	delay := time.Duration(m.RetryBackoff) * time.Second
+
+	for i := 1; i < attempt && delay < time.Hour; i++ {
+		delay *= 2
+	}
+
+	return min(delay, time.Hour)
+}
+
 // SerializeSecret ensures to encrypt all related secrets stored on the database.
 func (m *Template) SerializeSecret(passphrase string) error {
 	if m.Repository != nil {
@@ -256,6 +256,10 @@ func Server(
 								r.With(apiv1.AllowShowProjectExecution).Get("/", wrapper.ShowProjectExecution)
 								r.With(apiv1.AllowManageProjectExecution).Delete("/", wrapper.DeleteProjectExecution)
 								r.With(apiv1.AllowManageProjectExecution).Get("/purge", wrapper.PurgeProjectExecution)
+								r.With(apiv1.AllowManageProjectExecution).Post("/approve", wrapper.ApproveProjectExecution)
+								r.With(apiv1.AllowManageProjectExecution).Post("/reject", wrapper.RejectProjectExecution)
+								r.With(apiv1.AllowManageProjectExecution).Post("/stop", wrapper.StopProjectExecution)
+								r.With(apiv1.AllowManageProjectExecution).Post("/rerun", wrapper.RerunProjectExecution)
 								r.With(apiv1.AllowShowProjectExecution).Get("/output", wrapper.OutputProjectExecution)
 							})
 						})
@@ -272,6 +276,7 @@ func Server(
 								r.With(apiv1.AllowShowProjectSchedule).Get("/", wrapper.ShowProjectSchedule)
 								r.With(apiv1.AllowManageProjectSchedule).Delete("/", wrapper.DeleteProjectSchedule)
 								r.With(apiv1.AllowManageProjectSchedule).Put("/", wrapper.UpdateProjectSchedule)
+								r.With(apiv1.AllowShowProjectSchedule).Get("/runs", wrapper.ListProjectScheduleRuns)
 							})
 						})
 
@@ -287,6 +292,21 @@ func Server(
 								r.With(apiv1.AllowShowProjectRunner).Get("/", wrapper.ShowProjectRunner)
 								r.With(apiv1.AllowManageProjectRunner).Delete("/", wrapper.DeleteProjectRunner)
 								r.With(apiv1.AllowManageProjectRunner).Put("/", wrapper.UpdateProjectRunner)
+								r.With(apiv1.AllowManageProjectRunner).Post("/rotate", wrapper.RotateProjectRunner)
+								r.With(apiv1.AllowManageProjectRunner).Post("/revoke", wrapper.RevokeProjectRunner)
+							})
+						})
+
+						r.Route("/joins", func(r chi.Router) {
+							r.Use(apiv1.AllowManageProjectRunner)
+
+							r.Get("/", wrapper.ListProjectJoins)
+							r.Post("/", wrapper.CreateProjectJoin)
+
+							r.Route("/{join_id}", func(r chi.Router) {
+								r.Use(apiv1.ProjectJoinToContext)
+
+								r.Delete("/", wrapper.DeleteProjectJoin)
 							})
 						})
 
@@ -431,6 +451,39 @@ func Server(
 						r.Get("/", wrapper.ShowGlobalRunner)
 						r.Delete("/", wrapper.DeleteGlobalRunner)
 						r.Put("/", wrapper.UpdateGlobalRunner)
+						r.Post("/rotate", wrapper.RotateGlobalRunner)
+						r.Post("/revoke", wrapper.RevokeGlobalRunner)
+					})
+				})
+
+				r.Route("/joins", func(r chi.Router) {
+					r.Use(apiv1.AllowAdminAccessOnly)
+
+					r.Get("/", wrapper.ListGlobalJoins)
+					r.Post("/", wrapper.CreateGlobalJoin)
+
+					r.Route("/{join_id}", func(r chi.Router) {
+						r.Use(apiv1.GlobalJoinToContext)
+
+						r.Delete("/", wrapper.DeleteGlobalJoin)
+					})
+				})
+
+				r.Route("/runner", func(r chi.Router) {
+					r.Post("/join", wrapper.JoinRunner)
+					r.Post("/register", wrapper.RegisterRunner)
+					r.Post("/heartbeat", wrapper.HeartbeatRunner)
+
+					r.Route("/executions", func(r chi.Router) {
+						r.Post("/", wrapper.ClaimRunnerExecution)
+
+						r.Route("/{execution_id}", func(r chi.Router) {
+							r.Use(apiv1.RunnerExecutionToContext)
+
+							r.Get("/", wrapper.ShowRunnerExecution)
+							r.Put("/", wrapper.UpdateRunnerExecution)
+							r.Post("/output", wrapper.CreateRunnerOutput)
+						})
 					})
 				})
 
@@ -0,0 +1,134 @@
+package runner
+
+import (
+	"time"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Server     string
+	Token      string
+	JoinToken  string
+	Identity   string
+	Interval   time.Duration
+	Wait       time.Duration
+	Heartbeat  time.Duration
+	Interrupt  time.Duration
+	Terminate  time.Duration
+	Workspace  string
+	KeepFailed bool
+	Runtime    string
+	Capacity   int
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Interval:  5 * time.Second,
+		Wait:      30 * time.Second,
+		Heartbeat: 30 * time.Second,
+		Interrupt: 10 * time.Second,
+		Terminate: 10 * time.Second,
+		Capacity:  1,
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// WithServer provides a function to set the server option.
+func WithServer(v string) Option {
+	return func(o *Options) {
+		o.Server = v
+	}
+}
+
+// WithToken provides a function to set the token option.
+func WithToken(v string) Option {
+	return func(o *Options) {
+		o.Token = v
+	}
+}
+
+// WithJoinToken provides a function to set the join token option.
+func WithJoinToken(v string) Option {
+	return func(o *Options) {
+		o.JoinToken = v
+	}
+}
+
+// WithIdentity provides a function to set the identity option.
+func WithIdentity(v string) Option {
+	return func(o *Options) {
+		o.Identity = v
+	}
+}
+
+// WithInterval provides a function to set the interval option.
+func WithInterval(v time.Duration) Option {
+	return func(o *Options) {
+		o.Interval = v
+	}
+}
+
+// WithWait provides a function to set the wait option.
+func WithWait(v time.Duration) Option {
+	return func(o *Options) {
+		o.Wait = v
+	}
+}
+
+// WithHeartbeat provides a function to set the heartbeat option.
+func WithHeartbeat(v time.Duration) Option {
+	return func(o *Options) {
+		o.Heartbeat = v
+	}
+}
+
+// WithInterrupt provides a function to set the interrupt option.
+func WithInterrupt(v time.Duration) Option {
+	return func(o *Options) {
+		o.Interrupt = v
+	}
+}
+
+// WithTerminate provides a function to set the terminate option.
+func WithTerminate(v time.Duration) Option {
+	return func(o *Options) {
+		o.Terminate = v
+	}
+}
+
+// WithWorkspace provides a function to set the workspace option.
+func WithWorkspace(v string) Option {
+	return func(o *Options) {
+		o.Workspace = v
+	}
+}
+
+// WithKeepFailed provides a function to set the keep failed option.
+func WithKeepFailed(v bool) Option {
+	return func(o *Options) {
+		o.KeepFailed = v
+	}
+}
+
+// WithRuntime provides a function to set the runtime option.
+func WithRuntime(v string) Option {
+	return func(o *Options) {
+		o.Runtime = v
+	}
+}
+
+// WithCapacity provides a function to set the capacity option.
+func WithCapacity(v int) Option {
+	return func(o *Options) {
+		o.Capacity = v
+	}
+}
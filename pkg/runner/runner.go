@@ -0,0 +1,816 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/gexec/gexec/pkg/api/v1"
+	"github.com/gexec/gexec/pkg/executor"
+	"github.com/gexec/gexec/pkg/model"
+	"github.com/gexec/gexec/pkg/version"
+	"github.com/gexec/gexec/pkg/workspace"
+)
+
+var (
+	// ErrMissingServer defines the error if the server address is missing.
+	ErrMissingServer = errors.New("missing server address")
+
+	// ErrMissingToken defines the error if the runner token is missing.
+	ErrMissingToken = errors.New("missing runner token")
+
+	// ErrUnauthorized defines the error if the server rejects the runner.
+	ErrUnauthorized = errors.New("runner is not authorized")
+
+	// ErrInvalidJoinToken defines the error if the server rejects the join token.
+	ErrInvalidJoinToken = errors.New("invalid or expired join token")
+
+	// ErrUnknownServerResponse defines the error for unknown server responses.
+	ErrUnknownServerResponse = errors.New("unknown response from api")
+
+	// ErrStopRequested defines the cause if an execution should be stopped.
+	ErrStopRequested = errors.New("stop requested")
+
+	// ErrConflict defines the cause if an execution is not held by the runner anymore.
+	ErrConflict = errors.New("execution is not held by the runner")
+)
+
+const (
+	// outputInterval defines the interval to stream output to the server.
+	outputInterval = time.Second
+
+	// reportTimeout defines the timeout to report the final state of an
+	// execution, even if the runner is shutting down.
+	reportTimeout = 30 * time.Second
+
+	// reportBackoff defines the maximum delay between retries to report the
+	// state of an execution.
+	reportBackoff = 30 * time.Second
+)
+
+// Runner claims waiting executions from the server and executes them.
+type Runner struct {
+	options   Options
+	token     string
+	restored  bool
+	client    *v1.ClientWithResponses
+	workspace *workspace.Manager
+	running   map[string]context.CancelCauseFunc
+	mutex     sync.Mutex
+	auth      sync.RWMutex
+}
+
+// New initializes a new runner connected to the server.
+func New(opts ...Option) (*Runner, error) {
+	options := newOptions(opts...)
+
+	if options.Server == "" {
+		return nil, ErrMissingServer
+	}
+
+	if options.Token == "" && options.JoinToken == "" {
+		return nil, ErrMissingToken
+	}
+
+	if options.Identity == "" {
+		options.Identity = defaultIdentity()
+	}
+
+	server, err := url.JoinPath(
+		options.Server,
+		"api",
+		"v1",
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid server address: %w", err)
+	}
+
+	r := &Runner{
+		options: options,
+		running: make(map[string]context.CancelCauseFunc),
+	}
+
+	// The token gets read lazily as it gets joined or rotated later on.
+	client, err := v1.NewClientWithResponses(
+		server,
+		v1.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			if token := r.credential(); token != "" {
+				req.Header.Set(
+					"X-Runner-Token",
+					token,
+				)
+			}
+
+			return nil
+		}),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize client: %w", err)
+	}
+
+	manager, err := workspace.New(
+		workspace.WithRoot(options.Workspace),
+		workspace.WithKeepFailed(options.KeepFailed),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize workspace: %w", err)
+	}
+
+	r.client = client
+	r.workspace = manager
+
+	return r, nil
+}
+
+// Run registers the runner and starts the loop to claim and execute
+// executions until the context gets canceled.
+func (r *Runner) Run(ctx context.Context) error {
+	if err := r.workspace.Prune(); err != nil {
+		return err
+	}
+
+	if err := r.identify(ctx); err != nil {
+		return err
+	}
+
+	err := r.register(ctx)
+
+	if errors.Is(err, ErrUnauthorized) && r.restored && (r.options.Token != "" || r.options.JoinToken != "") {
+		slog.Warn(
+			"Stored identity got rejected, falling back to configured token",
+			slog.String("identity", r.options.Identity),
+		)
+
+		if err := os.Remove(r.options.Identity); err != nil {
+			return fmt.Errorf("failed to remove identity: %w", err)
+		}
+
+		r.restored = false
+		r.authenticate("")
+
+		if err := r.identify(ctx); err != nil {
+			return err
+		}
+
+		err = r.register(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	go r.heartbeat(ctx)
+
+	slots := make(chan struct{}, max(r.options.Capacity, 1))
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case slots <- struct{}{}:
+		}
+
+		execution, err := r.next(ctx)
+
+		if err == nil && execution != nil {
+			wg.Go(func() {
+				defer func() { <-slots }()
+
+				if err := r.execute(ctx, execution); err != nil {
+					slog.Error(
+						"Failed to process execution",
+						slog.Any("error", err),
+					)
+				}
+			})
+
+			continue
+		}
+
+		<-slots
+
+		if err != nil {
+			slog.Error(
+				"Failed to claim execution",
+				slog.Any("error", err),
+			)
+		}
+
+		if err == nil && r.options.Wait > 0 {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.options.Interval):
+		}
+	}
+}
+
+// identify resolves the token of the runner. A token stored within the
+// identity file takes precedence as it has been joined or rotated before,
+// otherwise the configured token gets used or the join token gets exchanged.
+func (r *Runner) identify(ctx context.Context) error {
+	if content, err := os.ReadFile(r.options.Identity); err == nil {
+		if token := strings.TrimSpace(string(content)); token != "" {
+			r.restored = true
+			r.authenticate(token)
+
+			return nil
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read identity: %w", err)
+	}
+
+	if r.options.Token != "" {
+		r.authenticate(r.options.Token)
+		return nil
+	}
+
+	return r.join(ctx)
+}
+
+// join exchanges the join token for a persistent runner token. The token gets
+// stored within the identity file, so restarts keep the same runner.
+func (r *Runner) join(ctx context.Context) error {
+	for {
+		resp, err := r.client.JoinRunnerWithResponse(
+			ctx,
+			v1.JoinRunnerJSONRequestBody{
+				Token: r.options.JoinToken,
+			},
+		)
+
+		if err == nil {
+			switch resp.StatusCode() {
+			case http.StatusOK:
+				token := v1.FromPtr(resp.JSON200.Token)
+
+				if err := r.persist(token); err != nil {
+					return err
+				}
+
+				slog.Info(
+					"Joined runner",
+					slog.String("id", v1.FromPtr(resp.JSON200.ID)),
+					slog.String("name", v1.FromPtr(resp.JSON200.Name)),
+					slog.String("identity", r.options.Identity),
+				)
+
+				r.authenticate(token)
+				return nil
+			case http.StatusForbidden, http.StatusUnprocessableEntity:
+				return ErrInvalidJoinToken
+			default:
+				err = ErrUnknownServerResponse
+			}
+		}
+
+		slog.Error(
+			"Failed to join runner",
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.options.Interval):
+		}
+	}
+}
+
+func (r *Runner) register(ctx context.Context) error {
+	hostname, _ := os.Hostname()
+
+	for {
+		resp, err := r.client.RegisterRunnerWithResponse(
+			ctx,
+			v1.RegisterRunnerJSONRequestBody{
+				Capacity: v1.ToPtr(r.options.Capacity),
+				Version:  v1.ToPtr(version.String),
+				Hostname: v1.ToPtr(hostname),
+				OS:       v1.ToPtr(runtime.GOOS),
+				Arch:     v1.ToPtr(runtime.GOARCH),
+			},
+		)
+
+		if err == nil {
+			switch resp.StatusCode() {
+			case http.StatusOK:
+				slog.Info(
+					"Registered runner",
+					slog.String("id", v1.FromPtr(resp.JSON200.ID)),
+					slog.String("name", v1.FromPtr(resp.JSON200.Name)),
+				)
+
+				return nil
+			case http.StatusUnauthorized, http.StatusForbidden:
+				return ErrUnauthorized
+			default:
+				err = ErrUnknownServerResponse
+			}
+		}
+
+		slog.Error(
+			"Failed to register runner",
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.options.Interval):
+		}
+	}
+}
+
+func (r *Runner) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(r.options.Heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		resp, err := r.client.HeartbeatRunnerWithResponse(ctx)
+
+		if err == nil && resp.StatusCode() != http.StatusOK {
+			err = ErrUnknownServerResponse
+		}
+
+		if err != nil {
+			slog.Error(
+				"Failed to send heartbeat",
+				slog.Any("error", err),
+			)
+
+			continue
+		}
+
+		if token := v1.FromPtr(resp.JSON200.Token); token != "" && token != r.credential() {
+			r.rotate(token)
+		}
+
+		for _, id := range resp.JSON200.Stop {
+			r.stop(id)
+		}
+	}
+}
+
+// rotate switches to the rotated token provided by the server and stores it
+// within the identity file to survive restarts after the grace period.
+func (r *Runner) rotate(token string) {
+	r.authenticate(token)
+
+	if err := r.persist(token); err != nil {
+		slog.Error(
+			"Failed to store rotated token",
+			slog.Any("error", err),
+		)
+
+		return
+	}
+
+	slog.Info(
+		"Rotated runner token",
+		slog.String("identity", r.options.Identity),
+	)
+}
+
+func (r *Runner) persist(token string) error {
+	if err := os.MkdirAll(filepath.Dir(r.options.Identity), 0o700); err != nil {
+		return fmt.Errorf("failed to create identity directory: %w", err)
+	}
+
+	if err := os.WriteFile(r.options.Identity, []byte(token), 0o600); err != nil {
+		return fmt.Errorf("failed to write identity: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Runner) credential() string {
+	r.auth.RLock()
+	defer r.auth.RUnlock()
+
+	return r.token
+}
+
+func (r *Runner) authenticate(token string) {
+	r.auth.Lock()
+	defer r.auth.Unlock()
+
+	r.token = token
+}
+
+func (r *Runner) stop(id string) {
+	r.abort(id, ErrStopRequested)
+}
+
+func (r *Runner) abort(id string, cause error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if cancel, ok := r.running[id]; ok {
+		slog.Info(
+			"Stopping execution",
+			slog.String("execution", id),
+			slog.String("cause", cause.Error()),
+		)
+
+		cancel(cause)
+	}
+}
+
+func (r *Runner) next(ctx context.Context) (*v1.Execution, error) {
+	resp, err := r.client.ClaimRunnerExecutionWithResponse(
+		ctx,
+		&v1.ClaimRunnerExecutionParams{
+			Wait: v1.ToPtr(int(r.options.Wait.Seconds())),
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, ErrUnauthorized
+	default:
+		return nil, ErrUnknownServerResponse
+	}
+}
+
+func (r *Runner) execute(ctx context.Context, execution *v1.Execution) error {
+	id := v1.FromPtr(execution.ID)
+
+	logger := slog.With(
+		slog.String("execution", id),
+	)
+
+	logger.Info(
+		"Starting execution",
+	)
+
+	// Register the execution upfront, stop requests could arrive while it
+	// is still starting.
+	running, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	r.mutex.Lock()
+	r.running[id] = cancel
+	r.mutex.Unlock()
+
+	defer func() {
+		r.mutex.Lock()
+		delete(r.running, id)
+		r.mutex.Unlock()
+	}()
+
+	if err := r.deliver(ctx, func(ctx context.Context) error {
+		return r.status(ctx, id, model.ExecutionStatusRunning)
+	}); err != nil {
+		if errors.Is(err, ErrConflict) {
+			logger.Warn(
+				"Execution is not held by the runner anymore",
+			)
+
+			return nil
+		}
+
+		if errors.Is(err, ErrStopRequested) {
+			logger.Info(
+				"Execution has been stopped before it started",
+			)
+
+			report, done := context.WithTimeout(context.WithoutCancel(ctx), reportTimeout)
+			defer done()
+
+			return r.finish(report, id, model.ExecutionStatusStopped, &executor.Result{})
+		}
+
+		return err
+	}
+
+	output := newStream(ctx, outputInterval, func(ctx context.Context, content string) error {
+		return r.output(ctx, id, content)
+	})
+
+	status := model.ExecutionStatusSuccess
+	result := &executor.Result{}
+	ws, err := r.workspace.Create(id)
+
+	if err == nil {
+		defer func() {
+			if err := ws.Close(status != model.ExecutionStatusSuccess); err != nil {
+				logger.Error(
+					"Failed to cleanup workspace",
+					slog.Any("error", err),
+				)
+			}
+		}()
+
+		var handler executor.Executor
+
+		handler, err = executor.New(
+			execution,
+			executor.WithConfirm(r.confirm(id)),
+			executor.WithRuntime(r.options.Runtime),
+			executor.WithInterrupt(r.options.Interrupt),
+			executor.WithTerminate(r.options.Terminate),
+		)
+
+		if err == nil {
+			result, err = handler.Execute(running, execution, ws, output)
+		}
+	}
+
+	if result == nil {
+		result = &executor.Result{}
+	}
+
+	// The final state must be reported even if the runner is shutting down.
+	report, done := context.WithTimeout(context.WithoutCancel(ctx), reportTimeout)
+	defer done()
+
+	if errors.Is(err, executor.ErrRejected) {
+		if err := output.Close(report); err != nil {
+			logger.Error(
+				"Failed to report output",
+				slog.Any("error", err),
+			)
+		}
+
+		logger.Info(
+			"Execution got rejected",
+		)
+
+		return nil
+	}
+
+	if errors.Is(context.Cause(running), ErrConflict) {
+		if err := output.Close(report); err != nil {
+			logger.Error(
+				"Failed to report output",
+				slog.Any("error", err),
+			)
+		}
+
+		logger.Warn(
+			"Execution is not held by the runner anymore",
+		)
+
+		return nil
+	}
+
+	if errors.Is(context.Cause(running), ErrStopRequested) {
+		fmt.Fprintln(output, "Execution has been stopped")
+		status = model.ExecutionStatusStopped
+	} else if err != nil {
+		logger.Error(
+			"Execution failed",
+			slog.Any("error", err),
+		)
+
+		fmt.Fprintf(output, "Error: %s\n", err)
+		status = model.ExecutionStatusFailure
+
+		var exitErr *exec.ExitError
+
+		if errors.As(err, &exitErr) {
+			result.ExitCode = exitErr.ExitCode()
+		}
+
+		if result.ExitCode == 0 {
+			result.ExitCode = 1
+		}
+	}
+
+	if err := output.Close(report); err != nil {
+		logger.Error(
+			"Failed to report output",
+			slog.Any("error", err),
+		)
+	}
+
+	logger.Info(
+		"Finished execution",
+		slog.String("status", string(status)),
+	)
+
+	return r.finish(report, id, status, result)
+}
+
+// confirm reports that the execution waits for a confirmation and polls the
+// server until somebody has approved or rejected it.
+func (r *Runner) confirm(id string) executor.ConfirmFunc {
+	return func(ctx context.Context) (bool, error) {
+		if err := r.status(ctx, id, model.ExecutionStatusConfirm); err != nil {
+			return false, err
+		}
+
+		ticker := time.NewTicker(r.options.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-ticker.C:
+			}
+
+			resp, err := r.client.ShowRunnerExecutionWithResponse(
+				ctx,
+				id,
+			)
+
+			if err != nil {
+				return false, err
+			}
+
+			switch resp.StatusCode() {
+			case http.StatusOK:
+			case http.StatusUnauthorized, http.StatusForbidden:
+				return false, ErrUnauthorized
+			default:
+				return false, ErrUnknownServerResponse
+			}
+
+			switch model.ExecutionStatus(v1.FromPtr(resp.JSON200.Status)) {
+			case model.ExecutionStatusConfirmed:
+				return true, r.status(ctx, id, model.ExecutionStatusRunning)
+			case model.ExecutionStatusRejected:
+				return false, nil
+			}
+		}
+	}
+}
+
+func (r *Runner) status(ctx context.Context, id string, status model.ExecutionStatus) error {
+	return r.update(ctx, id, v1.UpdateRunnerExecutionJSONRequestBody{
+		Status: string(status),
+	})
+}
+
+func (r *Runner) finish(ctx context.Context, id string, status model.ExecutionStatus, result *executor.Result) error {
+	body := v1.UpdateRunnerExecutionJSONRequestBody{
+		Status:   string(status),
+		ExitCode: v1.ToPtr(result.ExitCode),
+	}
+
+	if result.CommitSHA != "" {
+		body.CommitSHA = v1.ToPtr(result.CommitSHA)
+	}
+
+	return r.deliver(ctx, func(ctx context.Context) error {
+		return r.update(ctx, id, body)
+	})
+}
+
+// deliver retries to report the state of an execution with a backoff until
+// it succeeds, the server rejects it or the context gets canceled.
+func (r *Runner) deliver(ctx context.Context, report func(context.Context) error) error {
+	delay := time.Second
+
+	for {
+		err := report(ctx)
+
+		if err == nil || errors.Is(err, ErrConflict) || errors.Is(err, ErrStopRequested) || errors.Is(err, ErrUnauthorized) {
+			return err
+		}
+
+		slog.Warn(
+			"Failed to report execution, retrying",
+			slog.Any("error", err),
+			slog.Duration("delay", delay),
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, reportBackoff)
+	}
+}
+
+func (r *Runner) update(ctx context.Context, id string, body v1.UpdateRunnerExecutionJSONRequestBody) error {
+	resp, err := r.client.UpdateRunnerExecutionWithResponse(
+		ctx,
+		id,
+		body,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		cause := r.conflict(ctx, id)
+
+		if errors.Is(cause, ErrConflict) || errors.Is(cause, ErrStopRequested) {
+			r.abort(id, cause)
+		}
+
+		return cause
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	default:
+		return ErrUnknownServerResponse
+	}
+}
+
+// conflict resolves why an update got rejected, the execution either got
+// requested to stop or it is not held by the runner anymore.
+func (r *Runner) conflict(ctx context.Context, id string) error {
+	resp, err := r.client.ShowRunnerExecutionWithResponse(
+		ctx,
+		id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if model.ExecutionStatus(v1.FromPtr(resp.JSON200.Status)) == model.ExecutionStatusStopping {
+			return ErrStopRequested
+		}
+
+		return ErrConflict
+	case http.StatusNotFound:
+		return ErrConflict
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	default:
+		return ErrUnknownServerResponse
+	}
+}
+
+func (r *Runner) output(ctx context.Context, id, content string) error {
+	resp, err := r.client.CreateRunnerOutputWithResponse(
+		ctx,
+		id,
+		v1.CreateRunnerOutputJSONRequestBody{
+			Content: content,
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	default:
+		return ErrUnknownServerResponse
+	}
+}
+
+// defaultIdentity resolves the default location to persist the token of a
+// joined runner, it must not be within the workspace as that gets pruned.
+func defaultIdentity() string {
+	dir, err := os.UserConfigDir()
+
+	if err != nil {
+		return filepath.Join(os.TempDir(), "gexec-runner.token")
+	}
+
+	return filepath.Join(dir, "gexec", "runner.token")
+}
@@ -0,0 +1,86 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// stream buffers the output of an execution and sends it to the server in
+// chunks, so the output is visible while the execution is still running.
+type stream struct {
+	ctx      context.Context
+	send     func(context.Context, string) error
+	interval time.Duration
+	buffer   bytes.Buffer
+	mutex    sync.Mutex
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// newStream initializes a new stream and starts the periodic flushing.
+func newStream(ctx context.Context, interval time.Duration, send func(context.Context, string) error) *stream {
+	s := &stream{
+		ctx:      ctx,
+		send:     send,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+
+	s.wg.Add(1)
+	go s.loop()
+
+	return s
+}
+
+// Write implements the io.Writer interface.
+func (s *stream) Write(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.buffer.Write(p)
+}
+
+// Close stops the periodic flushing and sends the remaining output.
+func (s *stream) Close(ctx context.Context) error {
+	close(s.done)
+	s.wg.Wait()
+
+	return s.flush(ctx)
+}
+
+func (s *stream) loop() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.flush(s.ctx); err != nil {
+				slog.Error(
+					"Failed to stream output",
+					slog.Any("error", err),
+				)
+			}
+		}
+	}
+}
+
+func (s *stream) flush(ctx context.Context) error {
+	s.mutex.Lock()
+	content := s.buffer.String()
+	s.buffer.Reset()
+	s.mutex.Unlock()
+
+	if content == "" {
+		return nil
+	}
+
+	return s.send(ctx, content)
+}
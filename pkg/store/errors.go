@@ -26,6 +26,9 @@ var (
 	// ErrRunnerNotFound is returned when a runner was not found.
 	ErrRunnerNotFound = errors.New("runner not found")
 
+	// ErrJoinTokenNotFound is returned when a join token was not found.
+	ErrJoinTokenNotFound = errors.New("join token not found")
+
 	// ErrCredentialNotFound is returned when a credential was not found.
 	ErrCredentialNotFound = errors.New("credential not found")
 
@@ -62,6 +65,21 @@ var (
 	// ErrExecutionNotFound is returned when a execution was not found.
 	ErrExecutionNotFound = errors.New("execution not found")
 
+	// ErrExecutionConflict is returned when a execution is not held by the runner anymore.
+	ErrExecutionConflict = errors.New("execution is not held by the runner")
+
+	// ErrExecutionNotConfirm is returned when a execution does not await a confirmation.
+	ErrExecutionNotConfirm = errors.New("execution does not await confirmation")
+
+	// ErrExecutionNotRunning is returned when a execution is not running.
+	ErrExecutionNotRunning = errors.New("execution is not running")
+
+	// ErrExecutionNotOverridable is returned when a template does not allow overrides.
+	ErrExecutionNotOverridable = errors.New("overrides are not allowed by the template")
+
+	// ErrExecutionUnknownAnswer is returned when an answer is not part of the survey.
+	ErrExecutionUnknownAnswer = errors.New("is not part of the survey")
+
 	// ErrTokenNotFound is returned when a token was not found.
 	ErrTokenNotFound = errors.New("token not found")
 )
@@ -3,12 +3,43 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gexec/gexec/pkg/model"
 	"github.com/gexec/gexec/pkg/validate"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+const (
+	// claimCandidates defines the number of waiting executions to try per page.
+	claimCandidates = 10
+
+	// claimPages defines the maximum number of candidate pages per claim.
+	claimPages = 5
+
+	// claimPreference defines how long executions are reserved for project runners.
+	claimPreference = 30 * time.Second
+
+	// runnerActive defines how long a runner counts as active after a heartbeat.
+	runnerActive = 2 * time.Minute
+)
+
+var (
+	// activeStatus defines the status of executions occupying a runner.
+	activeStatus = []model.ExecutionStatus{
+		model.ExecutionStatusStarting,
+		model.ExecutionStatusRunning,
+		model.ExecutionStatusConfirm,
+		model.ExecutionStatusConfirmed,
+		model.ExecutionStatusStopping,
+	}
 )
 
 // Executions provides all database operations related to executions.
@@ -23,6 +54,7 @@ func (s *Executions) List(ctx context.Context, projectID string, params model.Li
 	q := s.client.handle.NewSelect().
 		Model(&records).
 		Relation("Template").
+		Relation("Template.Surveys").
 		Where("execution.project_id = ?", projectID)
 
 	if val, ok := s.validSort(params.Sort); ok {
@@ -67,6 +99,8 @@ func (s *Executions) Show(ctx context.Context, project *model.Project, name stri
 	q := s.client.handle.NewSelect().
 		Model(record).
 		Relation("Template").
+		Relation("Template.Surveys").
+		Relation("Runner").
 		Where("execution.project_id = ?", project.ID).
 		Where("execution.id = ?", name)
 
@@ -217,6 +251,72 @@ func (s *Executions) Purge(ctx context.Context, project *model.Project, name str
 	return nil
 }
 
+// Approve implements the approval of an execution awaiting confirmation.
+func (s *Executions) Approve(ctx context.Context, project *model.Project, name string) (*model.Execution, error) {
+	return s.confirm(
+		ctx,
+		project,
+		name,
+		model.ExecutionStatusConfirmed,
+		model.EventActionApprove,
+	)
+}
+
+// Reject implements the rejection of an execution awaiting confirmation.
+func (s *Executions) Reject(ctx context.Context, project *model.Project, name string) (*model.Execution, error) {
+	return s.confirm(
+		ctx,
+		project,
+		name,
+		model.ExecutionStatusRejected,
+		model.EventActionReject,
+	)
+}
+
+// Rerun implements the creation of a new execution based on a previous one,
+// the template, overrides and answers are taken over from the parent.
+func (s *Executions) Rerun(ctx context.Context, project *model.Project, name string) (*model.Execution, error) {
+	parent, err := s.Show(ctx, project, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	record := parent.Derive()
+	record.Priority = model.ExecutionPriorityManual
+
+	if err := s.validate(ctx, record, false); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(record).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeExecution,
+				Action:         model.EventActionRerun,
+				Attrs: map[string]interface{}{
+					"parent": parent.ID,
+				},
+			},
+		)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.Show(ctx, project, record.ID)
+}
+
 // Outputs implements the output of the logg for an execution.
 func (s *Executions) Outputs(ctx context.Context, _ *model.Project, execution *model.Execution) ([]*model.Output, error) {
 	records := make([]*model.Output, 0)
@@ -233,6 +333,689 @@ func (s *Executions) Outputs(ctx context.Context, _ *model.Project, execution *m
 	return records, nil
 }
 
+// Position implements the approximate position of a waiting execution within
+// the queue, executions with a higher priority or an older age come first.
+// The fairness between projects is ignored as it changes with every claim.
+func (s *Executions) Position(ctx context.Context, record *model.Execution) (int, error) {
+	if record.Status != model.ExecutionStatusWaiting {
+		return 0, nil
+	}
+
+	ahead, err := s.client.handle.NewSelect().
+		Model((*model.Execution)(nil)).
+		Where("status = ?", model.ExecutionStatusWaiting).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("priority > ?", record.Priority).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.
+						Where("priority = ?", record.Priority).
+						Where("created_at < ?", record.CreatedAt)
+				})
+		}).
+		Count(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return ahead + 1, nil
+}
+
+// Claim implements the atomic claim of the next waiting execution. The update
+// only matches executions which are still waiting, that way concurrent runners
+// can never claim the same execution, independent of the database driver.
+// Only executions where the runner satisfies the labels of the template are
+// claimed, and executions are reserved for a while if an active project
+// runner is able to handle them. Runners never exceed their capacity and
+// sequential templates only get claimed if no other execution is active.
+// Executions with a higher priority are claimed first, within the same
+// priority projects with less active executions are preferred, that way a
+// single project is not able to starve all others.
+func (s *Executions) Claim(ctx context.Context, runner *model.Runner) (*model.Execution, error) {
+	// Skip the lookup of candidates early, the capacity gets enforced again
+	// while claiming as concurrent claims of the runner may pass this check.
+	active, err := s.client.handle.NewSelect().
+		Model((*model.Execution)(nil)).
+		Where("runner_id = ?", runner.ID).
+		Where("status IN (?)", bun.In(activeStatus)).
+		Count(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if active >= max(runner.Capacity, 1) {
+		return nil, ErrExecutionNotFound
+	}
+
+	reserved := make(map[string][]*model.Runner)
+
+	// Limit the candidates per claim, remaining executions are considered
+	// with the next claim once the blocking executions have been claimed.
+	for page := range claimPages {
+		candidates := make([]*model.Execution, 0)
+
+		q := s.client.handle.NewSelect().
+			Model(&candidates).
+			Relation("Template").
+			Where("execution.status = ?", model.ExecutionStatusWaiting).
+			Where("(execution.not_before IS NULL OR execution.not_before <= ?)", time.Now()).
+			Where(
+				"NOT EXISTS (SELECT 1 FROM templates AS sequential JOIN executions AS busy ON busy.template_id = sequential.id WHERE sequential.id = execution.template_id AND sequential.sequential = ? AND busy.status IN (?))",
+				true,
+				bun.In(activeStatus),
+			).
+			OrderExpr("execution.priority DESC").
+			OrderExpr(
+				"(SELECT COUNT(*) FROM executions AS busy WHERE busy.project_id = execution.project_id AND busy.status IN (?)) ASC",
+				bun.In(activeStatus),
+			).
+			Order("execution.created_at ASC").
+			Limit(claimCandidates).
+			Offset(page * claimCandidates)
+
+		if runner.ProjectID != "" {
+			q = q.Where("execution.project_id = ?", runner.ProjectID)
+		}
+
+		if err := q.Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		if len(candidates) == 0 {
+			return nil, ErrExecutionNotFound
+		}
+
+		for _, record := range candidates {
+			required := model.Labels{}
+
+			if record.Template != nil {
+				required = record.Template.Labels
+			}
+
+			if !runner.Labels.Satisfies(required) {
+				continue
+			}
+
+			if runner.ProjectID == "" && time.Since(record.CreatedAt) < claimPreference {
+				if _, ok := reserved[record.ProjectID]; !ok {
+					preferred, err := s.projectRunners(ctx, record.ProjectID)
+
+					if err != nil {
+						return nil, err
+					}
+
+					reserved[record.ProjectID] = preferred
+				}
+
+				if satisfied(reserved[record.ProjectID], required) {
+					continue
+				}
+			}
+
+			claimed, err := s.claim(ctx, runner, record)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Another runner has been faster or the template is busy.
+			if !claimed {
+				continue
+			}
+
+			if err := s.statusEvent(ctx, record); err != nil {
+				return nil, err
+			}
+
+			return s.Claimed(ctx, runner, record.ID)
+		}
+	}
+
+	return nil, ErrExecutionNotFound
+}
+
+// Claimed implements the details for an execution claimed by a runner,
+// including all relations required to actually run the execution.
+func (s *Executions) Claimed(ctx context.Context, runner *model.Runner, name string) (*model.Execution, error) {
+	record := &model.Execution{}
+
+	q := s.client.handle.NewSelect().
+		Model(record).
+		Relation("Project").
+		Relation("Template").
+		Relation("Template.Repository").
+		Relation("Template.Repository.Credential").
+		Relation("Template.Inventory").
+		Relation("Template.Inventory.Repository").
+		Relation("Template.Inventory.Credential").
+		Relation("Template.Inventory.Become").
+		Relation("Template.Environment").
+		Relation("Template.Environment.Secrets").
+		Relation("Template.Environment.Values").
+		Relation("Template.Surveys").
+		Relation("Template.Vaults").
+		Relation("Template.Vaults.Credential").
+		Where("execution.id = ?", name).
+		Where("execution.runner_id = ?", runner.ID)
+
+	if err := q.Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, ErrExecutionNotFound
+		}
+
+		return record, err
+	}
+
+	return record, nil
+}
+
+// Transition implements the status update of an execution.
+func (s *Executions) Transition(ctx context.Context, record *model.Execution, status model.ExecutionStatus) error {
+	if err := validation.Validate(
+		status,
+		validation.Required,
+		validation.In(
+			model.ExecutionStatusRunning,
+			model.ExecutionStatusConfirm,
+			model.ExecutionStatusStopped,
+			model.ExecutionStatusSuccess,
+			model.ExecutionStatusFailure,
+		),
+	); err != nil {
+		return validate.Errors{
+			Errors: []validate.Error{
+				{
+					Field: "status",
+					Error: err,
+				},
+			},
+		}
+	}
+
+	record.Status = status
+
+	switch status {
+	case model.ExecutionStatusRunning:
+		if record.StartedAt.IsZero() {
+			record.StartedAt = time.Now()
+		}
+	case model.ExecutionStatusStopped, model.ExecutionStatusSuccess, model.ExecutionStatusFailure:
+		record.FinishedAt = time.Now()
+	}
+
+	q := s.client.handle.NewUpdate().
+		Model(record).
+		Column("status", "started_at", "finished_at", "exit_code", "commit_sha", "updated_at").
+		Where("id = ?", record.ID).
+		Where("runner_id = ?", record.RunnerID)
+
+	// Finished or recovered executions must not be overwritten.
+	q = q.Where("status IN (?)", bun.In(activeStatus))
+
+	// Intermediate updates must not overwrite a requested stop.
+	if status == model.ExecutionStatusRunning || status == model.ExecutionStatusConfirm {
+		q = q.Where("status != ?", model.ExecutionStatusStopping)
+	}
+
+	res, err := q.Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	// The execution has been finished, stopped or re-queued meanwhile.
+	if affected == 0 {
+		return ErrExecutionConflict
+	}
+
+	if err := s.statusEvent(ctx, record); err != nil {
+		return err
+	}
+
+	if status == model.ExecutionStatusFailure {
+		return s.retry(ctx, record.ID)
+	}
+
+	return nil
+}
+
+// Stop implements the stop of an execution. Waiting executions are stopped
+// immediately, otherwise the runner gets notified to stop the execution.
+func (s *Executions) Stop(ctx context.Context, project *model.Project, name string) (*model.Execution, error) {
+	record, err := s.Show(ctx, project, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	previous := record.Status
+
+	switch previous {
+	case model.ExecutionStatusWaiting:
+		record.Status = model.ExecutionStatusStopped
+		record.FinishedAt = time.Now()
+	case model.ExecutionStatusStarting,
+		model.ExecutionStatusRunning,
+		model.ExecutionStatusConfirm,
+		model.ExecutionStatusConfirmed:
+		record.Status = model.ExecutionStatusStopping
+	default:
+		return nil, ErrExecutionNotRunning
+	}
+
+	res, err := s.client.handle.NewUpdate().
+		Model(record).
+		Column("status", "finished_at", "updated_at").
+		Where("id = ?", record.ID).
+		Where("status = ?", previous).
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrExecutionNotRunning
+	}
+
+	if err := s.statusEvent(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return s.Show(ctx, project, record.ID)
+}
+
+// Stopping implements the listing of executions a runner has to stop.
+func (s *Executions) Stopping(ctx context.Context, runner *model.Runner) ([]string, error) {
+	records := make([]*model.Execution, 0)
+
+	if err := s.client.handle.NewSelect().
+		Model(&records).
+		Column("execution.id").
+		Where("execution.runner_id = ?", runner.ID).
+		Where("execution.status = ?", model.ExecutionStatusStopping).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(records))
+	for id, record := range records {
+		result[id] = record.ID
+	}
+
+	return result, nil
+}
+
+// Recover implements the recovery of executions held by runners which have
+// not been seen within the timeout. Executions which should be stopped are
+// marked as stopped, all others get re-queued or marked as failed.
+func (s *Executions) Recover(ctx context.Context, timeout time.Duration, requeue bool) (int, error) {
+	records := make([]*model.Execution, 0)
+
+	if err := s.client.handle.NewSelect().
+		Model(&records).
+		Relation("Runner").
+		Where("execution.status IN (?)", bun.In(activeStatus)).
+		Scan(ctx); err != nil {
+		return 0, err
+	}
+
+	deadline := time.Now().Add(-timeout)
+	recovered := 0
+
+	for _, record := range records {
+		if record.Runner != nil && record.Runner.LastSeenAt.After(deadline) {
+			continue
+		}
+
+		prev := record.Status
+		message := "Runner went away, execution has been marked as failed\n"
+
+		switch {
+		case prev == model.ExecutionStatusStopping:
+			record.Status = model.ExecutionStatusStopped
+			record.FinishedAt = time.Now()
+			message = "Runner went away, execution has been marked as stopped\n"
+		case requeue:
+			record.Status = model.ExecutionStatusWaiting
+			record.RunnerID = ""
+			record.StartedAt = time.Time{}
+			message = "Runner went away, execution has been queued again\n"
+		default:
+			record.Status = model.ExecutionStatusFailure
+			record.FinishedAt = time.Now()
+		}
+
+		res, err := s.client.handle.NewUpdate().
+			Model(record).
+			Column("status", "runner_id", "started_at", "finished_at", "updated_at").
+			Where("id = ?", record.ID).
+			Where("status = ?", prev).
+			Exec(ctx)
+
+		if err != nil {
+			return recovered, err
+		}
+
+		affected, err := res.RowsAffected()
+
+		if err != nil {
+			return recovered, err
+		}
+
+		// The runner has reported back in the meantime.
+		if affected == 0 {
+			continue
+		}
+
+		if err := s.Append(ctx, record, message); err != nil {
+			return recovered, err
+		}
+
+		if err := s.statusEvent(ctx, record); err != nil {
+			return recovered, err
+		}
+
+		if record.Status == model.ExecutionStatusFailure {
+			if err := s.retry(ctx, record.ID); err != nil {
+				return recovered, err
+			}
+		}
+
+		recovered++
+	}
+
+	return recovered, nil
+}
+
+func (s *Executions) confirm(ctx context.Context, project *model.Project, name string, status model.ExecutionStatus, action model.EventAction) (*model.Execution, error) {
+	record, err := s.Show(ctx, project, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	record.Status = status
+
+	if status == model.ExecutionStatusRejected {
+		record.FinishedAt = time.Now()
+	}
+
+	res, err := s.client.handle.NewUpdate().
+		Model(record).
+		Column("status", "finished_at", "updated_at").
+		Where("id = ?", record.ID).
+		Where("status = ?", model.ExecutionStatusConfirm).
+		Exec(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, ErrExecutionNotConfirm
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeExecution,
+				Action:         action,
+			},
+		)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.client.Schedules.track(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return s.Show(ctx, project, record.ID)
+}
+
+// retry queues another attempt of a failed execution if the retry policy of
+// the template allows it, the attempt gets delayed by the backoff.
+func (s *Executions) retry(ctx context.Context, name string) error {
+	parent := &model.Execution{}
+
+	if err := s.client.handle.NewSelect().
+		Model(parent).
+		Relation("Project").
+		Relation("Template").
+		Where("execution.id = ?", name).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	if parent.Template == nil || parent.Attempt >= parent.Template.RetryAttempts {
+		return nil
+	}
+
+	if !parent.Template.RetryCodes.Matches(parent.ExitCode) {
+		return nil
+	}
+
+	delay := parent.Template.RetryDelay(parent.Attempt)
+	record := parent.Retry()
+	record.NotBefore = time.Now().Add(delay)
+
+	if _, err := s.client.handle.NewInsert().
+		Model(record).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := s.client.Schedules.follow(ctx, parent, record); err != nil {
+		return err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      parent.Project.ID,
+				ProjectDisplay: parent.Project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeExecution,
+				Action:         model.EventActionRetry,
+				Attrs: map[string]interface{}{
+					"parent":  parent.ID,
+					"attempt": record.Attempt,
+				},
+			},
+		)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return s.Append(ctx, parent, fmt.Sprintf(
+		"Execution will be retried as %s in %s\n",
+		record.Name,
+		delay,
+	))
+}
+
+func (s *Executions) claim(ctx context.Context, runner *model.Runner, record *model.Execution) (bool, error) {
+	claimed := false
+
+	if err := s.client.handle.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		var id string
+
+		// Lock the runner to serialize concurrent claims for it, transactions
+		// on SQLite are immediate and already hold the write lock.
+		q := tx.NewSelect().
+			Model((*model.Runner)(nil)).
+			Column("id").
+			Where("id = ?", runner.ID)
+
+		if tx.Dialect().Name() != dialect.SQLite {
+			q = q.For("UPDATE")
+		}
+
+		if err := q.Scan(ctx, &id); err != nil {
+			return err
+		}
+
+		active, err := tx.NewSelect().
+			Model((*model.Execution)(nil)).
+			Where("runner_id = ?", runner.ID).
+			Where("status IN (?)", bun.In(activeStatus)).
+			Count(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if active >= max(runner.Capacity, 1) {
+			return ErrExecutionNotFound
+		}
+
+		if record.Template != nil && record.Template.Sequential {
+			// Lock the template to serialize concurrent claims for it.
+			q := tx.NewSelect().
+				Model((*model.Template)(nil)).
+				Column("id").
+				Where("id = ?", record.TemplateID)
+
+			if tx.Dialect().Name() != dialect.SQLite {
+				q = q.For("UPDATE")
+			}
+
+			if err := q.Scan(ctx, &id); err != nil {
+				return err
+			}
+
+			busy, err := tx.NewSelect().
+				Model((*model.Execution)(nil)).
+				Where("template_id = ?", record.TemplateID).
+				Where("status IN (?)", bun.In(activeStatus)).
+				Count(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			if busy > 0 {
+				return nil
+			}
+		}
+
+		record.Status = model.ExecutionStatusStarting
+		record.RunnerID = runner.ID
+
+		res, err := tx.NewUpdate().
+			Model(record).
+			Column("status", "runner_id", "updated_at").
+			Where("id = ?", record.ID).
+			Where("status = ?", model.ExecutionStatusWaiting).
+			Exec(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+
+		if err != nil {
+			return err
+		}
+
+		claimed = affected > 0
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return claimed, nil
+}
+
+func (s *Executions) projectRunners(ctx context.Context, projectID string) ([]*model.Runner, error) {
+	records := make([]*model.Runner, 0)
+
+	if err := s.client.handle.NewSelect().
+		Model(&records).
+		Where("project_id = ?", projectID).
+		Where("last_seen_at > ?", time.Now().Add(-runnerActive)).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (s *Executions) statusEvent(ctx context.Context, record *model.Execution) error {
+	project := &model.Project{}
+
+	if err := s.client.handle.NewSelect().
+		Model(project).
+		Where("id = ?", record.ProjectID).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeExecution,
+				Action:         model.EventActionUpdate,
+				Attrs: map[string]interface{}{
+					"status": string(record.Status),
+				},
+			},
+		)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return s.client.Schedules.track(ctx, record)
+}
+
+// Append implements appending output to the log of an execution.
+func (s *Executions) Append(ctx context.Context, record *model.Execution, content string) error {
+	if _, err := s.client.handle.NewInsert().
+		Model(&model.Output{
+			ExecutionID: record.ID,
+			Content:     content,
+		}).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ValidateExists simply provides a validator for this record type.
 func (s *Executions) ValidateExists(ctx context.Context, projectID string) func(value interface{}) error {
 	return func(value interface{}) error {
@@ -275,6 +1058,74 @@ func (s *Executions) validate(ctx context.Context, record *model.Execution, _ bo
 		})
 	}
 
+	if err := validation.Validate(
+		record.Priority,
+		validation.Min(0),
+		validation.Max(100),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "priority",
+			Error: err,
+		})
+	}
+
+	secret := &model.Execution{
+		Secret:  record.Secret,
+		Answers: record.Answers,
+	}
+
+	if err := secret.DeserializeSecret(s.client.encrypt.Passphrase); err != nil {
+		return err
+	}
+
+	template, err := s.template(ctx, record)
+
+	if err != nil {
+		return err
+	}
+
+	overrides := []struct {
+		field string
+		value string
+		rules []validation.Rule
+	}{
+		{field: "branch", value: record.Branch, rules: []validation.Rule{validation.Length(0, 255)}},
+		{field: "limit", value: record.Limit, rules: []validation.Rule{validation.Length(0, 255)}},
+		{field: "path", value: record.Path, rules: []validation.Rule{validation.Length(0, 255)}},
+		{field: "arguments", value: record.Arguments, rules: []validation.Rule{validation.Length(0, 255)}},
+		{field: "environment", value: record.Environment, rules: []validation.Rule{validate.Variables}},
+		{field: "secret", value: secret.Secret, rules: []validation.Rule{validate.Variables}},
+	}
+
+	for _, override := range overrides {
+		if override.value == "" {
+			continue
+		}
+
+		if template == nil || !template.Override {
+			errs.Errors = append(errs.Errors, validate.Error{
+				Field: override.field,
+				Error: ErrExecutionNotOverridable,
+			})
+
+			continue
+		}
+
+		if err := validation.Validate(
+			override.value,
+			override.rules...,
+		); err != nil {
+			errs.Errors = append(errs.Errors, validate.Error{
+				Field: override.field,
+				Error: err,
+			})
+		}
+	}
+
+	if template != nil {
+		errs.Errors = append(errs.Errors, s.validateAnswers(template, secret.Answers)...)
+	}
+
 	if len(errs.Errors) > 0 {
 		return errs
 	}
@@ -282,6 +1133,84 @@ func (s *Executions) validate(ctx context.Context, record *model.Execution, _ bo
 	return nil
 }
 
+func (s *Executions) validateAnswers(template *model.Template, content string) []validate.Error {
+	result := make([]validate.Error, 0)
+	answers := make(map[string]string)
+
+	if content != "" {
+		if err := json.Unmarshal([]byte(content), &answers); err != nil {
+			return append(result, validate.Error{
+				Field: "answers",
+				Error: validate.ErrVariables,
+			})
+		}
+	}
+
+	known := make(map[string]bool, len(template.Surveys))
+
+	for _, survey := range template.Surveys {
+		known[survey.Name] = true
+		rules := make([]validation.Rule, 0)
+
+		if survey.Required {
+			rules = append(rules, validation.Required)
+		}
+
+		switch survey.Kind {
+		case "number":
+			rules = append(rules, validation.By(isNumber))
+		case "enum":
+			values := make([]interface{}, len(survey.Values))
+			for id, row := range survey.Values {
+				values[id] = row.Value
+			}
+
+			rules = append(rules, validation.In(values...))
+		}
+
+		if err := validation.Validate(
+			answers[survey.Name],
+			rules...,
+		); err != nil {
+			result = append(result, validate.Error{
+				Field: "answers." + survey.Name,
+				Error: err,
+			})
+		}
+	}
+
+	for name := range answers {
+		if !known[name] {
+			result = append(result, validate.Error{
+				Field: "answers." + name,
+				Error: ErrExecutionUnknownAnswer,
+			})
+		}
+	}
+
+	return result
+}
+
+func (s *Executions) template(ctx context.Context, record *model.Execution) (*model.Template, error) {
+	template := &model.Template{}
+
+	if err := s.client.handle.NewSelect().
+		Model(template).
+		Relation("Surveys").
+		Relation("Surveys.Values").
+		Where("template.project_id = ?", record.ProjectID).
+		Where("template.id = ?", record.TemplateID).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return template, nil
+}
+
 func (s *Executions) validSort(val string) (string, bool) {
 	if val == "" {
 		return "execution.created_at", true
@@ -292,6 +1221,7 @@ func (s *Executions) validSort(val string) (string, bool) {
 	for key, name := range map[string]string{
 		"template": "template.name",
 		"status":   "execution.status",
+		"priority": "execution.priority",
 		"created":  "execution.created_at",
 		"updated":  "execution.updated_at",
 	} {
@@ -302,3 +1232,27 @@ func (s *Executions) validSort(val string) (string, bool) {
 
 	return "execution.created_at", true
 }
+
+func isNumber(value interface{}) error {
+	val, _ := value.(string)
+
+	if val == "" {
+		return nil
+	}
+
+	if _, err := strconv.ParseFloat(val, 64); err != nil {
+		return errors.New("must be a valid number")
+	}
+
+	return nil
+}
+
+func satisfied(runners []*model.Runner, required model.Labels) bool {
+	for _, runner := range runners {
+		if runner.Labels.Satisfies(required) {
+			return true
+		}
+	}
+
+	return false
+}
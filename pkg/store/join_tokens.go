@@ -0,0 +1,329 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gexec/gexec/pkg/model"
+	"github.com/gexec/gexec/pkg/validate"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/uptrace/bun"
+)
+
+// JoinTokens provides all database operations related to join tokens.
+type JoinTokens struct {
+	client *Store
+}
+
+// List implements the listing of all join tokens.
+func (s *JoinTokens) List(ctx context.Context, projectID string, params model.ListParams) ([]*model.JoinToken, int64, error) {
+	records := make([]*model.JoinToken, 0)
+
+	q := s.client.handle.NewSelect().
+		Model(&records)
+
+	if projectID != "" {
+		q = q.Where("join_token.project_id = ?", projectID)
+	}
+
+	if val, ok := s.validSort(params.Sort); ok {
+		q = q.Order(strings.Join(
+			[]string{
+				val,
+				sortOrder(params.Order),
+			},
+			" ",
+		))
+	}
+
+	if params.Search != "" {
+		q = s.client.SearchQuery(q, params.Search)
+	}
+
+	counter, err := q.Count(ctx)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if params.Limit > 0 {
+		q = q.Limit(int(params.Limit))
+	}
+
+	if params.Offset > 0 {
+		q = q.Offset(int(params.Offset))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, int64(counter), err
+	}
+
+	return records, int64(counter), nil
+}
+
+// Show implements the details for a specific join token.
+func (s *JoinTokens) Show(ctx context.Context, project *model.Project, id string) (*model.JoinToken, error) {
+	record := &model.JoinToken{}
+
+	q := s.client.handle.NewSelect().
+		Model(record).
+		Where("join_token.id = ?", id)
+
+	if project.ID != "" {
+		q = q.Where("join_token.project_id = ?", project.ID)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, ErrJoinTokenNotFound
+		}
+
+		return record, err
+	}
+
+	return record, nil
+}
+
+// Create implements the create of a new join token. The token of the record
+// is expected to be hashed already, plain tokens never hit the database.
+func (s *JoinTokens) Create(ctx context.Context, project *model.Project, record *model.JoinToken) (*model.JoinToken, error) {
+	if record.Uses == 0 {
+		record.Uses = 1
+	}
+
+	if err := s.validate(ctx, record, false); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(record).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeJoinToken,
+				Action:         model.EventActionCreate,
+			},
+		)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.Show(ctx, project, record.ID)
+}
+
+// Delete implements the deletion of a join token.
+func (s *JoinTokens) Delete(ctx context.Context, project *model.Project, id string) error {
+	record, err := s.Show(ctx, project, id)
+
+	if err != nil {
+		return err
+	}
+
+	q := s.client.handle.NewDelete().
+		Model((*model.JoinToken)(nil)).
+		Where("id = ?", id)
+
+	if project.ID != "" {
+		q = q.Where("project_id = ?", project.ID)
+	}
+
+	if _, err := q.Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeJoinToken,
+				Action:         model.EventActionDelete,
+			},
+		)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Resolve implements the lookup of a plain join token without consuming it,
+// expired or exhausted tokens are treated as not found.
+func (s *JoinTokens) Resolve(ctx context.Context, token string) (*model.JoinToken, error) {
+	record := &model.JoinToken{}
+
+	if token == "" {
+		return nil, ErrJoinTokenNotFound
+	}
+
+	if err := s.client.handle.NewSelect().
+		Model(record).
+		Relation("Project").
+		Where("join_token.token = ?", model.HashJoinToken(token)).
+		Scan(ctx); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJoinTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	if record.Used >= record.Uses || time.Now().After(record.ExpiresAt) {
+		return nil, ErrJoinTokenNotFound
+	}
+
+	return record, nil
+}
+
+// Redeem implements the exchange of a resolved join token for a new runner.
+// Consuming one use of the token and creating the runner happen within a
+// single transaction, this way a failed join does not burn the token.
+func (s *JoinTokens) Redeem(ctx context.Context, join *model.JoinToken, record *model.Runner) (*model.Runner, error) {
+	project := &model.Project{}
+
+	if join.Project != nil {
+		project = join.Project
+	}
+
+	if record.Slug == "" {
+		record.Slug = s.client.Runners.slugify(
+			ctx,
+			"slug",
+			record.Name,
+			"",
+			project.ID,
+		)
+	}
+
+	if err := s.client.Runners.validate(ctx, record, false); err != nil {
+		return nil, err
+	}
+
+	if err := s.client.handle.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model((*model.JoinToken)(nil)).
+			Set("used = used + 1").
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", join.ID).
+			Where("used = ?", join.Used).
+			Exec(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if rows, err := res.RowsAffected(); err != nil || rows == 0 {
+			// Somebody else redeemed the same use in the meantime.
+			return ErrJoinTokenNotFound
+		}
+
+		if _, err := tx.NewInsert().
+			Model(record).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewInsert().
+			Model(model.PrepareEvent(
+				s.client.principal,
+				&model.Event{
+					ProjectID:      project.ID,
+					ProjectDisplay: project.Name,
+					ObjectID:       record.ID,
+					ObjectDisplay:  record.Name,
+					ObjectType:     model.EventTypeRunner,
+					Action:         model.EventActionCreate,
+				},
+			)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	join.Used++
+	return s.client.Runners.Show(ctx, project, record.ID)
+}
+
+func (s *JoinTokens) validate(_ context.Context, record *model.JoinToken, _ bool) error {
+	errs := validate.Errors{}
+
+	if err := validation.Validate(
+		record.Name,
+		validation.Required,
+		validation.Length(3, 255),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "name",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.Uses,
+		validation.Min(1),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "uses",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.ExpiresAt,
+		validation.Required,
+		validation.Min(time.Now()),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "expires_at",
+			Error: err,
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func (s *JoinTokens) validSort(val string) (string, bool) {
+	if val == "" {
+		return "join_token.name", true
+	}
+
+	val = strings.ToLower(val)
+
+	for key, name := range map[string]string{
+		"name":    "join_token.name",
+		"uses":    "join_token.uses",
+		"used":    "join_token.used",
+		"expires": "join_token.expires_at",
+		"created": "join_token.created_at",
+		"updated": "join_token.updated_at",
+	} {
+		if val == key {
+			return name, true
+		}
+	}
+
+	return "join_token.name", true
+}
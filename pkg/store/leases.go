@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gexec/gexec/pkg/model"
+	"github.com/uptrace/bun/dialect"
+)
+
+// Leases provides all database operations related to leases.
+type Leases struct {
+	client *Store
+}
+
+// Acquire implements the acquisition or renewal of a named lease. It returns
+// true if the holder owns the lease afterwards, a lease held by somebody else
+// can only be taken over after it has expired. The expiry is based on the
+// time of the database, that way the clocks of the servers don't matter.
+func (s *Leases) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	now, expires, seconds := s.clock(ttl)
+
+	res, err := s.client.handle.NewUpdate().
+		Model((*model.Lease)(nil)).
+		Set("holder = ?", holder).
+		Set("expires_at = "+expires, seconds).
+		Set("updated_at = ?", time.Now()).
+		Where("name = ?", name).
+		Where("holder = ? OR expires_at < "+now, holder).
+		Exec(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := res.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	if updated > 0 {
+		return true, nil
+	}
+
+	q := s.client.handle.NewInsert().
+		Model(&model.Lease{
+			Name:   name,
+			Holder: holder,
+		}).
+		Value("expires_at", expires, seconds)
+
+	switch s.client.handle.Dialect().Name() {
+	case dialect.MySQL:
+		q = q.Ignore()
+	default:
+		q = q.On("CONFLICT (name) DO NOTHING")
+	}
+
+	res, err = q.Exec(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := res.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return inserted > 0, nil
+}
+
+// clock builds the expressions for the current time and the expiry of a lease
+// based on the time of the database.
+func (s *Leases) clock(ttl time.Duration) (string, string, interface{}) {
+	seconds := max(int(ttl/time.Second), 1)
+
+	switch s.client.handle.Dialect().Name() {
+	case dialect.PG:
+		return "CURRENT_TIMESTAMP", "CURRENT_TIMESTAMP + ? * INTERVAL '1 second'", seconds
+	case dialect.MySQL:
+		return "CURRENT_TIMESTAMP", "DATE_ADD(CURRENT_TIMESTAMP, INTERVAL ? SECOND)", seconds
+	default:
+		return "datetime('now')", "datetime('now', ?)", fmt.Sprintf("+%d seconds", seconds)
+	}
+}
+
+// Release implements the release of a named lease, it is only released if it
+// is still owned by the holder.
+func (s *Leases) Release(ctx context.Context, name, holder string) error {
+	_, err := s.client.handle.NewDelete().
+		Model((*model.Lease)(nil)).
+		Where("name = ?", name).
+		Where("holder = ?", holder).
+		Exec(ctx)
+
+	return err
+}
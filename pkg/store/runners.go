@@ -2,10 +2,12 @@ package store
 
 import (
 	"context"
+	"crypto/subtle"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Machiel/slugify"
 	"github.com/dchest/uniuri"
@@ -89,6 +91,106 @@ func (s *Runners) Show(ctx context.Context, project *model.Project, name string)
 	return record, nil
 }
 
+// ByToken implements the lookup of a runner by its token. Tokens are stored
+// encrypted with a random nonce, so the runner gets looked up by the digest
+// and only the matching token gets decrypted to compare.
+// The previous token of a rotation is accepted until its grace period ends.
+func (s *Runners) ByToken(ctx context.Context, token string) (*model.Runner, error) {
+	records := make([]*model.Runner, 0)
+
+	if token == "" {
+		return nil, ErrRunnerNotFound
+	}
+
+	digest := model.HashRunnerToken(token)
+
+	q := s.client.handle.NewSelect().
+		Model(&records).
+		Relation("Project").
+		Where("runner.token_digest = ? OR runner.grace_digest = ?", digest, digest)
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if err := record.DeserializeSecret(s.client.encrypt.Passphrase); err != nil {
+			return nil, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(record.Token), []byte(token)) == 1 {
+			return record, nil
+		}
+
+		if record.GraceActive() && subtle.ConstantTimeCompare([]byte(record.GraceToken), []byte(token)) == 1 {
+			return record, nil
+		}
+	}
+
+	return nil, ErrRunnerNotFound
+}
+
+// Digest implements the backfill of token digests for runners which have
+// been created before the digests have been introduced.
+func (s *Runners) Digest(ctx context.Context) error {
+	records := make([]*model.Runner, 0)
+
+	if err := s.client.handle.NewSelect().
+		Model(&records).
+		Where("runner.token_digest IS NULL").
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := record.DeserializeSecret(s.client.encrypt.Passphrase); err != nil {
+			return err
+		}
+
+		record.Digest()
+
+		if _, err := s.client.handle.NewUpdate().
+			Model(record).
+			Column("token_digest", "grace_digest").
+			Where("id = ?", record.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Register implements the registration of a runner with its advertised details.
+func (s *Runners) Register(ctx context.Context, record *model.Runner) error {
+	record.LastSeenAt = time.Now()
+
+	if _, err := s.client.handle.NewUpdate().
+		Model(record).
+		Column("capacity", "version", "hostname", "os", "arch", "last_seen_at").
+		Where("id = ?", record.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Heartbeat implements the heartbeat of a runner to mark it as alive.
+func (s *Runners) Heartbeat(ctx context.Context, record *model.Runner) error {
+	record.LastSeenAt = time.Now()
+
+	if _, err := s.client.handle.NewUpdate().
+		Model(record).
+		Column("last_seen_at").
+		Where("id = ?", record.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Create implements the create of a new runner.
 func (s *Runners) Create(ctx context.Context, project *model.Project, record *model.Runner) (*model.Runner, error) {
 	if record.Slug == "" {
@@ -130,6 +232,57 @@ func (s *Runners) Create(ctx context.Context, project *model.Project, record *mo
 	return s.Show(ctx, project, record.ID)
 }
 
+// Rotate implements the rotation of a runner token. The record is expected to
+// contain the new token and the previous one as grace token, already encrypted.
+func (s *Runners) Rotate(ctx context.Context, project *model.Project, record *model.Runner) (*model.Runner, error) {
+	return s.credentials(ctx, project, record, model.EventActionRotate)
+}
+
+// Revoke implements the immediate revocation of all runner tokens. The record
+// is expected to contain an unknown replacement token, already encrypted.
+func (s *Runners) Revoke(ctx context.Context, project *model.Project, record *model.Runner) (*model.Runner, error) {
+	record.GraceToken = ""
+	record.GraceDigest = ""
+	record.GraceExpiresAt = time.Time{}
+
+	return s.credentials(ctx, project, record, model.EventActionRevoke)
+}
+
+func (s *Runners) credentials(ctx context.Context, project *model.Project, record *model.Runner, action model.EventAction) (*model.Runner, error) {
+	record.RotatedAt = time.Now()
+
+	q := s.client.handle.NewUpdate().
+		Model(record).
+		Column("token", "token_digest", "grace_token", "grace_digest", "grace_expires_at", "rotated_at", "updated_at").
+		Where("id = ?", record.ID)
+
+	if project.ID != "" {
+		q = q.Where("project_id = ?", project.ID)
+	}
+
+	if _, err := q.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.PrepareEvent(
+			s.client.principal,
+			&model.Event{
+				ProjectID:      project.ID,
+				ProjectDisplay: project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeRunner,
+				Action:         action,
+			},
+		)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.Show(ctx, project, record.ID)
+}
+
 // Update implements the update of an existing runner.
 func (s *Runners) Update(ctx context.Context, project *model.Project, record *model.Runner) (*model.Runner, error) {
 	if record.Slug == "" {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Machiel/slugify"
 	"github.com/dchest/uniuri"
@@ -97,6 +98,14 @@ func (s *Schedules) Create(ctx context.Context, project *model.Project, record *
 		)
 	}
 
+	if record.Timezone == "" {
+		record.Timezone = "UTC"
+	}
+
+	if record.Missed == "" {
+		record.Missed = model.ScheduleMissedSkip
+	}
+
 	if err := s.validate(ctx, record, false); err != nil {
 		return nil, err
 	}
@@ -142,8 +151,24 @@ func (s *Schedules) Update(ctx context.Context, project *model.Project, record *
 		return nil, err
 	}
 
+	// Runs while the schedule has been inactive are not missed, so the ticks
+	// before a reactivation are marked as triggered.
+	if record.Active {
+		if _, err := s.client.handle.NewUpdate().
+			Model((*model.Schedule)(nil)).
+			Set("triggered_at = ?", time.Now()).
+			Where("id = ?", record.ID).
+			Where("active = ?", false).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// The trigger time and the last run are owned by the scheduler, do not
+	// overwrite them with possibly outdated values.
 	q := s.client.handle.NewUpdate().
 		Model(record).
+		ExcludeColumn("triggered_at", "last_run_at", "last_status", "last_execution_id").
 		Where("project_id = ?", project.ID).
 		Where("id = ?", record.ID)
 
@@ -206,6 +231,297 @@ func (s *Schedules) Delete(ctx context.Context, project *model.Project, name str
 	return nil
 }
 
+// Active implements the listing of all active schedules across projects.
+func (s *Schedules) Active(ctx context.Context) ([]*model.Schedule, error) {
+	records := make([]*model.Schedule, 0)
+
+	q := s.client.handle.NewSelect().
+		Model(&records).
+		Relation("Project").
+		Where("schedule.active = ?", true).
+		Order("schedule.created_at ASC")
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Trigger implements the creation of an execution for a pending tick of a
+// schedule. The tick gets claimed atomically, if another process already
+// triggered the schedule for this tick no execution gets created and nil is
+// returned.
+func (s *Schedules) Trigger(ctx context.Context, record *model.Schedule, at time.Time) (*model.Execution, error) {
+	execution := &model.Execution{
+		ProjectID:  record.ProjectID,
+		TemplateID: record.TemplateID,
+		ScheduleID: record.ID,
+		Priority:   model.ExecutionPriorityScheduled,
+	}
+
+	invalid := s.client.Executions.validate(ctx, execution, false)
+	claimed := false
+
+	if err := s.client.handle.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model((*model.Schedule)(nil)).
+			Set("triggered_at = ?", at).
+			Where("id = ?", record.ID).
+			Where("triggered_at IS NULL OR triggered_at < ?", at).
+			Exec(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return nil
+		}
+
+		claimed = true
+
+		// The tick stays claimed even if the execution is invalid, otherwise it
+		// would fail again on every evaluation.
+		if invalid != nil {
+			return s.run(ctx, tx, record, &model.ScheduleRun{
+				Status:      model.ExecutionStatusFailure,
+				Message:     s.message(invalid),
+				TriggeredAt: at,
+				FinishedAt:  time.Now(),
+			})
+		}
+
+		if _, err := tx.NewInsert().
+			Model(execution).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := s.run(ctx, tx, record, &model.ScheduleRun{
+			ExecutionID: execution.ID,
+			Status:      execution.Status,
+			TriggeredAt: at,
+		}); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewInsert().
+			Model(model.SystemEvent(
+				&model.Event{
+					ProjectID:      record.Project.ID,
+					ProjectDisplay: record.Project.Name,
+					ObjectID:       execution.ID,
+					ObjectDisplay:  execution.Name,
+					ObjectType:     model.EventTypeExecution,
+					Action:         model.EventActionTrigger,
+					Attrs: map[string]interface{}{
+						"schedule":         record.ID,
+						"schedule_display": record.Name,
+					},
+				},
+			)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if !claimed {
+		return nil, nil
+	}
+
+	record.TriggeredAt = at
+
+	if invalid != nil {
+		return nil, invalid
+	}
+
+	return execution, nil
+}
+
+// Deactivate implements the deactivation of an expired schedule.
+func (s *Schedules) Deactivate(ctx context.Context, record *model.Schedule) error {
+	res, err := s.client.handle.NewUpdate().
+		Model((*model.Schedule)(nil)).
+		Set("active = ?", false).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", record.ID).
+		Where("active = ?", true).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return nil
+	}
+
+	record.Active = false
+
+	if _, err := s.client.handle.NewInsert().
+		Model(model.SystemEvent(
+			&model.Event{
+				ProjectID:      record.Project.ID,
+				ProjectDisplay: record.Project.Name,
+				ObjectID:       record.ID,
+				ObjectDisplay:  record.Name,
+				ObjectType:     model.EventTypeSchedule,
+				Action:         model.EventActionUpdate,
+				Attrs: map[string]interface{}{
+					"active": false,
+				},
+			},
+		)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Runs implements the listing of the run history for a schedule.
+func (s *Schedules) Runs(ctx context.Context, record *model.Schedule, params model.ListParams) ([]*model.ScheduleRun, int64, error) {
+	records := make([]*model.ScheduleRun, 0)
+
+	q := s.client.handle.NewSelect().
+		Model(&records).
+		Where("schedule_run.schedule_id = ?", record.ID).
+		Order("schedule_run.triggered_at DESC")
+
+	counter, err := q.Count(ctx)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if params.Limit > 0 {
+		q = q.Limit(int(params.Limit))
+	}
+
+	if params.Offset > 0 {
+		q = q.Offset(int(params.Offset))
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, int64(counter), err
+	}
+
+	return records, int64(counter), nil
+}
+
+// run records a run within the history and as the last run of the schedule.
+func (s *Schedules) run(ctx context.Context, db bun.IDB, record *model.Schedule, run *model.ScheduleRun) error {
+	run.ScheduleID = record.ID
+
+	if _, err := db.NewInsert().
+		Model(run).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	record.LastRunAt = run.TriggeredAt
+	record.LastStatus = run.Status
+	record.LastExecutionID = run.ExecutionID
+
+	_, err := db.NewUpdate().
+		Model(record).
+		Column("last_run_at", "last_status", "last_execution_id").
+		Where("id = ?", record.ID).
+		Exec(ctx)
+
+	return err
+}
+
+// track updates the run of a scheduled execution whenever its status changes,
+// this way the run history reflects the final status of the execution.
+func (s *Schedules) track(ctx context.Context, execution *model.Execution) error {
+	if execution.ScheduleID == "" {
+		return nil
+	}
+
+	if _, err := s.client.handle.NewUpdate().
+		Model((*model.ScheduleRun)(nil)).
+		Set("status = ?", execution.Status).
+		Set("finished_at = ?", bun.NullTime{Time: execution.FinishedAt}).
+		Set("updated_at = ?", time.Now()).
+		Where("execution_id = ?", execution.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := s.client.handle.NewUpdate().
+		Model((*model.Schedule)(nil)).
+		Set("last_status = ?", execution.Status).
+		Where("id = ?", execution.ScheduleID).
+		Where("last_execution_id = ?", execution.ID).
+		Exec(ctx)
+
+	return err
+}
+
+// follow moves the run of a scheduled execution over to its retry, this way
+// the run history reflects the final status after all attempts.
+func (s *Schedules) follow(ctx context.Context, parent, execution *model.Execution) error {
+	if execution.ScheduleID == "" {
+		return nil
+	}
+
+	if _, err := s.client.handle.NewUpdate().
+		Model((*model.ScheduleRun)(nil)).
+		Set("execution_id = ?", execution.ID).
+		Set("status = ?", execution.Status).
+		Set("finished_at = NULL").
+		Set("updated_at = ?", time.Now()).
+		Where("execution_id = ?", parent.ID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := s.client.handle.NewUpdate().
+		Model((*model.Schedule)(nil)).
+		Set("last_status = ?", execution.Status).
+		Set("last_execution_id = ?", execution.ID).
+		Where("id = ?", execution.ScheduleID).
+		Where("last_execution_id = ?", parent.ID).
+		Exec(ctx)
+
+	return err
+}
+
+// message converts an error into a message for the run history.
+func (s *Schedules) message(err error) string {
+	verrs := validate.Errors{}
+
+	if !errors.As(err, &verrs) {
+		return err.Error()
+	}
+
+	result := make([]string, 0, len(verrs.Errors))
+
+	for _, verr := range verrs.Errors {
+		result = append(result, fmt.Sprintf("%s: %s", verr.Field, verr.Error))
+	}
+
+	return strings.Join(result, ", ")
+}
+
 // ValidateExists simply provides a validator for this record type.
 func (s *Schedules) ValidateExists(ctx context.Context, projectID string) func(value interface{}) error {
 	return func(value interface{}) error {
@@ -271,10 +587,17 @@ func (s *Schedules) validate(ctx context.Context, record *model.Schedule, _ bool
 		})
 	}
 
+	oneshot := !record.RunAt.IsZero()
+
 	if err := validation.Validate(
 		record.Cron,
-		validation.Required,
-		validate.CronSyntax,
+		validation.When(
+			oneshot,
+			validation.Empty,
+		).Else(
+			validation.Required,
+			validate.CronSyntax,
+		),
 	); err != nil {
 		errs.Errors = append(errs.Errors, validate.Error{
 			Field: "cron",
@@ -282,6 +605,62 @@ func (s *Schedules) validate(ctx context.Context, record *model.Schedule, _ bool
 		})
 	}
 
+	if err := validation.Validate(
+		record.StartAt,
+		validation.When(
+			oneshot,
+			validation.Empty,
+		),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "start_at",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.EndAt,
+		validation.When(
+			oneshot,
+			validation.Empty,
+		),
+		validation.When(
+			!record.StartAt.IsZero() && !record.EndAt.IsZero(),
+			validation.Min(record.StartAt).Exclusive(),
+		),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "end_at",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.Timezone,
+		validation.Required,
+		validate.Timezone,
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "timezone",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.Missed,
+		validation.Required,
+		validation.In(
+			model.ScheduleMissedSkip,
+			model.ScheduleMissedOnce,
+			model.ScheduleMissedAll,
+		),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "missed",
+			Error: err,
+		})
+	}
+
 	if len(errs.Errors) > 0 {
 		return errs
 	}
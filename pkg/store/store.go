@@ -64,7 +64,9 @@ type Store struct {
 	Schedules    *Schedules
 	Executions   *Executions
 	Runners      *Runners
+	JoinTokens   *JoinTokens
 	Events       *Events
+	Leases       *Leases
 }
 
 // Handle returns a database handle.
@@ -72,10 +74,76 @@ func (s *Store) Handle() *bun.DB {
 	return s.handle
 }
 
-// WithPrincipal integrates the current user.
+// WithPrincipal returns a copy of the store bound to the current user.
 func (s *Store) WithPrincipal(principal *model.User) *Store {
-	s.principal = principal
-	return s
+	result := *s
+	result.principal = principal
+	result.attach()
+
+	return &result
+}
+
+// attach binds all sub-stores to the current store.
+func (s *Store) attach() {
+	s.Auth = &Auth{
+		client: s,
+	}
+
+	s.Groups = &Groups{
+		client: s,
+	}
+
+	s.Users = &Users{
+		client: s,
+	}
+
+	s.Projects = &Projects{
+		client: s,
+	}
+
+	s.Credentials = &Credentials{
+		client: s,
+	}
+
+	s.Repositories = &Repositories{
+		client: s,
+	}
+
+	s.Inventories = &Inventories{
+		client: s,
+	}
+
+	s.Environments = &Environments{
+		client: s,
+	}
+
+	s.Templates = &Templates{
+		client: s,
+	}
+
+	s.Schedules = &Schedules{
+		client: s,
+	}
+
+	s.Executions = &Executions{
+		client: s,
+	}
+
+	s.Runners = &Runners{
+		client: s,
+	}
+
+	s.JoinTokens = &JoinTokens{
+		client: s,
+	}
+
+	s.Events = &Events{
+		client: s,
+	}
+
+	s.Leases = &Leases{
+		client: s,
+	}
 }
 
 // SearchQuery builds a query for search terms.
@@ -285,7 +353,13 @@ func (s *Store) Migrate(ctx context.Context) (*migrate.MigrationGroup, error) {
 		_ = migrator.Unlock(ctx)
 	}()
 
-	return migrator.Migrate(ctx)
+	group, err := migrator.Migrate(ctx)
+
+	if err != nil {
+		return group, err
+	}
+
+	return group, s.Runners.Digest(ctx)
 }
 
 // Rollback handles a database rollback.
@@ -495,6 +569,10 @@ func NewStore(cfg config.Database, scim config.Scim, encrypt config.Encrypt, upl
 		client.meta.Add("_pragma", "journal_mode(WAL)")
 		client.meta.Add("_pragma", "busy_timeout(5000)")
 		client.meta.Add("_pragma", "foreign_keys(1)")
+
+		// Immediate transactions take the write lock upfront, that way
+		// concurrent transactions like claims are strictly serialized.
+		client.meta.Add("_txlock", "immediate")
 	case "mysql", "mariadb":
 		client.driver = "mysql"
 
@@ -540,58 +618,7 @@ func NewStore(cfg config.Database, scim config.Scim, encrypt config.Encrypt, upl
 		}
 	}
 
-	client.Auth = &Auth{
-		client: client,
-	}
-
-	client.Groups = &Groups{
-		client: client,
-	}
-
-	client.Users = &Users{
-		client: client,
-	}
-
-	client.Projects = &Projects{
-		client: client,
-	}
-
-	client.Credentials = &Credentials{
-		client: client,
-	}
-
-	client.Repositories = &Repositories{
-		client: client,
-	}
-
-	client.Inventories = &Inventories{
-		client: client,
-	}
-
-	client.Environments = &Environments{
-		client: client,
-	}
-
-	client.Templates = &Templates{
-		client: client,
-	}
-
-	client.Schedules = &Schedules{
-		client: client,
-	}
-
-	client.Executions = &Executions{
-		client: client,
-	}
-
-	client.Runners = &Runners{
-		client: client,
-	}
-
-	client.Events = &Events{
-		client: client,
-	}
-
+	client.attach()
 	return client, nil
 }
 
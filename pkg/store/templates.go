@@ -842,6 +842,41 @@ func (s *Templates) validate(ctx context.Context, record *model.Template, _ bool
 		})
 	}
 
+	if err := validation.Validate(
+		record.RetryAttempts,
+		validation.Min(0),
+		validation.Max(10),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "retry_attempts",
+			Error: err,
+		})
+	}
+
+	if err := validation.Validate(
+		record.RetryBackoff,
+		validation.Min(0),
+		validation.Max(3600),
+	); err != nil {
+		errs.Errors = append(errs.Errors, validate.Error{
+			Field: "retry_backoff",
+			Error: err,
+		})
+	}
+
+	for i, code := range record.RetryCodes {
+		if err := validation.Validate(
+			code,
+			validation.Min(1),
+			validation.Max(255),
+		); err != nil {
+			errs.Errors = append(errs.Errors, validate.Error{
+				Field: fmt.Sprintf("retry_codes.%d", i),
+				Error: err,
+			})
+		}
+	}
+
 	for i, survey := range record.Surveys {
 		if err := validation.Validate(
 			survey.Kind,
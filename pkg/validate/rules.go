@@ -1,6 +1,10 @@
 package validate
 
 import (
+	"encoding/json"
+	"regexp"
+	"time"
+
 	"github.com/adhocore/gronx"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	giturls "github.com/whilp/git-urls"
@@ -18,6 +22,20 @@ var (
 
 	// CronSyntax validates if a string is a valid cron syntax
 	CronSyntax = validation.NewStringRuleWithError(gronx.IsValid, ErrGitURL)
+
+	// ErrVariables is the error that returns in case of invalid variables.
+	ErrVariables = validation.NewError("validation_is_variables", "must be a JSON object of string variables")
+
+	// Variables validates if a string is a JSON object of variables
+	Variables = validation.NewStringRuleWithError(IsVariables, ErrVariables)
+
+	// ErrTimezone is the error that returns in case of an invalid time zone.
+	ErrTimezone = validation.NewError("validation_is_timezone", "must be a valid IANA time zone")
+
+	// Timezone validates if a string is a valid IANA time zone
+	Timezone = validation.NewStringRuleWithError(IsTimezone, ErrTimezone)
+
+	variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 )
 
 // IsGitURL provides a vaöidator for Git clone URLs.
@@ -48,3 +66,29 @@ func IsGitURL(str string) bool {
 
 	return true
 }
+
+// IsVariables provides a validator for JSON objects of variables.
+func IsVariables(str string) bool {
+	vars := make(map[string]string)
+
+	if err := json.Unmarshal([]byte(str), &vars); err != nil {
+		return false
+	}
+
+	for name := range vars {
+		if !variableName.MatchString(name) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsTimezone provides a validator for IANA time zones.
+func IsTimezone(str string) bool {
+	if _, err := time.LoadLocation(str); err != nil {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,44 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Option defines a single option function.
+type Option func(o *Options)
+
+// Options defines the available options for this package.
+type Options struct {
+	Root       string
+	KeepFailed bool
+}
+
+// newOptions initializes the available default options.
+func newOptions(opts ...Option) Options {
+	opt := Options{
+		Root: filepath.Join(os.TempDir(), "gexec"),
+	}
+
+	for _, o := range opts {
+		o(&opt)
+	}
+
+	return opt
+}
+
+// WithRoot provides a function to set the root option.
+func WithRoot(v string) Option {
+	return func(o *Options) {
+		if v != "" {
+			o.Root = v
+		}
+	}
+}
+
+// WithKeepFailed provides a function to set the keep failed option.
+func WithKeepFailed(v bool) Option {
+	return func(o *Options) {
+		o.KeepFailed = v
+	}
+}
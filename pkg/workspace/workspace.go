@@ -0,0 +1,157 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// keepMarker defines the file marking a workspace kept for debugging.
+	keepMarker = ".gexec-keep"
+)
+
+// Manager creates and cleans the workspaces for executions.
+type Manager struct {
+	options Options
+}
+
+// New initializes a new workspace manager.
+func New(opts ...Option) (*Manager, error) {
+	options := newOptions(opts...)
+
+	if err := os.MkdirAll(options.Root, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create workspace root: %w", err)
+	}
+
+	return &Manager{
+		options: options,
+	}, nil
+}
+
+// Prune removes all leftover workspaces, e.g. after a crash of the runner.
+// Workspaces which have been kept for debugging purposes are skipped.
+func (m *Manager) Prune() error {
+	entries, err := os.ReadDir(m.options.Root)
+
+	if err != nil {
+		return fmt.Errorf("failed to read workspace root: %w", err)
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(m.options.Root, entry.Name())
+
+		if _, err := os.Stat(filepath.Join(path, keepMarker)); err == nil {
+			continue
+		}
+
+		slog.Info(
+			"Removing stale workspace",
+			slog.String("path", path),
+		)
+
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to remove workspace: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Create creates a new private workspace for an execution.
+func (m *Manager) Create(id string) (*Workspace, error) {
+	path, err := os.MkdirTemp(m.options.Root, id+"-")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	root, err := os.OpenRoot(path)
+
+	if err != nil {
+		_ = os.RemoveAll(path)
+		return nil, fmt.Errorf("failed to open workspace: %w", err)
+	}
+
+	return &Workspace{
+		path:    path,
+		root:    root,
+		keep:    m.options.KeepFailed,
+		secrets: make([]string, 0),
+	}, nil
+}
+
+// Workspace defines the private temporary directory of an execution.
+type Workspace struct {
+	path    string
+	root    *os.Root
+	keep    bool
+	secrets []string
+	mutex   sync.Mutex
+}
+
+// Path returns the absolute path for an element within the workspace, it is
+// not possible to escape the workspace with relative elements.
+func (w *Workspace) Path(elem ...string) string {
+	return filepath.Join(
+		w.path,
+		filepath.Clean("/"+filepath.Join(elem...)),
+	)
+}
+
+// Secret stores sensitive content readable only by the owner. Secrets are
+// always removed when the workspace gets closed, even if it should be kept.
+func (w *Workspace) Secret(name, content string) (string, error) {
+	if err := w.root.WriteFile(name, []byte(content), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	w.mutex.Lock()
+	w.secrets = append(w.secrets, name)
+	w.mutex.Unlock()
+
+	return w.Path(name), nil
+}
+
+// Protect marks an existing file as sensitive, it gets removed like secrets.
+func (w *Workspace) Protect(name string) string {
+	w.mutex.Lock()
+	w.secrets = append(w.secrets, name)
+	w.mutex.Unlock()
+
+	return w.Path(name)
+}
+
+// Close wipes the workspace. If the execution failed and failed workspaces
+// should be kept, only the secrets get removed.
+func (w *Workspace) Close(failed bool) error {
+	defer func() { _ = w.root.Close() }()
+
+	if failed && w.keep {
+		errs := make([]error, 0)
+
+		w.mutex.Lock()
+		for _, name := range w.secrets {
+			if err := w.root.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, err)
+			}
+		}
+		w.mutex.Unlock()
+
+		if err := w.root.WriteFile(keepMarker, nil, 0o600); err != nil {
+			errs = append(errs, err)
+		}
+
+		slog.Info(
+			"Keeping failed workspace",
+			slog.String("path", w.path),
+		)
+
+		return errors.Join(errs...)
+	}
+
+	return os.RemoveAll(w.path)
+}